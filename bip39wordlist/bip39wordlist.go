@@ -0,0 +1,164 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package bip39wordlist encodes binary data as BIP0039 mnemonics.
+//
+// Only the mnemonic encoding of BIP0039 is implemented: the data encoded by a
+// mnemonic is used directly as a wallet seed, and is not stretched into a
+// seed using the mnemonic passphrase derivation of BIP0039.
+package bip39wordlist
+
+import (
+	"crypto/sha256"
+	"strings"
+	"sync"
+
+	"decred.org/dcrwallet/v5/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Language is a BIP0039 word list.
+type Language struct {
+	name  string
+	sep   string
+	words string
+
+	once    sync.Once
+	list    []string
+	indexes map[string]uint16
+}
+
+// Word lists of each supported language.
+var (
+	English            = &Language{name: "english", sep: " ", words: englishWords}
+	Spanish            = &Language{name: "spanish", sep: " ", words: spanishWords}
+	French             = &Language{name: "french", sep: " ", words: frenchWords}
+	Italian            = &Language{name: "italian", sep: " ", words: italianWords}
+	Czech              = &Language{name: "czech", sep: " ", words: czechWords}
+	Japanese           = &Language{name: "japanese", sep: "　", words: japaneseWords}
+	Korean             = &Language{name: "korean", sep: " ", words: koreanWords}
+	ChineseSimplified  = &Language{name: "chinese-simplified", sep: " ", words: chineseSimplifiedWords}
+	ChineseTraditional = &Language{name: "chinese-traditional", sep: " ", words: chineseTraditionalWords}
+)
+
+var languages = []*Language{
+	English, Spanish, French, Italian, Czech, Japanese, Korean,
+	ChineseSimplified, ChineseTraditional,
+}
+
+// Languages returns every supported language.
+func Languages() []*Language {
+	return append([]*Language(nil), languages...)
+}
+
+// LanguageByName returns the language with the name returned by Name.
+func LanguageByName(name string) (*Language, bool) {
+	for _, l := range languages {
+		if l.name == name {
+			return l, true
+		}
+	}
+	return nil, false
+}
+
+// Name returns the lowercase name of the language.
+func (l *Language) Name() string {
+	return l.name
+}
+
+func (l *Language) init() {
+	l.once.Do(func() {
+		l.list = strings.Split(l.words, "\n")
+		l.indexes = make(map[string]uint16, len(l.list))
+		for i, word := range l.list {
+			l.indexes[word] = uint16(i)
+		}
+	})
+}
+
+// checksumBits returns the number of checksum bits appended to entropy of
+// length n bytes.
+func checksumBits(n int) int {
+	return n * 8 / 32
+}
+
+// EncodeMnemonicSlice encodes entropy as the words of a BIP0039 mnemonic.
+// The entropy must be between 16 and 32 bytes, in multiples of 4 bytes.
+func EncodeMnemonicSlice(entropy []byte, lang *Language) ([]string, error) {
+	const op errors.Op = "bip39wordlist.EncodeMnemonicSlice"
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		err := errors.Errorf("entropy length %d is not supported by BIP0039",
+			len(entropy))
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	lang.init()
+
+	checksum := sha256.Sum256(entropy)
+	data := append(entropy[:len(entropy):len(entropy)], checksum[0])
+	n := (len(entropy)*8 + checksumBits(len(entropy))) / 11
+	words := make([]string, n)
+	for i := range words {
+		var index uint16
+		for j := i * 11; j < (i+1)*11; j++ {
+			index = index<<1 | uint16(data[j/8]>>(7-j%8)&1)
+		}
+		words[i] = lang.list[index]
+	}
+	return words, nil
+}
+
+// EncodeMnemonic encodes entropy as a BIP0039 mnemonic, with words separated
+// by spaces (ideographic spaces for Japanese).
+func EncodeMnemonic(entropy []byte, lang *Language) (string, error) {
+	words, err := EncodeMnemonicSlice(entropy, lang)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(words, lang.sep), nil
+}
+
+// DecodeMnemonics returns the entropy encoded by the words of a BIP0039
+// mnemonic, after verifying its checksum.  Words are normalized before they
+// are looked up in the word list, and empty words are skipped.
+func DecodeMnemonics(words []string, lang *Language) ([]byte, error) {
+	const op errors.Op = "bip39wordlist.DecodeMnemonics"
+	lang.init()
+
+	indexes := make([]uint16, 0, len(words))
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		index, ok := lang.indexes[norm.NFKD.String(strings.ToLower(w))]
+		if !ok {
+			err := errors.Errorf("word %v is not in the BIP0039 %s word list",
+				w, lang.name)
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+		indexes = append(indexes, index)
+	}
+	switch len(indexes) {
+	case 12, 15, 18, 21, 24:
+	default:
+		err := errors.Errorf("BIP0039 mnemonic has invalid word count %d",
+			len(indexes))
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+
+	n := len(indexes) * 11 * 32 / 33 / 8
+	data := make([]byte, n+1)
+	for i, index := range indexes {
+		for j := 0; j < 11; j++ {
+			bit := i*11 + j
+			data[bit/8] |= byte(index>>(10-j)&1) << (7 - bit%8)
+		}
+	}
+	entropy, cs := data[:n], checksumBits(n)
+	checksum := sha256.Sum256(entropy)
+	if checksum[0]>>(8-cs) != data[n]>>(8-cs) {
+		return nil, errors.E(op, errors.Encoding, "checksum mismatch")
+	}
+	return entropy, nil
+}
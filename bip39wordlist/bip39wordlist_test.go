@@ -0,0 +1,138 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bip39wordlist
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash/crc32"
+	"strings"
+	"testing"
+)
+
+// Test vectors from the BIP0039 specification.
+var mnemonicTests = []struct {
+	entropy  string
+	mnemonic string
+}{
+	{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+	},
+	{
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+	},
+	{
+		entropy:  "9e885d952ad362caeb4efe34a8e91bd2",
+		mnemonic: "ozone drill grab fiber curtain grace pudding thank cruise elder eight picnic",
+	},
+	{
+		entropy:  "8080808080808080808080808080808080808080808080808080808080808080",
+		mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+	},
+	{
+		entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+	},
+}
+
+func TestWordLists(t *testing.T) {
+	// CRC32 checksums of the word list files of the BIP0039 specification.
+	checksums := map[*Language]uint32{
+		English:            0xc1dbd296,
+		Spanish:            0x266e4f3d,
+		French:             0x3e56b216,
+		Italian:            0x2fc7d07e,
+		Czech:              0xd1b5fda0,
+		Japanese:           0x0acc1419,
+		Korean:             0x4ef461eb,
+		ChineseSimplified:  0xe3721bbf,
+		ChineseTraditional: 0x3c20b443,
+	}
+	for _, l := range Languages() {
+		l.init()
+		if len(l.list) != 2048 || len(l.indexes) != 2048 {
+			t.Errorf("%s: word list has %d unique words", l.Name(),
+				len(l.indexes))
+		}
+		if sum := crc32.ChecksumIEEE([]byte(l.words + "\n")); sum != checksums[l] {
+			t.Errorf("%s: word list checksum %08x, want %08x", l.Name(),
+				sum, checksums[l])
+		}
+	}
+}
+
+func TestEncodeMnemonic(t *testing.T) {
+	for i, test := range mnemonicTests {
+		entropy, _ := hex.DecodeString(test.entropy)
+		mnemonic, err := EncodeMnemonic(entropy, English)
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		if mnemonic != test.mnemonic {
+			t.Errorf("test %d: got `%v` want `%v`", i, mnemonic, test.mnemonic)
+		}
+	}
+
+	for _, n := range []int{0, 15, 17, 36, 64} {
+		_, err := EncodeMnemonic(make([]byte, n), English)
+		if err == nil {
+			t.Errorf("encoded unsupported entropy length %d", n)
+		}
+	}
+}
+
+func TestDecodeMnemonics(t *testing.T) {
+	for i, test := range mnemonicTests {
+		entropy, _ := hex.DecodeString(test.entropy)
+		words := strings.Split(strings.ToUpper(test.mnemonic), " ")
+		decoded, err := DecodeMnemonics(words, English)
+		if err != nil {
+			t.Errorf("test %d: %v", i, err)
+			continue
+		}
+		if !bytes.Equal(decoded, entropy) {
+			t.Errorf("test %d: got %x want %x", i, decoded, entropy)
+		}
+	}
+
+	invalid := []string{
+		// Checksum mismatch
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		// Invalid word count
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		// Unknown word
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon aardvark",
+	}
+	for _, mnemonic := range invalid {
+		_, err := DecodeMnemonics(strings.Split(mnemonic, " "), English)
+		if err == nil {
+			t.Errorf("decoded invalid mnemonic %q", mnemonic)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	entropy := make([]byte, 32)
+	for i := range entropy {
+		entropy[i] = byte(i * 7)
+	}
+	for _, l := range Languages() {
+		mnemonic, err := EncodeMnemonic(entropy, l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := DecodeMnemonics(strings.Fields(mnemonic), l)
+		if err != nil {
+			t.Errorf("%s: %v", l.Name(), err)
+			continue
+		}
+		if !bytes.Equal(decoded, entropy) {
+			t.Errorf("%s: got %x want %x", l.Name(), decoded, entropy)
+		}
+	}
+}
@@ -83,11 +83,21 @@ func (s *Syncer) ExistsLiveTickets(ctx context.Context, tickets []*chainhash.Has
 	return s.rpc.ExistsLiveTickets(ctx, tickets)
 }
 
+// ExistsMempoolTxs fulfills the MempoolQuerier interface.
+func (s *Syncer) ExistsMempoolTxs(ctx context.Context, txs []*chainhash.Hash) (bitset.Bytes, error) {
+	return s.rpc.ExistsMempoolTxs(ctx, txs)
+}
+
 // UsedAddresses fulfills the usedAddressesQuerier interface.
 func (s *Syncer) UsedAddresses(ctx context.Context, addrs []stdaddr.Address) (bitset.Bytes, error) {
 	return s.rpc.UsedAddresses(ctx, addrs)
 }
 
+// String returns the address of the dcrd RPC server.
+func (s *Syncer) String() string {
+	return "dcrd RPC " + s.opts.Address
+}
+
 func (s *Syncer) Done() <-chan struct{} {
 	s.doneMu.Lock()
 	c := s.done
@@ -620,6 +620,15 @@ func (s *Syncer) Run(ctx context.Context) (err error) {
 			"with required version %v", api.Version, requiredAPIVersion)
 	}
 
+	// Adopt the server's minimum relay fee as the wallet's fee floor so
+	// created transactions are relayed.
+	relayFee, err := s.rpc.RelayFee(ctx)
+	if err != nil {
+		return err
+	}
+	log.Debugf("Chain server minimum relay fee is %v/kB", relayFee)
+	s.wallet.SetMinRelayFee(relayFee)
+
 	// Associate the RPC client with the wallet and remove the association on return.
 	s.wallet.SetNetworkBackend(s)
 	defer s.wallet.SetNetworkBackend(nil)
@@ -9,6 +9,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -16,15 +17,19 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"decred.org/cspp/v2/solverrpc"
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/cfgutil"
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/netparams"
+	"decred.org/dcrwallet/v5/spv"
+	"decred.org/dcrwallet/v5/ticketbuyer"
 	"decred.org/dcrwallet/v5/version"
 	"decred.org/dcrwallet/v5/wallet"
 	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/walletseed"
 	"github.com/decred/dcrd/connmgr/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/go-socks/socks"
@@ -61,6 +66,9 @@ const (
 	defaultCircuitLimit            = 32
 	defaultMixSplitLimit           = 10
 	defaultVSPMaxFee               = dcrutil.Amount(0.2e8)
+	defaultSweepMaxFee             = dcrutil.Amount(0.001e8)
+	defaultTipCheckMaxDivergence   = 6
+	defaultTipCheckInterval        = 10 * time.Minute
 
 	// ticket buyer options
 	defaultBalanceToMaintainAbsolute = 0
@@ -88,9 +96,13 @@ type config struct {
 	Create             bool                    `long:"create" description:"Create new wallet"`
 	CreateTemp         bool                    `long:"createtemp" description:"Create simulation wallet in nonstandard --appdata; private passphrase is 'password'"`
 	CreateWatchingOnly bool                    `long:"createwatchingonly" description:"Create watching wallet from account extended pubkey"`
+	SeedFormat         string                  `long:"seedformat" description:"Mnemonic format of the seed shown or entered when creating a wallet {pgp, bip39-english, bip39-spanish, bip39-french, bip39-italian, bip39-czech, bip39-japanese, bip39-korean, bip39-chinese-simplified, bip39-chinese-traditional}"`
+	DryRun             bool                    `long:"dryrun" description:"Report the database migrations, upgrades, and rescan performed when opening the wallet, without performing them, and exit"`
+	DBUpgradeDryRun    bool                    `long:"dbupgrade-dryrun" description:"Alias for --dryrun"`
 	AppDataDir         *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
 	TestNet            bool                    `long:"testnet" description:"Use the test network"`
 	SimNet             bool                    `long:"simnet" description:"Use the simulation test network"`
+	ParamsFile         string                  `long:"paramsfile" description:"Use the alternate network described by a JSON chain parameters file"`
 	NoInitialLoad      bool                    `long:"noinitialload" description:"Defer wallet creation/opening on startup and enable loading wallets over RPC"`
 	DebugLevel         string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
 	LogDir             *cfgutil.ExplicitString `long:"logdir" description:"Directory to log output."`
@@ -104,6 +116,7 @@ type config struct {
 	WalletPass              string              `long:"walletpass" default-mask:"-" description:"Public wallet password; required when created with one"`
 	PromptPass              bool                `long:"promptpass" description:"Prompt for private passphase from terminal and unlock without timeout"`
 	Pass                    string              `long:"pass" description:"Unlock with private passphrase"`
+	VotingPass              string              `long:"votingpass" description:"Unlock the voting domain with passphrase; voting accounts remain unlocked when the wallet is locked"`
 	PromptPublicPass        bool                `long:"promptpublicpass" description:"Prompt for public passphrase from terminal"`
 	EnableTicketBuyer       bool                `long:"enableticketbuyer" description:"Enable the automatic ticket buyer"`
 	EnableVoting            bool                `long:"enablevoting" description:"Automatically vote on winning tickets"`
@@ -113,8 +126,21 @@ type config struct {
 	ManualTickets           bool                `long:"manualtickets" description:"Do not discover new tickets through network synchronization"`
 	AllowHighFees           bool                `long:"allowhighfees" description:"Do not perform high fee checks"`
 	RelayFee                *cfgutil.AmountFlag `long:"txfee" description:"Transaction fee per kilobyte"`
+	MaxTxOutputs            int                 `long:"maxtxoutputs" description:"Maximum number of outputs, including change, of authored transactions (0 for no limit)"`
+	MaxTxSize               int                 `long:"maxtxsize" description:"Maximum size in bytes of authored transactions (0 for the network limit)"`
+	VoteFeeReserve          *cfgutil.AmountFlag `long:"votefeereserve" description:"Spendable funds retained for each unspent ticket to pay its vote or revocation fees; ticket purchases and sends may not spend below the total reserve (0 to disable)"`
+	ExcludeStakeInputs      bool                `long:"excludestakeinputs" description:"Never spend matured vote, revocation, and other stake tree outputs when authoring transactions"`
+	StakeSweepAccount       string              `long:"stakesweepaccount" description:"Sweep matured stake tree outputs of other accounts into this account as blocks are attached"`
+	SweepMatured            bool                `long:"sweepmatured" description:"Consolidate matured coinbase, vote, and revocation outputs into the default account as blocks are attached"`
+	SweepMaxFee             *cfgutil.AmountFlag `long:"sweepmaxfee" description:"Maximum fee paid by each matured output sweep transaction"`
+	AttestInterval          time.Duration       `long:"attestinterval" description:"Record a signed attestation of the wallet state at this interval for external monitoring (0 to disable)"`
+	BalanceSnapshots        bool                `long:"balancesnapshots" description:"Record a daily snapshot of the wallet balance and tickets, returned by the getbalancehistory RPC"`
+	TipCheckURLs            []string            `long:"tipcheckurl" description:"URL of an additional source of the best block height, such as https://dcrdata.decred.org/api/block/best/height, to cross-check the main chain tip against; may be repeated"`
+	TipCheckMaxDivergence   uint32              `long:"tipcheckmaxdivergence" description:"Alert when a tip check source's best height differs from the main chain tip by more than this many blocks"`
+	TipCheckInterval        time.Duration       `long:"tipcheckinterval" description:"Interval between cross-checks of the main chain tip against tip check sources"`
 	AccountGapLimit         int                 `long:"accountgaplimit" description:"Allowed gap of unused accounts"`
 	DisableCoinTypeUpgrades bool                `long:"disablecointypeupgrades" description:"Never upgrade from legacy to SLIP0044 coin type keys"`
+	ExternalSigner          string              `long:"externalsigner" description:"Command used to communicate with an external signing device"`
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Network address of dcrd RPC server"`
@@ -144,6 +170,7 @@ type config struct {
 	SPV               bool     `long:"spv" description:"Sync using simplified payment verification"`
 	SPVConnect        []string `long:"spvconnect" description:"SPV sync only with specified peers; disables DNS seeding"`
 	SPVDisableRelayTx bool     `long:"spvdisablerelaytx" description:"Disable receiving mempool transactions when in SPV mode"`
+	SPVRescanFetchers int      `long:"spvrescanfetchers" description:"Maximum number of concurrent block fetches during SPV rescans"`
 
 	// RPC server options
 	RPCCert                *cfgutil.ExplicitString `long:"rpccert" description:"RPC server TLS certificate"`
@@ -160,6 +187,8 @@ type config struct {
 	Username               string                  `short:"u" long:"username" description:"JSON-RPC username and default dcrd RPC username"`
 	Password               string                  `short:"P" long:"password" default-mask:"-" description:"JSON-RPC password and default dcrd RPC password"`
 	JSONRPCAuthType        string                  `long:"jsonrpcauthtype" description:"Method for JSON-RPC client authentication (basic or clientcert)"`
+	RPCAuditLog            string                  `long:"rpcauditlog" description:"Append a record of every mutating JSON-RPC request to this file"`
+	JSONRPCOmitFloats      bool                    `long:"jsonrpcomitfloats" description:"Omit floating point DCR amounts from JSON-RPC results, reporting only integer atom amounts"`
 
 	// IPC options
 	PipeTx            *uint `long:"pipetx" description:"File descriptor or handle of write end pipe to enable child -> parent process communication"`
@@ -181,12 +210,73 @@ type config struct {
 	TBOpts ticketBuyerOptions `group:"Ticket Buyer Options" namespace:"ticketbuyer"`
 
 	VSPOpts vspOptions `group:"VSP Options" namespace:"vsp"`
+
+	// Config file path and parsed option values, keyed by long name, used
+	// to detect changed options when reloading the configuration.
+	configFilePath string
+	options        map[string]string
 }
 
 type ticketBuyerOptions struct {
 	BalanceToMaintainAbsolute *cfgutil.AmountFlag `long:"balancetomaintainabsolute" description:"Amount of funds to keep in wallet when purchasing tickets"`
 	Limit                     uint                `long:"limit" description:"Buy no more than specified number of tickets per block"`
 	VotingAccount             string              `long:"votingaccount" description:"Account used to derive addresses specifying voting rights"`
+	SkipFinalBlocks           uint                `long:"skipfinalblocks" description:"Do not buy tickets when fewer than this many blocks remain before the ticket price changes"`
+	OnlyFinalBlocks           uint                `long:"onlyfinalblocks" description:"Only buy tickets when fewer than this many blocks remain before the ticket price changes"`
+	MaxPrice                  *cfgutil.AmountFlag `long:"maxprice" description:"Do not buy tickets when the ticket price is above this amount"`
+	MinReturn                 float64             `long:"minreturn" description:"Do not buy tickets when a single vote's subsidy divided by the ticket price is below this ratio"`
+	PolicyURL                 string              `long:"policyurl" description:"URL of an external HTTP service which decides whether tickets should be purchased"`
+	MinConf                   uint                `long:"minconf" description:"Only fund ticket purchases with outputs having at least this many confirmations"`
+	NoChangeInputs            bool                `long:"nochangeinputs" description:"Do not fund ticket purchases with change outputs or the outputs of previous split transactions"`
+}
+
+// validate checks the ticket buyer options for invalid values and
+// combinations.
+func (o *ticketBuyerOptions) validate(params *netparams.Params) error {
+	if o.BalanceToMaintainAbsolute.ToCoin() < 0 {
+		return errors.Errorf("balancetomaintainabsolute cannot be "+
+			"negative: %v", o.BalanceToMaintainAbsolute)
+	}
+	if o.MaxPrice.Amount < 0 {
+		return errors.Errorf("ticketbuyer.maxprice cannot be negative: %v",
+			o.MaxPrice)
+	}
+	if o.MinReturn < 0 {
+		return errors.Errorf("ticketbuyer.minreturn cannot be negative: %v",
+			o.MinReturn)
+	}
+	if o.PolicyURL != "" {
+		u, err := url.Parse(o.PolicyURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return errors.Errorf("ticketbuyer.policyurl must be an "+
+				"http or https URL: %q", o.PolicyURL)
+		}
+	}
+
+	// Only one of the ticket buyer stake difficulty window options may be
+	// used, and neither may exceed the window size.
+	if o.SkipFinalBlocks != 0 && o.OnlyFinalBlocks != 0 {
+		return errors.New("ticketbuyer.skipfinalblocks and " +
+			"ticketbuyer.onlyfinalblocks cannot be used together")
+	}
+	windowSize := uint(params.Params.StakeDiffWindowSize)
+	if o.SkipFinalBlocks > windowSize || o.OnlyFinalBlocks > windowSize {
+		return errors.Errorf("ticketbuyer final block options cannot "+
+			"exceed the stake difficulty window size (%d)", windowSize)
+	}
+	return nil
+}
+
+// limitPolicy returns the ticket buyer policy limiting purchases by ticket
+// price and return, or nil when no limits are set.
+func (o *ticketBuyerOptions) limitPolicy() *ticketbuyer.LimitPolicy {
+	if o.MaxPrice.Amount == 0 && o.MinReturn == 0 {
+		return nil
+	}
+	return &ticketbuyer.LimitPolicy{
+		MaxPrice:  o.MaxPrice.Amount,
+		MinReturn: o.MinReturn,
+	}
 }
 
 type vspOptions struct {
@@ -197,6 +287,15 @@ type vspOptions struct {
 	MaxFee *cfgutil.AmountFlag `long:"maxfee" description:"Maximum VSP fee"`
 }
 
+// inputTree returns the transaction tree of outputs which the wallet may
+// select as inputs of authored transactions.
+func (cfg *config) inputTree() wallet.InputTree {
+	if cfg.ExcludeStakeInputs {
+		return wallet.InputTreeRegular
+	}
+	return wallet.InputTreeAny
+}
+
 // cleanAndExpandPath expands environement variables and leading ~ in the
 // passed path, cleans the result, and returns it.
 func cleanAndExpandPath(path string) string {
@@ -322,28 +421,9 @@ func parseAndSetDebugLevels(debugLevel string) error {
 	return nil
 }
 
-// loadConfig initializes and parses the config using a config file and command
-// line options.
-//
-// The configuration proceeds as follows:
-//  1. Start with a default config with sane settings
-//  2. Pre-parse the command line to check for an alternative config file
-//  3. Load configuration file overwriting defaults with any specified options
-//  4. Parse CLI options and overwrite/add any specified options
-//
-// The above results in dcrwallet functioning properly without any config
-// settings while still allowing the user to override settings with config files
-// and command line options.  Command line options always take precedence.
-// The bool returned indicates whether or not the wallet was recreated from a
-// seed and needs to perform the initial resync. The []byte is the private
-// passphrase required to do the sync for this special case.
-func loadConfig(ctx context.Context) (*config, []string, error) {
-	loadConfigError := func(err error) (*config, []string, error) {
-		return nil, nil, err
-	}
-
-	// Default config.
-	cfg := config{
+// defaultConfig returns the configuration used before any options are parsed.
+func defaultConfig() config {
+	return config{
 		DebugLevel:              defaultLogLevel,
 		ConfigFile:              cfgutil.NewExplicitString(defaultConfigFile),
 		AppDataDir:              cfgutil.NewExplicitString(defaultAppDataDir),
@@ -366,28 +446,58 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		LegacyRPCMaxWebsockets:  defaultRPCMaxWebsockets,
 		JSONRPCAuthType:         defaultAuthType,
 		DcrdAuthType:            defaultAuthType,
+		SeedFormat:              string(walletseed.FormatPGP),
 		EnableTicketBuyer:       defaultEnableTicketBuyer,
 		EnableVoting:            defaultEnableVoting,
 		PurchaseAccount:         defaultPurchaseAccount,
 		GapLimit:                defaultGapLimit,
 		AllowHighFees:           defaultAllowHighFees,
 		RelayFee:                cfgutil.NewAmountFlag(txrules.DefaultRelayFeePerKb),
+		SweepMaxFee:             cfgutil.NewAmountFlag(defaultSweepMaxFee),
+		VoteFeeReserve:          cfgutil.NewAmountFlag(0),
+		TipCheckMaxDivergence:   defaultTipCheckMaxDivergence,
+		TipCheckInterval:        defaultTipCheckInterval,
 		AccountGapLimit:         defaultAccountGapLimit,
 		DisableCoinTypeUpgrades: defaultDisableCoinTypeUpgrades,
 		CircuitLimit:            defaultCircuitLimit,
 		MixSplitLimit:           defaultMixSplitLimit,
+		SPVRescanFetchers:       spv.DefaultRescanFetchers,
 		CSPPSolver:              cfgutil.NewExplicitString(solverrpc.SolverProcess),
 
 		// Ticket Buyer Options
 		TBOpts: ticketBuyerOptions{
 			BalanceToMaintainAbsolute: cfgutil.NewAmountFlag(defaultBalanceToMaintainAbsolute),
 			Limit:                     defaultTicketbuyerLimit,
+			MaxPrice:                  cfgutil.NewAmountFlag(0),
 		},
 
 		VSPOpts: vspOptions{
 			MaxFee: cfgutil.NewAmountFlag(defaultVSPMaxFee),
 		},
 	}
+}
+
+// loadConfig initializes and parses the config using a config file and command
+// line options.
+//
+// The configuration proceeds as follows:
+//  1. Start with a default config with sane settings
+//  2. Pre-parse the command line to check for an alternative config file
+//  3. Load configuration file overwriting defaults with any specified options
+//  4. Parse CLI options and overwrite/add any specified options
+//
+// The above results in dcrwallet functioning properly without any config
+// settings while still allowing the user to override settings with config files
+// and command line options.  Command line options always take precedence.
+// The bool returned indicates whether or not the wallet was recreated from a
+// seed and needs to perform the initial resync. The []byte is the private
+// passphrase required to do the sync for this special case.
+func loadConfig(ctx context.Context) (*config, []string, error) {
+	loadConfigError := func(err error) (*config, []string, error) {
+		return nil, nil, err
+	}
+
+	cfg := defaultConfig()
 
 	// Pre-parse the command line options to see if an alternative config
 	// file or the version flag was specified.
@@ -447,6 +557,11 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Record the parsed options so that changes can be detected when the
+	// configuration is reloaded.
+	cfg.configFilePath = configFilePath
+	cfg.options = optionValues(parser)
+
 	// If an alternate data directory was specified, and paths with defaults
 	// relative to the data dir are unchanged, modify each path to be
 	// relative to the new data dir.
@@ -483,9 +598,23 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		activeNet = &netparams.SimNetParams
 		numNets++
 	}
+	if cfg.ParamsFile != "" {
+		params, err := netparams.LoadFile(cleanAndExpandPath(cfg.ParamsFile))
+		if err == nil {
+			err = netparams.Register(params)
+		}
+		if err != nil {
+			err := errors.Errorf("%s: invalid chain parameters file: %v",
+				"loadConfig", err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+		activeNet = params
+		numNets++
+	}
 	if numNets > 1 {
-		str := "%s: The testnet and simnet params can't be used " +
-			"together -- choose one"
+		str := "%s: The testnet, simnet and paramsfile params can't be " +
+			"used together -- choose one"
 		err := errors.Errorf(str, "loadConfig")
 		fmt.Fprintln(os.Stderr, err)
 		return loadConfigError(err)
@@ -565,10 +694,9 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		log.Warnf("%v", configFileError)
 	}
 
-	// Sanity check BalanceToMaintainAbsolute
-	if cfg.TBOpts.BalanceToMaintainAbsolute.ToCoin() < 0 {
-		str := "%s: balancetomaintainabsolute cannot be negative: %v"
-		err := errors.Errorf(str, funcName, cfg.TBOpts.BalanceToMaintainAbsolute)
+	// Sanity check ticket buyer options.
+	if err := cfg.TBOpts.validate(activeNet); err != nil {
+		err := errors.Errorf("%s: %v", funcName, err)
 		fmt.Fprintln(os.Stderr, err)
 		return loadConfigError(err)
 	}
@@ -659,6 +787,20 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 
 		// Created successfully, so exit now with success.
 		os.Exit(0)
+	} else if cfg.DryRun || cfg.DBUpgradeDryRun {
+		if !dbFileExists {
+			err := errors.Errorf("The wallet database file `%v` "+
+				"does not exist.", dbPath)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+
+		err := dryRun(ctx, &cfg, dbPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Unable to perform dry run:", err)
+			return loadConfigError(err)
+		}
+		os.Exit(0)
 	} else if !dbFileExists && !cfg.NoInitialLoad {
 		err := errors.Errorf("The wallet does not exist.  Run with the " +
 			"--create option to initialize and create it.")
@@ -864,6 +1006,32 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 			return loadConfigError(err)
 		}
 	}
+	if cfg.MaxTxOutputs < 0 {
+		err := errors.E("--maxtxoutputs must not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.MaxTxSize < 0 || cfg.MaxTxSize > activeNet.Params.MaxTxSize {
+		err := errors.Errorf("--maxtxsize must be between 0 and the "+
+			"network maximum transaction size %d", activeNet.Params.MaxTxSize)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.VoteFeeReserve.Amount < 0 {
+		err := errors.E("--votefeereserve must not be negative")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if len(cfg.TipCheckURLs) != 0 && cfg.TipCheckInterval <= 0 {
+		err := errors.E("--tipcheckinterval must be positive")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.SPVRescanFetchers < 1 {
+		err := errors.E("--spvrescanfetchers must be positive")
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
 
 	// Default to localhost listen addresses if no listeners were manually
 	// specified.  When the RPC server is configured to be disabled, remove all
@@ -979,6 +1147,9 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 	cfg.DcrdClientCert.Value = cleanAndExpandPath(cfg.DcrdClientCert.Value)
 	cfg.DcrdClientKey.Value = cleanAndExpandPath(cfg.DcrdClientKey.Value)
 	cfg.ClientCAFile.Value = cleanAndExpandPath(cfg.ClientCAFile.Value)
+	if cfg.RPCAuditLog != "" {
+		cfg.RPCAuditLog = cleanAndExpandPath(cfg.RPCAuditLog)
+	}
 
 	// If the dcrd username or password are unset, use the same auth as for
 	// the client.  The two settings were previously shared for dcrd and
@@ -24,11 +24,13 @@ import (
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/prompt"
 	"decred.org/dcrwallet/v5/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/v5/internal/supervisor"
 	"decred.org/dcrwallet/v5/p2p"
 	"decred.org/dcrwallet/v5/spv"
 	"decred.org/dcrwallet/v5/ticketbuyer"
 	"decred.org/dcrwallet/v5/version"
 	"decred.org/dcrwallet/v5/wallet"
+	"decred.org/dcrwallet/v5/wallet/udb"
 	"github.com/decred/dcrd/addrmgr/v2"
 	"github.com/decred/dcrd/wire"
 )
@@ -161,6 +163,11 @@ func run(ctx context.Context) error {
 		return ctx.Err()
 	}
 
+	// Long-running subsystems are run by the supervisor, which recovers them
+	// from panics and restarts them after failures.  Their status is
+	// reported by the walletinfo JSON-RPC method.
+	sup := supervisor.New()
+
 	// Create the loader which is used to load and unload the wallet.  If
 	// --noinitialload is not set, this function is responsible for loading the
 	// wallet.  Otherwise, loading is deferred so it can be performed over RPC.
@@ -169,7 +176,13 @@ func run(ctx context.Context) error {
 	loader := ldr.NewLoader(activeNet.Params, dbDir, cfg.EnableVoting,
 		cfg.GapLimit, cfg.WatchLast, cfg.AllowHighFees, cfg.RelayFee.Amount,
 		cfg.AccountGapLimit, cfg.DisableCoinTypeUpgrades, !cfg.Mixing,
-		cfg.ManualTickets, cfg.MixSplitLimit, cfg.dial)
+		cfg.ManualTickets, cfg.MixSplitLimit, cfg.MaxTxOutputs, cfg.MaxTxSize,
+		cfg.inputTree(), cfg.VoteFeeReserve.Amount, cfg.dial)
+
+	// Reload the configuration when requested by a signal or the
+	// reloadconfig JSON-RPC method.
+	reloader := newConfigReloader(loader)
+	go reloadListener(ctx, reloader)
 
 	// Stop any services started by the loader after the shutdown procedure is
 	// initialized and this function returns.
@@ -249,6 +262,15 @@ func run(ctx context.Context) error {
 			passphrase = startPromptPass(ctx, w)
 		}
 
+		if cfg.VotingPass != "" {
+			accounts, err := w.UnlockVotingDomain(ctx, []byte(cfg.VotingPass))
+			if err != nil {
+				log.Errorf("Failed to unlock voting domain: %v", err)
+				return err
+			}
+			log.Infof("Unlocked %d voting account(s)", len(accounts))
+		}
+
 		if cfg.VSPOpts.URL != "" {
 			changeAccountName := cfg.ChangeAccount
 			if changeAccountName == "" && !cfg.Mixing {
@@ -330,12 +352,34 @@ func run(ctx context.Context) error {
 				return err
 			}
 
+			// Create any configured purchase policies.
+			var policies []ticketbuyer.Policy
+			if p := cfg.TBOpts.limitPolicy(); p != nil {
+				policies = append(policies, p)
+			}
+			if cfg.TBOpts.PolicyURL != "" {
+				client := &http.Client{
+					Transport: &http.Transport{
+						DialContext: cfg.dial,
+					},
+					Timeout: time.Minute,
+				}
+				policies = append(policies, &ticketbuyer.HTTPPolicy{
+					URL:    cfg.TBOpts.PolicyURL,
+					Client: client,
+				})
+			}
+
 			// Start a ticket buyer.
 			tb := ticketbuyer.New(w, ticketbuyer.Config{
 				BuyTickets:         cfg.EnableTicketBuyer,
 				Account:            purchaseAccount,
 				Maintain:           cfg.TBOpts.BalanceToMaintainAbsolute.Amount,
 				Limit:              int(cfg.TBOpts.Limit),
+				SkipFinalBlocks:    int32(cfg.TBOpts.SkipFinalBlocks),
+				OnlyFinalBlocks:    int32(cfg.TBOpts.OnlyFinalBlocks),
+				MinConf:            int32(cfg.TBOpts.MinConf),
+				ExcludeChange:      cfg.TBOpts.NoChangeInputs,
 				VotingAccount:      votingAccount,
 				Mixing:             cfg.Mixing,
 				MixChange:          cfg.MixChange,
@@ -344,17 +388,15 @@ func run(ctx context.Context) error {
 				TicketSplitAccount: ticketSplitAccount,
 				ChangeAccount:      changeAccount,
 				VSP:                vspClient,
+				Policies:           policies,
 			})
+			reloader.setTicketBuyer(tb)
 
 			log.Infof("Starting auto transaction creator")
-			tbdone := make(chan struct{})
-			go func() {
-				err := tb.Run(ctx, passphrase)
-				if err != nil && !errors.Is(err, context.Canceled) {
-					log.Errorf("Transaction creator ended: %v", err)
-				}
-				tbdone <- struct{}{}
-			}()
+			tbdone := sup.Go(ctx, "ticketbuyer", supervisor.Policy{},
+				func(ctx context.Context) error {
+					return tb.Run(ctx, passphrase)
+				})
 			defer func() { <-tbdone }()
 		}
 	}
@@ -370,7 +412,7 @@ func run(ctx context.Context) error {
 	//
 	// Servers will be associated with a loaded wallet if it has already been
 	// loaded, or after it is loaded later on.
-	gRPCServer, jsonRPCServer, err := startRPCServers(loader)
+	gRPCServer, jsonRPCServer, err := startRPCServers(loader, sup, reloader)
 	if err != nil {
 		log.Errorf("Unable to create RPC servers: %v", err)
 		return err
@@ -402,6 +444,76 @@ func run(ctx context.Context) error {
 		}()
 	}
 
+	// Execute scheduled payment templates as blocks are attached.
+	loader.RunAfterLoad(func(w *wallet.Wallet) {
+		sup.Go(ctx, "paymenttemplates", supervisor.Policy{},
+			w.RunPaymentTemplates)
+	})
+
+	// Sweep matured stake outputs into the configured account as blocks are
+	// attached.
+	if cfg.StakeSweepAccount != "" {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			account, err := w.AccountNumber(ctx, cfg.StakeSweepAccount)
+			if err != nil {
+				log.Errorf("Stake sweep account %q: %v",
+					cfg.StakeSweepAccount, err)
+				return
+			}
+			sup.Go(ctx, "stakesweep", supervisor.Policy{},
+				func(ctx context.Context) error {
+					return w.RunStakeSweep(ctx, account)
+				})
+		})
+	}
+
+	if cfg.SweepMatured {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			sup.Go(ctx, "maturedsweep", supervisor.Policy{},
+				func(ctx context.Context) error {
+					return w.RunMaturedRewardsSweep(ctx,
+						udb.DefaultAccountNum, cfg.SweepMaxFee.Amount)
+				})
+		})
+	}
+
+	if cfg.AttestInterval > 0 {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			sup.Go(ctx, "attestations", supervisor.Policy{},
+				func(ctx context.Context) error {
+					return w.RunAttestations(ctx, cfg.AttestInterval)
+				})
+		})
+	}
+
+	if cfg.BalanceSnapshots {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			sup.Go(ctx, "balancesnapshots", supervisor.Policy{},
+				w.RunBalanceSnapshots)
+		})
+	}
+
+	if len(cfg.TipCheckURLs) != 0 {
+		client := &http.Client{
+			Transport: &http.Transport{
+				DialContext: cfg.dial,
+			},
+			Timeout: time.Minute,
+		}
+		sources := make([]wallet.TipSource, len(cfg.TipCheckURLs))
+		for i, u := range cfg.TipCheckURLs {
+			sources[i] = &wallet.HTTPTipSource{URL: u, Client: client}
+		}
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			sup.Go(ctx, "tipchecks", supervisor.Policy{},
+				func(ctx context.Context) error {
+					return w.RunTipChecks(ctx, sources,
+						int32(cfg.TipCheckMaxDivergence),
+						cfg.TipCheckInterval)
+				})
+		})
+	}
+
 	// When not running with --noinitialload, it is the main package's
 	// responsibility to synchronize the wallet with the network through SPV or
 	// the trusted dcrd server.  This blocks until cancelled.
@@ -426,9 +538,9 @@ func run(ctx context.Context) error {
 			case cfg.Offline:
 				w.SetNetworkBackend(wallet.OfflineNetworkBackend{})
 			case cfg.SPV:
-				spvLoop(ctx, w)
+				<-spvLoop(ctx, sup, w)
 			default:
-				rpcSyncLoop(ctx, w)
+				<-rpcSyncLoop(ctx, sup, w)
 			}
 		})
 	}
@@ -519,7 +631,10 @@ func startPromptPass(ctx context.Context, w *wallet.Wallet) []byte {
 	}
 }
 
-func spvLoop(ctx context.Context, w *wallet.Wallet) {
+// spvLoop associates the wallet with a SPV syncer and runs it under the
+// supervisor, which restarts synchronization after it fails.  The returned
+// channel is closed after synchronization has stopped.
+func spvLoop(ctx context.Context, sup *supervisor.Supervisor, w *wallet.Wallet) <-chan struct{} {
 	addr := &net.TCPAddr{IP: net.ParseIP("::1"), Port: 0}
 	amgrDir := filepath.Join(cfg.AppDataDir.Value, w.ChainParams().Name)
 	amgr := addrmgr.New(amgrDir, cfg.lookup)
@@ -530,29 +645,31 @@ func spvLoop(ctx context.Context, w *wallet.Wallet) {
 	if len(cfg.SPVConnect) > 0 {
 		syncer.SetPersistentPeers(cfg.SPVConnect)
 	}
+	syncer.SetRescanFetchers(cfg.SPVRescanFetchers)
 	w.SetNetworkBackend(syncer)
-	for {
-		err := syncer.Run(ctx)
-		if done(ctx) {
-			return
-		}
-		log.Errorf("SPV synchronization ended: %v", err)
-	}
+	return sup.Go(ctx, "sync", supervisor.Policy{MaxBackoff: time.Minute},
+		syncer.Run)
 }
 
-// rpcSyncLoop loops forever, attempting to create a connection to the
-// consensus RPC server.  If this connection succeeds, the RPC client is used as
-// the loaded wallet's network backend and used to keep the wallet synchronized
-// to the network.  If/when the RPC connection is lost, the wallet is
-// disassociated from the client and a new connection is attempmted.
-func rpcSyncLoop(ctx context.Context, w *wallet.Wallet) {
+// rpcSyncLoop runs a supervised subsystem which attempts to create a
+// connection to the consensus RPC server.  If this connection succeeds, the RPC
+// client is used as the loaded wallet's network backend and used to keep the
+// wallet synchronized to the network.  If/when the RPC connection is lost, the
+// wallet is disassociated from the client and the supervisor attempts a new
+// connection after a backoff.  The returned channel is closed after
+// synchronization has stopped.
+func rpcSyncLoop(ctx context.Context, sup *supervisor.Supervisor, w *wallet.Wallet) <-chan struct{} {
 	certs := readCAFile()
 	clientCert, clientKey := readClientCertKey()
 	dial := cfg.dial
 	if cfg.NoDcrdProxy {
 		dial = new(net.Dialer).DialContext
 	}
-	for {
+	policy := supervisor.Policy{
+		MinBackoff: 5 * time.Second,
+		MaxBackoff: time.Minute,
+	}
+	return sup.Go(ctx, "sync", policy, func(ctx context.Context) error {
 		rpcOptions := &chain.RPCOptions{
 			Address:     cfg.RPCConnect,
 			DefaultPort: activeNet.JSONRPCClientPort,
@@ -569,16 +686,8 @@ func rpcSyncLoop(ctx context.Context, w *wallet.Wallet) {
 			rpcOptions.ClientKey = clientKey
 		}
 		syncer := chain.NewSyncer(w, rpcOptions)
-		err := syncer.Run(ctx)
-		if err != nil {
-			loggers.SyncLog.Errorf("Wallet synchronization stopped: %v", err)
-			select {
-			case <-ctx.Done():
-				return
-			case <-time.After(5 * time.Second):
-			}
-		}
-	}
+		return syncer.Run(ctx)
+	})
 }
 
 func readCAFile() []byte {
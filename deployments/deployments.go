@@ -88,7 +88,8 @@ type Querier interface {
 // DCP0010Active returns whether the consensus rules for the next block with the
 // current chain tip height requires the subsidy split as specified in DCP0010.
 // DCP0010 is always active on simnet, and requires the RPC syncer to detect
-// activation on mainnet and testnet3.
+// activation on mainnet and testnet3.  On alternate networks, activation is
+// detected when the RPC syncer is used and is otherwise assumed inactive.
 func DCP0010Active(ctx context.Context, height int32, params *chaincfg.Params,
 	querier Querier) (bool, error) {
 
@@ -98,7 +99,7 @@ func DCP0010Active(ctx context.Context, height int32, params *chaincfg.Params,
 	if net == wire.SimNet {
 		return true, nil
 	}
-	if net != wire.MainNet && net != wire.TestNet3 {
+	if net != wire.MainNet && net != wire.TestNet3 && querier == nil {
 		return false, nil
 	}
 	if querier == nil {
@@ -125,14 +126,16 @@ func DCP0010Active(ctx context.Context, height int32, params *chaincfg.Params,
 // DCP0012Active returns whether the consensus rules for the next block with the
 // current chain tip height requires the version 2 subsidy split as specified in
 // DCP0012.  DCP0012 requires the RPC syncer to detect activation on mainnet,
-// testnet3 and simnet.
+// testnet3 and simnet.  On alternate networks, activation is detected when the
+// RPC syncer is used and is otherwise assumed inactive.
 func DCP0012Active(ctx context.Context, height int32, params *chaincfg.Params,
 	querier Querier) (bool, error) {
 
 	net := params.Net
 	rcai := int32(params.RuleChangeActivationInterval)
 
-	if net != wire.MainNet && net != wire.TestNet3 && net != wire.SimNet {
+	if net != wire.MainNet && net != wire.TestNet3 && net != wire.SimNet &&
+		querier == nil {
 		return false, nil
 	}
 	if querier == nil {
@@ -0,0 +1,164 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package addrcheck decodes payment addresses for the active network, and
+// describes why addresses which can not be decoded are invalid.
+package addrcheck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"decred.org/dcrwallet/v5/internal/netparams"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+var regNet = chaincfg.RegNetParams()
+
+// networks returns the networks which are checked to detect addresses
+// intended for another network.  These are the networks registered with the
+// netparams package, including any alternate networks, and regnet.
+func networks() []*chaincfg.Params {
+	registered := netparams.Registered()
+	nets := make([]*chaincfg.Params, 0, len(registered)+1)
+	for _, p := range registered {
+		nets = append(nets, p.Params)
+	}
+	return append(nets, regNet)
+}
+
+const (
+	// maxSuggestions limits the number of suggested corrections of a
+	// mistyped address.
+	maxSuggestions = 3
+
+	// maxSuggestLen is the longest address for which corrections are
+	// searched.  No supported address encoding is longer.
+	maxSuggestLen = 64
+
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// Error describes an address which is invalid for the active network.
+type Error struct {
+	// Address is the invalid address string.
+	Address string
+
+	// Network is the name of the active network.
+	Network string
+
+	// DetectedNetwork is the name of another network which the address is
+	// valid for, or empty if the address is not valid for any network.
+	DetectedNetwork string
+
+	// Suggestions are valid addresses for the active network which differ
+	// from the invalid address by a single mistyped character or by two
+	// swapped adjacent characters.
+	Suggestions []string
+
+	// Err is the error decoding the address for the active network.
+	Err error
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "invalid %s address %q: ", e.Network, e.Address)
+	if e.DetectedNetwork != "" {
+		fmt.Fprintf(&b, "address is for the %s network", e.DetectedNetwork)
+		return b.String()
+	}
+	b.WriteString(e.Err.Error())
+	if len(e.Suggestions) != 0 {
+		b.WriteString("; did you mean ")
+		for i, s := range e.Suggestions {
+			switch {
+			case i == 0:
+			case i == len(e.Suggestions)-1:
+				b.WriteString(" or ")
+			default:
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%q", s)
+		}
+		b.WriteString("?")
+	}
+	return b.String()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Decode decodes an address for the network described by params.  Addresses
+// which can not be decoded return an *Error describing any network the
+// address is intended for, or likely corrections when the address appears to
+// be mistyped.
+func Decode(s string, params *chaincfg.Params) (stdaddr.Address, error) {
+	addr, err := stdaddr.DecodeAddress(s, params)
+	if err == nil {
+		return addr, nil
+	}
+	e := &Error{Address: s, Network: params.Name, Err: err}
+	for _, p := range networks() {
+		if p.Net == params.Net {
+			continue
+		}
+		if _, err := stdaddr.DecodeAddress(s, p); err == nil {
+			e.DetectedNetwork = p.Name
+			return nil, e
+		}
+	}
+	e.Suggestions = suggestions(s, params)
+	return nil, e
+}
+
+// suggestions returns valid addresses for the network which differ from s by
+// a single substituted character, or by two swapped adjacent characters.
+func suggestions(s string, params *chaincfg.Params) []string {
+	if len(s) > maxSuggestLen {
+		return nil
+	}
+	found := make(map[string]struct{})
+	try := func(b []byte) {
+		v := string(b)
+		if _, err := stdaddr.DecodeAddress(v, params); err == nil {
+			found[v] = struct{}{}
+		}
+	}
+	b := []byte(s)
+	for i := range b {
+		orig := b[i]
+		for j := 0; j < len(base58Alphabet); j++ {
+			if base58Alphabet[j] == orig {
+				continue
+			}
+			b[i] = base58Alphabet[j]
+			try(b)
+		}
+		b[i] = orig
+	}
+	for i := 0; i+1 < len(b); i++ {
+		if b[i] == b[i+1] {
+			continue
+		}
+		b[i], b[i+1] = b[i+1], b[i]
+		try(b)
+		b[i], b[i+1] = b[i+1], b[i]
+	}
+
+	if len(found) == 0 {
+		return nil
+	}
+	sugg := make([]string, 0, len(found))
+	for v := range found {
+		sugg = append(sugg, v)
+	}
+	sort.Strings(sugg)
+	if len(sugg) > maxSuggestions {
+		sugg = sugg[:maxSuggestions]
+	}
+	return sugg
+}
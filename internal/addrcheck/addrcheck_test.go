@@ -0,0 +1,71 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package addrcheck
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+func TestDecode(t *testing.T) {
+	mainnet := chaincfg.MainNetParams()
+	testnet := chaincfg.TestNet3Params()
+	hash160 := make([]byte, 20)
+	for i := range hash160 {
+		hash160[i] = byte(i)
+	}
+	mainAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash160, mainnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testAddr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(hash160, testnet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := Decode(mainAddr.String(), mainnet)
+	if err != nil {
+		t.Fatalf("valid address: %v", err)
+	}
+	if addr.String() != mainAddr.String() {
+		t.Fatalf("decoded %v, want %v", addr, mainAddr)
+	}
+
+	_, err = Decode(testAddr.String(), mainnet)
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("wrong network: expected *Error, got %v", err)
+	}
+	if e.DetectedNetwork != testnet.Name {
+		t.Errorf("wrong network: detected %q, want %q", e.DetectedNetwork, testnet.Name)
+	}
+
+	// Mistype a character after the network prefix.
+	typo := []byte(mainAddr.String())
+	if typo[10] == 'x' {
+		typo[10] = 'y'
+	} else {
+		typo[10] = 'x'
+	}
+	_, err = Decode(string(typo), mainnet)
+	if !errors.As(err, &e) {
+		t.Fatalf("typo: expected *Error, got %v", err)
+	}
+	if e.DetectedNetwork != "" {
+		t.Errorf("typo: detected network %q", e.DetectedNetwork)
+	}
+	found := false
+	for _, s := range e.Suggestions {
+		if s == mainAddr.String() {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("typo: suggestions %q do not include %v", e.Suggestions, mainAddr)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package extsigner implements wallet.ExternalSigner by executing an external
+// command which communicates with a signing device.
+//
+// For each request, the command is executed with the request method appended
+// to its arguments (e.g. "displayaddress"), and the JSON-encoded request is
+// written to its standard input.  The command must write a JSON object
+// describing the result to its standard output and exit with status zero.
+package extsigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os/exec"
+	"strings"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet"
+)
+
+// Command is an external signer implemented by an external command.
+type Command struct {
+	path string
+	args []string
+}
+
+var _ wallet.ExternalSigner = (*Command)(nil)
+
+// New returns an external signer which executes the command line cmd.  The
+// command line is split into the program and its arguments on whitespace.
+func New(cmd string) (*Command, error) {
+	fields := strings.Fields(cmd)
+	if len(fields) == 0 {
+		return nil, errors.E(errors.Invalid, "empty external signer command")
+	}
+	return &Command{path: fields[0], args: fields[1:]}, nil
+}
+
+func (c *Command) run(ctx context.Context, method string, req, res any) error {
+	stdin, err := json.Marshal(req)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	args := append(c.args[:len(c.args):len(c.args)], method)
+	cmd := exec.CommandContext(ctx, c.path, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg != "" {
+			return errors.E(errors.IO, errors.Errorf("external signer: %v: %s", err, msg))
+		}
+		return errors.E(errors.IO, errors.Errorf("external signer: %v", err))
+	}
+	err = json.Unmarshal(stdout.Bytes(), res)
+	if err != nil {
+		return errors.E(errors.Encoding, errors.Errorf("external signer: %v", err))
+	}
+	return nil
+}
+
+// DisplayAddress implements the DisplayAddress method of the
+// wallet.ExternalSigner interface.  The command must respond with a JSON
+// object with a boolean "confirmed" field.
+func (c *Command) DisplayAddress(ctx context.Context, req *wallet.DisplayAddressRequest) (bool, error) {
+	var res struct {
+		Confirmed bool `json:"confirmed"`
+	}
+	err := c.run(ctx, "displayaddress", req, &res)
+	if err != nil {
+		return false, err
+	}
+	return res.Confirmed, nil
+}
@@ -47,6 +47,10 @@ type Loader struct {
 	manualTickets           bool
 	relayFee                dcrutil.Amount
 	mixSplitLimit           int
+	maxTxOutputs            int
+	maxTxSize               int
+	inputTree               wallet.InputTree
+	voteFeeReserve          dcrutil.Amount
 	dialer                  wallet.DialFunc
 
 	mu sync.Mutex
@@ -55,7 +59,9 @@ type Loader struct {
 // NewLoader constructs a Loader.
 func NewLoader(chainParams *chaincfg.Params, dbDirPath string, votingEnabled bool, gapLimit uint32,
 	watchLast uint32, allowHighFees bool, relayFee dcrutil.Amount, accountGapLimit int,
-	disableCoinTypeUpgrades bool, disableMixing bool, manualTickets bool, mixSplitLimit int, dialer wallet.DialFunc) *Loader {
+	disableCoinTypeUpgrades bool, disableMixing bool, manualTickets bool, mixSplitLimit int,
+	maxTxOutputs int, maxTxSize int, inputTree wallet.InputTree, voteFeeReserve dcrutil.Amount,
+	dialer wallet.DialFunc) *Loader {
 
 	return &Loader{
 		chainParams:             chainParams,
@@ -70,6 +76,10 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string, votingEnabled boo
 		manualTickets:           manualTickets,
 		relayFee:                relayFee,
 		mixSplitLimit:           mixSplitLimit,
+		maxTxOutputs:            maxTxOutputs,
+		maxTxSize:               maxTxSize,
+		inputTree:               inputTree,
+		voteFeeReserve:          voteFeeReserve,
 		dialer:                  dialer,
 	}
 }
@@ -176,6 +186,10 @@ func (l *Loader) CreateWatchingOnlyWallet(ctx context.Context, extendedPubKey st
 		ManualTickets:           l.manualTickets,
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
+		MaxTxOutputs:            l.maxTxOutputs,
+		MaxTxSize:               l.maxTxSize,
+		InputTree:               l.inputTree,
+		VoteFeeReserve:          l.voteFeeReserve,
 		MixSplitLimit:           l.mixSplitLimit,
 		Params:                  l.chainParams,
 		Dialer:                  l.dialer,
@@ -264,6 +278,10 @@ func (l *Loader) CreateNewWallet(ctx context.Context, pubPassphrase, privPassphr
 		ManualTickets:           l.manualTickets,
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
+		MaxTxOutputs:            l.maxTxOutputs,
+		MaxTxSize:               l.maxTxSize,
+		InputTree:               l.inputTree,
+		VoteFeeReserve:          l.voteFeeReserve,
 		Params:                  l.chainParams,
 		Dialer:                  l.dialer,
 	}
@@ -321,6 +339,10 @@ func (l *Loader) OpenExistingWallet(ctx context.Context, pubPassphrase []byte) (
 		ManualTickets:           l.manualTickets,
 		AllowHighFees:           l.allowHighFees,
 		RelayFee:                l.relayFee,
+		MaxTxOutputs:            l.maxTxOutputs,
+		MaxTxSize:               l.maxTxSize,
+		InputTree:               l.inputTree,
+		VoteFeeReserve:          l.voteFeeReserve,
 		MixSplitLimit:           l.mixSplitLimit,
 		Params:                  l.chainParams,
 		Dialer:                  l.dialer,
@@ -0,0 +1,175 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netparams
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+var (
+	registeredMu sync.Mutex
+	registered   = []*Params{&MainNetParams, &TestNet3Params, &SimNetParams}
+)
+
+// Registered returns the parameters of the built-in networks and of all
+// networks added with Register.
+func Registered() []*Params {
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+	return append([]*Params(nil), registered...)
+}
+
+// Register adds the parameters of an alternate network.  The network name,
+// magic, address prefixes and HD key IDs must not be shared with any
+// registered network, so that addresses and extended keys of the network can
+// not be mistaken for those of another.
+func Register(p *Params) error {
+	registeredMu.Lock()
+	defer registeredMu.Unlock()
+
+	for _, r := range registered {
+		switch {
+		case r.Name == p.Name:
+			return fmt.Errorf("network name %q is already registered", p.Name)
+		case r.Net == p.Net:
+			return fmt.Errorf("network magic %v is already used by %s",
+				p.Net, r.Name)
+		case r.NetworkAddressPrefix == p.NetworkAddressPrefix:
+			return fmt.Errorf("address prefix %q is already used by %s",
+				p.NetworkAddressPrefix, r.Name)
+		case r.PubKeyHashAddrID == p.PubKeyHashAddrID ||
+			r.ScriptHashAddrID == p.ScriptHashAddrID:
+			return fmt.Errorf("address IDs are already used by %s", r.Name)
+		case r.HDPublicKeyID == p.HDPublicKeyID ||
+			r.HDPrivateKeyID == p.HDPrivateKeyID:
+			return fmt.Errorf("HD key IDs are already used by %s", r.Name)
+		}
+	}
+	registered = append(registered, p)
+	return nil
+}
+
+// paramsFile describes an alternate network.  Consensus rules, the genesis
+// block and deployments are those of the base network; all other fields
+// override the base network's parameters.
+type paramsFile struct {
+	Base              string `json:"base"`
+	Name              string `json:"name"`
+	Net               uint32 `json:"net"`
+	DefaultPort       string `json:"defaultport"`
+	JSONRPCClientPort string `json:"jsonrpcclientport"`
+	JSONRPCServerPort string `json:"jsonrpcserverport"`
+	GRPCServerPort    string `json:"grpcserverport"`
+
+	NetworkAddressPrefix string `json:"networkaddressprefix"`
+	PubKeyAddrID         string `json:"pubkeyaddrid"`
+	PubKeyHashAddrID     string `json:"pubkeyhashaddrid"`
+	PKHEdwardsAddrID     string `json:"pkhedwardsaddrid"`
+	PKHSchnorrAddrID     string `json:"pkhschnorraddrid"`
+	ScriptHashAddrID     string `json:"scripthashaddrid"`
+	PrivateKeyID         string `json:"privatekeyid"`
+	HDPrivateKeyID       string `json:"hdprivatekeyid"`
+	HDPublicKeyID        string `json:"hdpublickeyid"`
+
+	SLIP0044CoinType *uint32 `json:"slip0044cointype"`
+	LegacyCoinType   *uint32 `json:"legacycointype"`
+}
+
+// decodeID decodes the hex encoded ID s into id.
+func decodeID(id []byte, s, field string) error {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(id) {
+		return fmt.Errorf("%s must be %d hex encoded bytes", field, len(id))
+	}
+	copy(id, b)
+	return nil
+}
+
+// LoadFile reads the JSON parameters file of an alternate network.  The base
+// field names the network ("mainnet", "testnet3", "simnet" or "regnet") whose
+// consensus parameters are used, and the name, net, address prefix and
+// IDs, and ports of the network are required.  The coin types default to
+// those of the base network.  The returned parameters are not registered.
+func LoadFile(path string) (*Params, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f paramsFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var base *chaincfg.Params
+	switch f.Base {
+	case "mainnet":
+		base = chaincfg.MainNetParams()
+	case "testnet3":
+		base = chaincfg.TestNet3Params()
+	case "simnet":
+		base = chaincfg.SimNetParams()
+	case "regnet":
+		base = chaincfg.RegNetParams()
+	default:
+		return nil, fmt.Errorf("%s: unknown base network %q", path, f.Base)
+	}
+
+	switch {
+	case f.Name == "":
+		return nil, fmt.Errorf("%s: missing network name", path)
+	case f.Net == 0:
+		return nil, fmt.Errorf("%s: missing network magic", path)
+	case f.DefaultPort == "" || f.JSONRPCClientPort == "" ||
+		f.JSONRPCServerPort == "" || f.GRPCServerPort == "":
+		return nil, fmt.Errorf("%s: missing network ports", path)
+	case len(f.NetworkAddressPrefix) != 1:
+		return nil, fmt.Errorf("%s: network address prefix must be a "+
+			"single character", path)
+	}
+	base.Name = f.Name
+	base.Net = wire.CurrencyNet(f.Net)
+	base.DefaultPort = f.DefaultPort
+	base.DNSSeeds = nil
+	base.NetworkAddressPrefix = f.NetworkAddressPrefix
+	ids := []struct {
+		id    []byte
+		s     string
+		field string
+	}{
+		{base.PubKeyAddrID[:], f.PubKeyAddrID, "pubkeyaddrid"},
+		{base.PubKeyHashAddrID[:], f.PubKeyHashAddrID, "pubkeyhashaddrid"},
+		{base.PKHEdwardsAddrID[:], f.PKHEdwardsAddrID, "pkhedwardsaddrid"},
+		{base.PKHSchnorrAddrID[:], f.PKHSchnorrAddrID, "pkhschnorraddrid"},
+		{base.ScriptHashAddrID[:], f.ScriptHashAddrID, "scripthashaddrid"},
+		{base.PrivateKeyID[:], f.PrivateKeyID, "privatekeyid"},
+		{base.HDPrivateKeyID[:], f.HDPrivateKeyID, "hdprivatekeyid"},
+		{base.HDPublicKeyID[:], f.HDPublicKeyID, "hdpublickeyid"},
+	}
+	for _, id := range ids {
+		if err := decodeID(id.id, id.s, id.field); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	if f.SLIP0044CoinType != nil {
+		base.SLIP0044CoinType = *f.SLIP0044CoinType
+	}
+	if f.LegacyCoinType != nil {
+		base.LegacyCoinType = *f.LegacyCoinType
+	}
+
+	return &Params{
+		Params:            base,
+		JSONRPCClientPort: f.JSONRPCClientPort,
+		JSONRPCServerPort: f.JSONRPCServerPort,
+		GRPCServerPort:    f.GRPCServerPort,
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package netparams
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeParamsFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "params.json")
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const altNetFile = `{
+	"base": "simnet",
+	"name": "altnet",
+	"net": 3735928559,
+	"defaultport": "28555",
+	"jsonrpcclientport": "28556",
+	"jsonrpcserverport": "28557",
+	"grpcserverport": "28558",
+	"networkaddressprefix": "A",
+	"pubkeyaddrid": "1a01",
+	"pubkeyhashaddrid": "1a02",
+	"pkhedwardsaddrid": "1a03",
+	"pkhschnorraddrid": "1a04",
+	"scripthashaddrid": "1a05",
+	"privatekeyid": "1a06",
+	"hdprivatekeyid": "1a1a0001",
+	"hdpublickeyid": "1a1a0002",
+	"slip0044cointype": 4242
+}`
+
+func TestLoadFile(t *testing.T) {
+	p, err := LoadFile(writeParamsFile(t, altNetFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "altnet" || p.Net != 3735928559 {
+		t.Errorf("unexpected network %s (%v)", p.Name, p.Net)
+	}
+	if p.PubKeyHashAddrID != [2]byte{0x1a, 0x02} {
+		t.Errorf("unexpected P2PKH address ID %x", p.PubKeyHashAddrID)
+	}
+	if p.HDPublicKeyID != [4]byte{0x1a, 0x1a, 0x00, 0x02} {
+		t.Errorf("unexpected HD public key ID %x", p.HDPublicKeyID)
+	}
+	if p.SLIP0044CoinType != 4242 {
+		t.Errorf("unexpected SLIP0044 coin type %d", p.SLIP0044CoinType)
+	}
+	if p.LegacyCoinType != SimNetParams.LegacyCoinType {
+		t.Errorf("legacy coin type %d does not default to base network's %d",
+			p.LegacyCoinType, SimNetParams.LegacyCoinType)
+	}
+	if SimNetParams.Name != "simnet" {
+		t.Errorf("base network parameters were modified")
+	}
+
+	if err := Register(p); err != nil {
+		t.Fatal(err)
+	}
+	if err := Register(p); err == nil {
+		t.Errorf("registered duplicate network")
+	}
+	found := false
+	for _, r := range Registered() {
+		found = found || r == p
+	}
+	if !found {
+		t.Errorf("registered network is missing")
+	}
+}
+
+func TestLoadFileInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{"unknown base", `{"base": "fakenet"}`},
+		{"missing name", `{"base": "simnet", "net": 1}`},
+		{"bad json", `{`},
+	}
+	for _, test := range tests {
+		_, err := LoadFile(writeParamsFile(t, test.contents))
+		if err == nil {
+			t.Errorf("%s: loaded invalid parameters file", test.name)
+		}
+	}
+}
+
+func TestRegisterConflicts(t *testing.T) {
+	p, err := LoadFile(writeParamsFile(t, altNetFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Name = "conflictnet"
+	p.Net = 1
+	p.NetworkAddressPrefix = "C"
+	p.PubKeyHashAddrID = MainNetParams.PubKeyHashAddrID
+	if err := Register(p); err == nil {
+		t.Errorf("registered network using mainnet address IDs")
+	}
+}
@@ -260,8 +260,10 @@ func PublicPass(reader *bufio.Reader, privPass []byte,
 // the user along with prompting them for confirmation.  When the user answers
 // yes, a the user is prompted for it.  All prompts are repeated until the user
 // enters a valid response. The bool returned indicates if the wallet was
-// restored from a given seed or not.
-func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
+// restored from a given seed or not.  Generated seeds are shown, and entered
+// seeds are decoded, using the mnemonic format; entered seeds may also be
+// tagged with another format.
+func Seed(reader *bufio.Reader, format walletseed.Format) (seed []byte, imported bool, err error) {
 	// Ascertain the wallet generation seed.
 	useUserSeed, err := promptListBool(reader, "Do you have an "+
 		"existing wallet seed you want to use?", "no")
@@ -274,16 +276,30 @@ func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 			return nil, false, err
 		}
 
-		seedStrSplit := walletseed.EncodeMnemonicSlice(seed)
+		seedStr, err := walletseed.EncodeMnemonicFormat(seed, format)
+		if err != nil {
+			return nil, false, err
+		}
+		seedStrSplit := strings.Fields(seedStr)
 
-		fmt.Println("Your wallet generation seed is:")
-		for i := 0; i < hdkeychain.RecommendedSeedLen+1; i++ {
+		if format == walletseed.FormatPGP {
+			fmt.Println("Your wallet generation seed is:")
+		} else {
+			fmt.Printf("Your wallet generation seed (%s format) is:\n", format)
+		}
+		for i := range seedStrSplit {
 			fmt.Printf("%v ", seedStrSplit[i])
 
 			if (i+1)%6 == 0 {
 				fmt.Printf("\n")
 			}
 		}
+		if format != walletseed.FormatPGP {
+			fmt.Printf("\nThis seed can only be restored by wallets "+
+				"supporting the %s seed format.  It can not be\n"+
+				"restored by other BIP0039 wallets, which derive "+
+				"different keys from the same words.\n", format)
+		}
 
 		fmt.Printf("\n\nHex: %x\n", seed)
 		fmt.Println("IMPORTANT: Keep the seed in a safe place as you\n" +
@@ -348,7 +364,7 @@ func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 				fmt.Printf("Input error: %v\n", err.Error())
 			}
 		} else {
-			seed, err = walletseed.DecodeUserInput(seedStrTrimmed)
+			seed, err = walletseed.DecodeUserInputFormat(seedStrTrimmed, format)
 			if err != nil {
 				fmt.Printf("Input error: %v\n", err.Error())
 			}
@@ -356,7 +372,9 @@ func Seed(reader *bufio.Reader) (seed []byte, imported bool, err error) {
 		if err != nil || len(seed) < hdkeychain.MinSeedBytes ||
 			len(seed) > hdkeychain.MaxSeedBytes {
 			fmt.Printf("Invalid seed specified.  Must be a "+
-				"word seed (usually 33 words) using the PGP wordlist or "+
+				"word seed (usually 33 words) using the PGP wordlist, "+
+				"a BIP0039 word seed tagged with its format (e.g. "+
+				"\"bip39-english: abandon ...\") or "+
 				"hexadecimal value that is at least %d bits and "+
 				"at most %d bits\n", hdkeychain.MinSeedBytes*8,
 				hdkeychain.MaxSeedBytes*8)
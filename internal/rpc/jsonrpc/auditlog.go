@@ -0,0 +1,183 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// AuditLog is an append-only log of mutating JSON-RPC requests.  Each request
+// is recorded as a single line of JSON.
+type AuditLog struct {
+	path string
+	file *os.File
+	mu   sync.Mutex
+}
+
+// OpenAuditLog opens the audit log at path for appending, creating the file
+// if it does not exist.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	const op errors.Op = "jsonrpc.OpenAuditLog"
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	return &AuditLog{path: path, file: f}, nil
+}
+
+// Close closes the audit log file.
+func (l *AuditLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// append writes an entry to the end of the log and syncs it to disk.
+func (l *AuditLog) append(e *types.AuditLogEntryResult) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.file.Write(b)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = l.file.Sync()
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// entries returns up to count log entries, oldest first, after skipping the
+// from most recent entries.
+func (l *AuditLog) entries(count, from int) ([]types.AuditLogEntryResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	defer f.Close()
+
+	// Only the most recent count+from entries are retained while reading.
+	var window []types.AuditLogEntryResult
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 16*1024*1024)
+	for s.Scan() {
+		var e types.AuditLogEntryResult
+		err := json.Unmarshal(s.Bytes(), &e)
+		if err != nil {
+			return nil, errors.E(errors.Encoding, err)
+		}
+		if len(window) == count+from {
+			if len(window) == 0 {
+				continue
+			}
+			copy(window, window[1:])
+			window = window[:len(window)-1]
+		}
+		window = append(window, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	if len(window) <= from {
+		return []types.AuditLogEntryResult{}, nil
+	}
+	return window[:len(window)-from], nil
+}
+
+// redactedParams describes the indexes of secret parameters of audited
+// methods.  These are never written to the audit log.
+var redactedParams = map[string][]int{
+	"importlegacykeys":          {0},
+	"importprivkey":             {0},
+	"importticket":              {0},
+	"setaccountpassphrase":      {1},
+	"setvotingpassphrase":       {0},
+	"unlockaccount":             {1},
+	"walletpassphrase":          {0},
+	"walletpassphrasechange":    {0, 1},
+	"walletpubpassphrasechange": {0, 1},
+}
+
+// sanitizeParams returns the JSON encoding of each request parameter, with
+// secret parameters replaced.
+func sanitizeParams(method string, params []json.RawMessage) []string {
+	sanitized := make([]string, len(params))
+	for i := range params {
+		sanitized[i] = string(params[i])
+	}
+	for _, i := range redactedParams[method] {
+		if i < len(sanitized) {
+			sanitized[i] = `"(redacted)"`
+		}
+	}
+	return sanitized
+}
+
+// auditTxIDs returns the transaction hashes included in a method result.
+func auditTxIDs(result any) []string {
+	var candidates []string
+	switch r := result.(type) {
+	case string:
+		candidates = []string{r}
+	case []string:
+		candidates = r
+	case *chainhash.Hash:
+		return []string{r.String()}
+	}
+	var txids []string
+	for _, s := range candidates {
+		if len(s) != chainhash.MaxHashStringSize {
+			continue
+		}
+		if _, err := chainhash.NewHashFromStr(s); err == nil {
+			txids = append(txids, s)
+		}
+	}
+	return txids
+}
+
+// audit records a mutating request and its outcome to the audit log, if one
+// is configured.
+func (s *Server) audit(ctx context.Context, method string, params []json.RawMessage,
+	result any, err error) {
+
+	if s.cfg.AuditLog == nil {
+		return
+	}
+	e := &types.AuditLogEntryResult{
+		Time:        time.Now().Unix(),
+		Method:      method,
+		Params:      sanitizeParams(method, params),
+		Client:      remoteAddr(ctx),
+		Certificate: clientCertificate(ctx),
+	}
+	if err != nil {
+		e.Error = err.Error()
+	} else {
+		e.TxIDs = auditTxIDs(result)
+	}
+	if err := s.cfg.AuditLog.append(e); err != nil {
+		log.Errorf("Failed to record %v request by %v to the audit log: %v",
+			method, e.Client, err)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
+)
+
+func TestAuditLogEntries(t *testing.T) {
+	l, err := OpenAuditLog(filepath.Join(t.TempDir(), "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	methods := []string{"sendtoaddress", "walletlock", "settxfee", "lockunspent"}
+	for _, m := range methods {
+		err := l.append(&types.AuditLogEntryResult{Method: m})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		count, from int
+		want        []string
+	}{
+		{10, 0, methods},
+		{2, 0, methods[2:]},
+		{2, 1, methods[1:3]},
+		{10, 3, methods[:1]},
+		{10, 4, nil},
+		{0, 0, nil},
+	}
+	for _, test := range tests {
+		entries, err := l.entries(test.count, test.from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, e := range entries {
+			got = append(got, e.Method)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("entries(%d, %d) = %v, want %v", test.count,
+				test.from, got, test.want)
+		}
+	}
+}
+
+func TestSanitizeParams(t *testing.T) {
+	params := []json.RawMessage{
+		json.RawMessage(`"old secret"`),
+		json.RawMessage(`"new secret"`),
+	}
+	got := sanitizeParams("walletpassphrasechange", params)
+	want := []string{`"(redacted)"`, `"(redacted)"`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("walletpassphrasechange params = %v, want %v", got, want)
+	}
+
+	params = []json.RawMessage{
+		json.RawMessage(`"Dsaddr"`),
+		json.RawMessage(`1.5`),
+	}
+	got = sanitizeParams("sendtoaddress", params)
+	want = []string{`"Dsaddr"`, `1.5`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sendtoaddress params = %v, want %v", got, want)
+	}
+}
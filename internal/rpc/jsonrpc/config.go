@@ -8,6 +8,8 @@ import (
 	"context"
 	"net"
 
+	"decred.org/dcrwallet/v5/internal/supervisor"
+	"decred.org/dcrwallet/v5/wallet"
 	"github.com/decred/dcrd/dcrutil/v4"
 )
 
@@ -29,4 +31,38 @@ type Options struct {
 	VSPPubKey string
 	VSPMaxFee dcrutil.Amount
 	Dial      func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// ExternalSigner, if non-nil, is used to verify addresses with an
+	// external signing device.
+	ExternalSigner wallet.ExternalSigner
+
+	// AuditLog, if non-nil, records every mutating request.
+	AuditLog *AuditLog
+
+	// OmitFloatAmounts removes the floating point DCR amounts from results
+	// which also report the amount in atoms, leaving only the integer
+	// atom fields.
+	OmitFloatAmounts bool
+
+	// ReloadConfig, if non-nil, reloads the configuration of the process
+	// and applies the subset of changed options which may be changed while
+	// running.
+	ReloadConfig func() (*ConfigReload, error)
+
+	// Supervisor, if non-nil, runs the long-running subsystems of the
+	// process and reports their status in walletinfo results.
+	Supervisor *supervisor.Supervisor
+}
+
+// ConfigReload describes the changed options found by a configuration reload.
+type ConfigReload struct {
+	Applied         []string // Options applied to the running process
+	RestartRequired []string // Options which take effect after a restart
+	Failed          []ConfigReloadError
+}
+
+// ConfigReloadError describes a changed option which could not be applied.
+type ConfigReloadError struct {
+	Option string
+	Err    error
 }
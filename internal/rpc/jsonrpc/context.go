@@ -12,6 +12,29 @@ func withRemoteAddr(parent context.Context, remoteAddr string) context.Context {
 	return context.WithValue(parent, contextKey("remote-addr"), remoteAddr)
 }
 
+func withClientCertificate(parent context.Context, subject string) context.Context {
+	return context.WithValue(parent, contextKey("client-certificate"), subject)
+}
+
+// clientCertificate returns the subject common name of the TLS client
+// certificate which authenticated the request, or the empty string when no
+// client certificate was used.
+func clientCertificate(ctx context.Context) string {
+	subject, _ := ctx.Value(contextKey("client-certificate")).(string)
+	return subject
+}
+
+func withWebsocketClient(parent context.Context, wsc *websocketClient) context.Context {
+	return context.WithValue(parent, contextKey("websocket-client"), wsc)
+}
+
+// websocketClientFromContext returns the websocket client which sent the
+// request, or nil for HTTP POST requests.
+func websocketClientFromContext(ctx context.Context) *websocketClient {
+	wsc, _ := ctx.Value(contextKey("websocket-client")).(*websocketClient)
+	return wsc
+}
+
 func remoteAddr(ctx context.Context) string {
 	v := ctx.Value(contextKey("remote-addr"))
 	if v == nil {
@@ -46,3 +46,41 @@ func addressStringsMarshaler(addrs []string) json.Marshaler {
 		return addrs[i]
 	})
 }
+
+// omitFloatAmounts returns the JSON encoding of v with all floating point DCR
+// amounts removed.  An amount is recognized as any object member k with a
+// sibling member named k+"atoms", which already reports the same amount as an
+// integer count of atoms.  Results which are not objects or arrays of objects
+// (for example, a single float amount or a map of account names to amounts)
+// are encoded unchanged.
+func omitFloatAmounts(v any) (json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return nil, err
+	}
+	stripFloatAmounts(generic)
+	return json.Marshal(generic)
+}
+
+func stripFloatAmounts(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if _, ok := v[k+"atoms"]; ok {
+				delete(v, k)
+				continue
+			}
+			stripFloatAmounts(e)
+		}
+	case []any:
+		for _, e := range v {
+			stripFloatAmounts(e)
+		}
+	}
+}
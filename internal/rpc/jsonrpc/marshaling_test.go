@@ -0,0 +1,70 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
+)
+
+func TestOmitFloatAmounts(t *testing.T) {
+	fee := 0.0001
+	feeAtoms := int64(10000)
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{{
+		name: "object",
+		v: &types.FundRawTransactionResult{
+			Hex:      "00",
+			Fee:      0.0001,
+			FeeAtoms: 10000,
+		},
+		want: `{"feeatoms":10000,"hex":"00"}`,
+	}, {
+		name: "array",
+		v: []types.ListReceivedByAccountResult{{
+			Account:       "default",
+			Amount:        1.5,
+			AmountAtoms:   150000000,
+			Confirmations: 6,
+		}},
+		want: `[{"account":"default","amountatoms":150000000,"confirmations":6}]`,
+	}, {
+		name: "nested optional amounts",
+		v: &types.GetTransactionResult{
+			Details: []types.GetTransactionDetailsResult{{
+				Category: "send",
+				Fee:      &fee,
+				FeeAtoms: &feeAtoms,
+			}},
+		},
+		want: `{"amountatoms":0,"blockhash":"","blockindex":0,` +
+			`"blocktime":0,"confirmations":0,"details":[{"account":"",` +
+			`"amountatoms":0,"category":"send","feeatoms":10000,"vout":0}],` +
+			`"hex":"","time":0,"timereceived":0,"txid":"","type":"",` +
+			`"walletconflicts":null}`,
+	}, {
+		name: "scalar",
+		v:    1.5,
+		want: `1.5`,
+	}, {
+		name: "map of amounts",
+		v:    map[string]float64{"default": 1.5},
+		want: `{"default":1.5}`,
+	}}
+	for _, test := range tests {
+		got, err := omitFloatAmounts(test.v)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if string(got) != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+}
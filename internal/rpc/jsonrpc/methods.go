@@ -13,7 +13,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/big"
+	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -22,6 +25,7 @@ import (
 
 	"decred.org/dcrwallet/v5/chain"
 	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/addrcheck"
 	"decred.org/dcrwallet/v5/p2p"
 	"decred.org/dcrwallet/v5/rpc/client/dcrd"
 	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
@@ -53,9 +57,9 @@ import (
 
 // API version constants
 const (
-	jsonrpcSemverString = "10.0.0"
+	jsonrpcSemverString = "10.6.0"
 	jsonrpcSemverMajor  = 10
-	jsonrpcSemverMinor  = 0
+	jsonrpcSemverMinor  = 21
 	jsonrpcSemverPatch  = 0
 )
 
@@ -83,26 +87,39 @@ func confirms(txHeight, curHeight int32) int32 {
 
 // the registered rpc handlers
 var handlers = map[string]handler{
-	"abandontransaction":        {fn: (*Server).abandonTransaction},
+	"abandontransaction":        {fn: (*Server).abandonTransaction, mutates: true},
 	"accountaddressindex":       {fn: (*Server).accountAddressIndex},
-	"accountsyncaddressindex":   {fn: (*Server).accountSyncAddressIndex},
+	"accountspendpolicy":        {fn: (*Server).accountSpendPolicy},
+	"accountsyncaddressindex":   {fn: (*Server).accountSyncAddressIndex, mutates: true},
 	"accountunlocked":           {fn: (*Server).accountUnlocked},
-	"addmultisigaddress":        {fn: (*Server).addMultiSigAddress},
-	"addtransaction":            {fn: (*Server).addTransaction},
+	"addcontact":                {fn: (*Server).addContact, mutates: true},
+	"addmultisigaddress":        {fn: (*Server).addMultiSigAddress, mutates: true},
+	"addtransaction":            {fn: (*Server).addTransaction, mutates: true},
 	"auditreuse":                {fn: (*Server).auditReuse},
-	"consolidate":               {fn: (*Server).consolidate},
+	"consolidate":               {fn: (*Server).consolidate, usesKeys: true, mutates: true},
 	"createmultisig":            {fn: (*Server).createMultiSig},
-	"createnewaccount":          {fn: (*Server).createNewAccount},
+	"createnewaccount":          {fn: (*Server).createNewAccount, usesKeys: true, mutates: true},
+	"createpaymenttemplate":     {fn: (*Server).createPaymentTemplate, mutates: true},
 	"createrawtransaction":      {fn: (*Server).createRawTransaction},
-	"createsignature":           {fn: (*Server).createSignature},
+	"createsignature":           {fn: (*Server).createSignature, usesKeys: true},
+	"deletecontact":             {fn: (*Server).deleteContact, mutates: true},
+	"deletepaymenttemplate":     {fn: (*Server).deletePaymentTemplate, mutates: true},
 	"disapprovepercent":         {fn: (*Server).disapprovePercent},
-	"discoverusage":             {fn: (*Server).discoverUsage},
-	"dumpprivkey":               {fn: (*Server).dumpPrivKey},
+	"discoverusage":             {fn: (*Server).discoverUsage, usesKeys: true, mutates: true},
+	"droptransactionhistory":    {fn: (*Server).dropTransactionHistory, mutates: true},
+	"dumpprivkey":               {fn: (*Server).dumpPrivKey, usesKeys: true},
+	"dumpwallet":                {fn: (*Server).dumpWallet, usesKeys: true},
+	"executesweepplan":          {fn: (*Server).executeSweepPlan, usesKeys: true, mutates: true},
+	"executetemplate":           {fn: (*Server).executeTemplate, usesKeys: true, mutates: true},
+	"exportticket":              {fn: (*Server).exportTicket, usesKeys: true},
 	"fundrawtransaction":        {fn: (*Server).fundRawTransaction},
 	"getaccount":                {fn: (*Server).getAccount},
 	"getaccountaddress":         {fn: (*Server).getAccountAddress},
 	"getaddressesbyaccount":     {fn: (*Server).getAddressesByAccount},
+	"getattestation":            {fn: (*Server).getAttestation},
+	"getauditlog":               {fn: (*Server).getAuditLog},
 	"getbalance":                {fn: (*Server).getBalance},
+	"getbalancehistory":         {fn: (*Server).getBalanceHistory},
 	"getbestblock":              {fn: (*Server).getBestBlock},
 	"getbestblockhash":          {fn: (*Server).getBestBlockHash},
 	"getblockcount":             {fn: (*Server).getBlockCount},
@@ -119,72 +136,92 @@ var handlers = map[string]handler{
 	"getrawchangeaddress":       {fn: (*Server).getRawChangeAddress},
 	"getreceivedbyaccount":      {fn: (*Server).getReceivedByAccount},
 	"getreceivedbyaddress":      {fn: (*Server).getReceivedByAddress},
+	"getstakeearnings":          {fn: (*Server).getStakeEarnings},
 	"getstakeinfo":              {fn: (*Server).getStakeInfo},
 	"gettickets":                {fn: (*Server).getTickets},
 	"gettransaction":            {fn: (*Server).getTransaction},
 	"gettxout":                  {fn: (*Server).getTxOut},
 	"getunconfirmedbalance":     {fn: (*Server).getUnconfirmedBalance},
+	"getutxostats":              {fn: (*Server).getUTXOStats},
 	"getvotechoices":            {fn: (*Server).getVoteChoices},
 	"getwalletfee":              {fn: (*Server).getWalletFee},
 	"help":                      {fn: (*Server).help},
 	"getcfilterv2":              {fn: (*Server).getCFilterV2},
-	"importcfiltersv2":          {fn: (*Server).importCFiltersV2},
-	"importprivkey":             {fn: (*Server).importPrivKey},
-	"importpubkey":              {fn: (*Server).importPubKey},
-	"importscript":              {fn: (*Server).importScript},
-	"importxpub":                {fn: (*Server).importXpub},
+	"importcfiltersv2":          {fn: (*Server).importCFiltersV2, mutates: true},
+	"importprivkey":             {fn: (*Server).importPrivKey, usesKeys: true, mutates: true},
+	"importlegacykeys":          {fn: (*Server).importLegacyKeys, usesKeys: true, mutates: true},
+	"importpubkey":              {fn: (*Server).importPubKey, mutates: true},
+	"importscript":              {fn: (*Server).importScript, mutates: true},
+	"importticket":              {fn: (*Server).importTicket, usesKeys: true, mutates: true},
+	"importxpub":                {fn: (*Server).importXpub, mutates: true},
 	"listaccounts":              {fn: (*Server).listAccounts},
 	"listaddresstransactions":   {fn: (*Server).listAddressTransactions},
 	"listalltransactions":       {fn: (*Server).listAllTransactions},
+	"listcontacts":              {fn: (*Server).listContacts},
 	"listlockunspent":           {fn: (*Server).listLockUnspent},
+	"listpaymenttemplates":      {fn: (*Server).listPaymentTemplates},
 	"listreceivedbyaccount":     {fn: (*Server).listReceivedByAccount},
 	"listreceivedbyaddress":     {fn: (*Server).listReceivedByAddress},
 	"listsinceblock":            {fn: (*Server).listSinceBlock},
 	"listtransactions":          {fn: (*Server).listTransactions},
 	"listunspent":               {fn: (*Server).listUnspent},
-	"lockaccount":               {fn: (*Server).lockAccount},
-	"lockunspent":               {fn: (*Server).lockUnspent},
-	"mixaccount":                {fn: (*Server).mixAccount},
-	"mixoutput":                 {fn: (*Server).mixOutput},
-	"purchaseticket":            {fn: (*Server).purchaseTicket},
-	"processunmanagedticket":    {fn: (*Server).processUnmanagedTicket},
-	"redeemmultisigout":         {fn: (*Server).redeemMultiSigOut},
-	"redeemmultisigouts":        {fn: (*Server).redeemMultiSigOuts},
-	"renameaccount":             {fn: (*Server).renameAccount},
-	"rescanwallet":              {fn: (*Server).rescanWallet},
-	"sendfrom":                  {fn: (*Server).sendFrom},
-	"sendfromtreasury":          {fn: (*Server).sendFromTreasury},
-	"sendmany":                  {fn: (*Server).sendMany},
-	"sendrawtransaction":        {fn: (*Server).sendRawTransaction},
-	"sendtoaddress":             {fn: (*Server).sendToAddress},
-	"sendtomultisig":            {fn: (*Server).sendToMultiSig},
-	"sendtotreasury":            {fn: (*Server).sendToTreasury},
-	"setaccountpassphrase":      {fn: (*Server).setAccountPassphrase},
-	"setdisapprovepercent":      {fn: (*Server).setDisapprovePercent},
-	"settreasurypolicy":         {fn: (*Server).setTreasuryPolicy},
-	"settspendpolicy":           {fn: (*Server).setTSpendPolicy},
-	"settxfee":                  {fn: (*Server).setTxFee},
-	"setvotechoice":             {fn: (*Server).setVoteChoice},
-	"signmessage":               {fn: (*Server).signMessage},
-	"signrawtransaction":        {fn: (*Server).signRawTransaction},
-	"signrawtransactions":       {fn: (*Server).signRawTransactions},
-	"spendoutputs":              {fn: (*Server).spendOutputs},
-	"sweepaccount":              {fn: (*Server).sweepAccount},
+	"listvsptickets":            {fn: (*Server).listVSPTickets},
+	"listwalletevents":          {fn: (*Server).listWalletEvents},
+	"lockaccount":               {fn: (*Server).lockAccount, mutates: true},
+	"lockunspent":               {fn: (*Server).lockUnspent, mutates: true},
+	"mixaccount":                {fn: (*Server).mixAccount, usesKeys: true, mutates: true},
+	"mixoutput":                 {fn: (*Server).mixOutput, usesKeys: true, mutates: true},
+	"plansweep":                 {fn: (*Server).planSweep},
+	"purchaseticket":            {fn: (*Server).purchaseTicket, usesKeys: true, mutates: true},
+	"processunmanagedticket":    {fn: (*Server).processUnmanagedTicket, usesKeys: true, mutates: true},
+	"redeemmultisigout":         {fn: (*Server).redeemMultiSigOut, usesKeys: true, mutates: true},
+	"redeemmultisigouts":        {fn: (*Server).redeemMultiSigOuts, usesKeys: true, mutates: true},
+	"reloadconfig":              {fn: (*Server).reloadConfig, mutates: true},
+	"renameaccount":             {fn: (*Server).renameAccount, mutates: true},
+	"rescanwallet":              {fn: (*Server).rescanWallet, mutates: true},
+	"resendtransaction":         {fn: (*Server).resendTransaction},
+	"selfcheck":                 {fn: (*Server).selfCheck},
+	"sendfrom":                  {fn: (*Server).sendFrom, usesKeys: true, mutates: true},
+	"sendfromtreasury":          {fn: (*Server).sendFromTreasury, usesKeys: true, mutates: true},
+	"sendmany":                  {fn: (*Server).sendMany, usesKeys: true, mutates: true},
+	"sendrawtransaction":        {fn: (*Server).sendRawTransaction, mutates: true},
+	"sendtoaddress":             {fn: (*Server).sendToAddress, usesKeys: true, mutates: true},
+	"sendtomultisig":            {fn: (*Server).sendToMultiSig, usesKeys: true, mutates: true},
+	"sendtotreasury":            {fn: (*Server).sendToTreasury, usesKeys: true, mutates: true},
+	"setaccountpassphrase":      {fn: (*Server).setAccountPassphrase, usesKeys: true, mutates: true},
+	"setaccountspendpolicy":     {fn: (*Server).setAccountSpendPolicy, mutates: true},
+	"setdisapprovepercent":      {fn: (*Server).setDisapprovePercent, mutates: true},
+	"settreasurypolicy":         {fn: (*Server).setTreasuryPolicy, mutates: true},
+	"settspendpolicy":           {fn: (*Server).setTSpendPolicy, mutates: true},
+	"settxfee":                  {fn: (*Server).setTxFee, mutates: true},
+	"setvotechoice":             {fn: (*Server).setVoteChoice, mutates: true},
+	"setvotingaccount":          {fn: (*Server).setVotingAccount, usesKeys: true, mutates: true},
+	"setvotingpassphrase":       {fn: (*Server).setVotingPassphrase, usesKeys: true, mutates: true},
+	"signmessage":               {fn: (*Server).signMessage, usesKeys: true},
+	"signrawtransaction":        {fn: (*Server).signRawTransaction, usesKeys: true},
+	"signrawtransactions":       {fn: (*Server).signRawTransactions, usesKeys: true},
+	"simnetadvance":             {fn: (*Server).simnetAdvance, mutates: true},
+	"simnetfundaccount":         {fn: (*Server).simnetFundAccount, usesKeys: true, mutates: true},
+	"simnetreorg":               {fn: (*Server).simnetReorg, mutates: true},
+	"spendoutputs":              {fn: (*Server).spendOutputs, usesKeys: true, mutates: true},
+	"sweepaccount":              {fn: (*Server).sweepAccount, usesKeys: true, mutates: true},
 	"syncstatus":                {fn: (*Server).syncStatus},
 	"ticketinfo":                {fn: (*Server).ticketInfo},
 	"treasurypolicy":            {fn: (*Server).treasuryPolicy},
 	"tspendpolicy":              {fn: (*Server).tspendPolicy},
-	"unlockaccount":             {fn: (*Server).unlockAccount},
+	"unlockaccount":             {fn: (*Server).unlockAccount, mutates: true},
+	"updatecontact":             {fn: (*Server).updateContact, mutates: true},
 	"validateaddress":           {fn: (*Server).validateAddress},
 	"validatepredcp0005cf":      {fn: (*Server).validatePreDCP0005CF},
+	"verifyexternaladdress":     {fn: (*Server).verifyExternalAddress},
 	"verifymessage":             {fn: (*Server).verifyMessage},
 	"version":                   {fn: (*Server).version},
 	"walletinfo":                {fn: (*Server).walletInfo},
 	"walletislocked":            {fn: (*Server).walletIsLocked},
-	"walletlock":                {fn: (*Server).walletLock},
-	"walletpassphrase":          {fn: (*Server).walletPassphrase},
-	"walletpassphrasechange":    {fn: (*Server).walletPassphraseChange},
-	"walletpubpassphrasechange": {fn: (*Server).walletPubPassphraseChange},
+	"walletlock":                {fn: (*Server).walletLock, mutates: true},
+	"walletpassphrase":          {fn: (*Server).walletPassphrase, mutates: true},
+	"walletpassphrasechange":    {fn: (*Server).walletPassphraseChange, mutates: true},
+	"walletpubpassphrasechange": {fn: (*Server).walletPubPassphraseChange, mutates: true},
 
 	// Unimplemented/unsupported RPCs which may be found in other
 	// cryptocurrency wallets.
@@ -192,7 +229,6 @@ var handlers = map[string]handler{
 	"getwalletinfo":        {fn: unimplemented, noHelp: true},
 	"importwallet":         {fn: unimplemented, noHelp: true},
 	"listaddressgroupings": {fn: unimplemented, noHelp: true},
-	"dumpwallet":           {fn: unsupported, noHelp: true},
 	"encryptwallet":        {fn: unsupported, noHelp: true},
 	"move":                 {fn: unsupported, noHelp: true},
 	"setaccount":           {fn: unsupported, noHelp: true},
@@ -269,7 +305,13 @@ func lazyApplyHandler(s *Server, ctx context.Context, request *dcrjson.Request)
 				log.Warnf("Canceled RPC method %v invoked by %v: %v", request.Method, remoteAddr(ctx), err)
 			}
 		}()
+		if handlerData.usesKeys && s.sessionLocked(ctx) {
+			return nil, errWalletUnlockNeeded
+		}
 		resp, err := handlerData.fn(s, ctx, params)
+		if handlerData.mutates {
+			s.audit(ctx, request.Method, request.Params, resp, err)
+		}
 		if err != nil {
 			return nil, convertError(err)
 		}
@@ -318,7 +360,14 @@ func (s *Server) abandonTransaction(ctx context.Context, icmd any) (any, error)
 		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
 	}
 
-	err = w.AbandonTransaction(ctx, hash)
+	// Refuse to abandon transactions accepted to the mempool of a trusted
+	// dcrd backend.  A nil querier skips this check for SPV wallets.
+	n, _ := s.walletLoader.NetworkBackend()
+	rpc, _ := n.(wallet.MempoolQuerier)
+	err = w.AbandonUnrelayedTransaction(ctx, rpc, hash)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcError(dcrjson.ErrRPCNoTxInfo, err)
+	}
 	return nil, err
 }
 
@@ -686,10 +735,9 @@ func (s *Server) createRawTransaction(ctx context.Context, icmd any) (any, error
 	for encodedAddr, amount := range cmd.Amounts {
 		// Decode the provided address.  This also ensures the network encoded
 		// with the address matches the network the server is currently on.
-		addr, err := stdaddr.DecodeAddress(encodedAddr, s.activeNet)
+		addr, err := addrcheck.Decode(encodedAddr, s.activeNet)
 		if err != nil {
-			return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
-				"Address %q: %v", encodedAddr, err)
+			return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
 		}
 
 		// Ensure the address is one of the supported types.
@@ -831,6 +879,32 @@ func (s *Server) discoverUsage(ctx context.Context, icmd any) (any, error) {
 	return nil, err
 }
 
+// dropTransactionHistory handles a droptransactionhistory request by removing
+// the wallet's transaction history and rebuilding it with a rescan.
+func (s *Server) dropTransactionHistory(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DropTransactionHistoryCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	keepUnmined := cmd.KeepUnmined == nil || *cmd.KeepUnmined
+	res, err := w.DropTransactionHistory(ctx, n, keepUnmined)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DropTransactionHistoryResult{
+		RescanFrom:     res.RescanFrom,
+		UnminedKept:    res.UnminedKept,
+		UnminedDropped: res.UnminedDropped,
+	}, nil
+}
+
 // dumpPrivKey handles a dumpprivkey request with the private key
 // for a single address, or an appropriate error if the wallet
 // is locked.
@@ -856,6 +930,45 @@ func (s *Server) dumpPrivKey(ctx context.Context, icmd any) (any, error) {
 	return key, nil
 }
 
+// dumpWallet handles a dumpwallet request by writing every private key and
+// redeem script of the wallet to a new file.  The wallet must already be
+// unlocked, and the request must be explicitly confirmed.
+func (s *Server) dumpWallet(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DumpWalletCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if !cmd.Confirm {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"confirm must be true to export all private keys")
+	}
+	if w.Locked() {
+		return nil, errWalletUnlockNeeded
+	}
+
+	// Never overwrite existing files.
+	filename := filepath.Clean(cmd.Filename)
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	err = w.DumpWallet(ctx, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(filename)
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+
+	return &types.DumpWalletResult{Filename: filename}, nil
+}
+
 func (s *Server) fundRawTransaction(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.FundRawTransactionCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -943,12 +1056,27 @@ func (s *Server) fundRawTransaction(ctx context.Context, icmd any) (any, error)
 		return nil, err
 	}
 	res := &types.FundRawTransactionResult{
-		Hex: b.String(),
-		Fee: fee.ToCoin(),
+		Hex:      b.String(),
+		Fee:      fee.ToCoin(),
+		FeeAtoms: int64(fee),
 	}
 	return res, nil
 }
 
+// getAuditLog handles a getauditlog request by returning recorded mutating
+// requests from the audit log.
+func (s *Server) getAuditLog(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetAuditLogCmd)
+	if s.cfg.AuditLog == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "RPC audit log is not enabled")
+	}
+	if *cmd.Count < 0 || *cmd.From < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"count and from must be non-negative")
+	}
+	return s.cfg.AuditLog.entries(*cmd.Count, *cmd.From)
+}
+
 // getAddressesByAccount handles a getaddressesbyaccount request by returning
 // all addresses for an account, or an error if the requested account does
 // not exist.
@@ -1020,6 +1148,37 @@ func (s *Server) getAddressesByAccount(ctx context.Context, icmd any) (any, erro
 	return addressStringsMarshaler(addrs), nil
 }
 
+// getAttestation handles a getattestation request by returning the most
+// recently recorded signed attestation of the wallet state.
+func (s *Server) getAttestation(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	a, err := w.LatestAttestation(ctx)
+	if errors.Is(err, errors.NotExist) {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "no attestation has "+
+			"been recorded; attestations are enabled with the "+
+			"attestinterval option")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.GetAttestationResult{
+		Sequence:     a.Sequence,
+		Time:         a.Time.Unix(),
+		TipHash:      a.TipHash.String(),
+		TipHeight:    a.TipHeight,
+		BalancesHash: a.BalancesHash.String(),
+		TicketCount:  a.TicketCount,
+		PrevHash:     a.PrevHash.String(),
+		Message:      hex.EncodeToString(a.Message()),
+		PubKey:       hex.EncodeToString(a.PubKey),
+		Signature:    hex.EncodeToString(a.Signature),
+	}, nil
+}
+
 // getBalance handles a getbalance request by returning the balance for an
 // account (wallet), or an error if the requested account does not
 // exist.
@@ -1045,9 +1204,12 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 		BlockHash: blockHash.String(),
 	}
 
-	if accountName == "*" {
-		balances, err := w.AccountBalances(ctx, int32(*cmd.MinConf))
+	if wallet.IsAccountNameFilter(accountName) {
+		balances, err := w.AccountBalancesMatching(ctx, accountName, int32(*cmd.MinConf))
 		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
 			return nil, err
 		}
 
@@ -1058,6 +1220,7 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 			totSpendable        dcrutil.Amount
 			totUnconfirmed      dcrutil.Amount
 			totVotingAuthority  dcrutil.Amount
+			totEffective        dcrutil.Amount
 			cumTot              dcrutil.Amount
 		)
 
@@ -1080,29 +1243,29 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 			totSpendable += bal.Spendable
 			totUnconfirmed += bal.Unconfirmed
 			totVotingAuthority += bal.VotingAuthority
+			totEffective += bal.Effective
 			cumTot += bal.Total
 
-			json := types.GetAccountBalanceResult{
-				AccountName:             accountName,
-				ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
-				ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
-				LockedByTickets:         bal.LockedByTickets.ToCoin(),
-				Spendable:               bal.Spendable.ToCoin(),
-				Total:                   bal.Total.ToCoin(),
-				Unconfirmed:             bal.Unconfirmed.ToCoin(),
-				VotingAuthority:         bal.VotingAuthority.ToCoin(),
-			}
-
-			result.Balances = append(result.Balances, json)
+			result.Balances = append(result.Balances,
+				accountBalanceResult(accountName, &bal))
 		}
 
 		result.TotalImmatureCoinbaseRewards = totImmatureCoinbase.ToCoin()
+		result.TotalImmatureCoinbaseRewardsAtoms = int64(totImmatureCoinbase)
 		result.TotalImmatureStakeGeneration = totImmatureStakegen.ToCoin()
+		result.TotalImmatureStakeGenerationAtoms = int64(totImmatureStakegen)
 		result.TotalLockedByTickets = totLocked.ToCoin()
+		result.TotalLockedByTicketsAtoms = int64(totLocked)
 		result.TotalSpendable = totSpendable.ToCoin()
+		result.TotalSpendableAtoms = int64(totSpendable)
 		result.TotalUnconfirmed = totUnconfirmed.ToCoin()
+		result.TotalUnconfirmedAtoms = int64(totUnconfirmed)
 		result.TotalVotingAuthority = totVotingAuthority.ToCoin()
+		result.TotalVotingAuthorityAtoms = int64(totVotingAuthority)
+		result.TotalEffective = totEffective.ToCoin()
+		result.TotalEffectiveAtoms = int64(totEffective)
 		result.CumulativeTotal = cumTot.ToCoin()
+		result.CumulativeTotalAtoms = int64(cumTot)
 	} else {
 		account, err := w.AccountNumber(ctx, accountName)
 		if err != nil {
@@ -1120,22 +1283,71 @@ func (s *Server) getBalance(ctx context.Context, icmd any) (any, error) {
 			}
 			return nil, err
 		}
-		json := types.GetAccountBalanceResult{
-			AccountName:             accountName,
-			ImmatureCoinbaseRewards: bal.ImmatureCoinbaseRewards.ToCoin(),
-			ImmatureStakeGeneration: bal.ImmatureStakeGeneration.ToCoin(),
-			LockedByTickets:         bal.LockedByTickets.ToCoin(),
-			Spendable:               bal.Spendable.ToCoin(),
-			Total:                   bal.Total.ToCoin(),
-			Unconfirmed:             bal.Unconfirmed.ToCoin(),
-			VotingAuthority:         bal.VotingAuthority.ToCoin(),
-		}
-		result.Balances = append(result.Balances, json)
+		result.Balances = append(result.Balances,
+			accountBalanceResult(accountName, &bal))
 	}
 
 	return result, nil
 }
 
+// getBalanceHistory handles a getbalancehistory request by returning the
+// recorded daily balance snapshots of the most recent days.
+func (s *Server) getBalanceHistory(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetBalanceHistoryCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	if *cmd.Days < 1 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"days must be positive")
+	}
+
+	snapshots, err := w.BalanceHistory(ctx, *cmd.Days)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.BalanceSnapshotResult, len(snapshots))
+	for i, snap := range snapshots {
+		res[i] = types.BalanceSnapshotResult{
+			Date:                 snap.Day.Format(time.DateOnly),
+			Time:                 snap.Time.Unix(),
+			Height:               snap.Height,
+			Total:                snap.Total.ToCoin(),
+			TotalAtoms:           int64(snap.Total),
+			LockedByTickets:      snap.LockedByTickets.ToCoin(),
+			LockedByTicketsAtoms: int64(snap.LockedByTickets),
+			TicketCount:          snap.TicketCount,
+			Rewards:              snap.Rewards.ToCoin(),
+			RewardsAtoms:         int64(snap.Rewards),
+		}
+	}
+	return res, nil
+}
+
+// accountBalanceResult creates the getbalance result for a single account.
+func accountBalanceResult(accountName string, bal *wallet.Balances) types.GetAccountBalanceResult {
+	return types.GetAccountBalanceResult{
+		AccountName:                  accountName,
+		ImmatureCoinbaseRewards:      bal.ImmatureCoinbaseRewards.ToCoin(),
+		ImmatureCoinbaseRewardsAtoms: int64(bal.ImmatureCoinbaseRewards),
+		ImmatureStakeGeneration:      bal.ImmatureStakeGeneration.ToCoin(),
+		ImmatureStakeGenerationAtoms: int64(bal.ImmatureStakeGeneration),
+		LockedByTickets:              bal.LockedByTickets.ToCoin(),
+		LockedByTicketsAtoms:         int64(bal.LockedByTickets),
+		Spendable:                    bal.Spendable.ToCoin(),
+		SpendableAtoms:               int64(bal.Spendable),
+		Total:                        bal.Total.ToCoin(),
+		TotalAtoms:                   int64(bal.Total),
+		Unconfirmed:                  bal.Unconfirmed.ToCoin(),
+		UnconfirmedAtoms:             int64(bal.Unconfirmed),
+		VotingAuthority:              bal.VotingAuthority.ToCoin(),
+		VotingAuthorityAtoms:         int64(bal.VotingAuthority),
+		Effective:                    bal.Effective.ToCoin(),
+		EffectiveAtoms:               int64(bal.Effective),
+	}
+}
+
 // getBestBlock handles a getbestblock request by returning a JSON object
 // with the height and hash of the most recently processed block.
 func (s *Server) getBestBlock(ctx context.Context, icmd any) (any, error) {
@@ -1773,6 +1985,7 @@ func (s *Server) getInfo(ctx context.Context, icmd any) (any, error) {
 		ProtocolVersion: int32(p2p.Pver),
 		WalletVersion:   version.Integer,
 		Balance:         spendableBalance.ToCoin(),
+		BalanceAtoms:    int64(spendableBalance),
 		Blocks:          tipHeight,
 		TimeOffset:      0,
 		Connections:     0,
@@ -1783,6 +1996,7 @@ func (s *Server) getInfo(ctx context.Context, icmd any) (any, error) {
 		KeypoolSize:     0,
 		UnlockedUntil:   0,
 		PaytxFee:        w.RelayFee().ToCoin(),
+		PaytxFeeAtoms:   int64(w.RelayFee()),
 		RelayFee:        0,
 		Errors:          "",
 	}
@@ -1799,7 +2013,12 @@ func (s *Server) getInfo(ctx context.Context, icmd any) (any, error) {
 		info.TimeOffset = consensusInfo.TimeOffset
 		info.Connections = consensusInfo.Connections
 		info.Proxy = consensusInfo.Proxy
+		relayFee, err := dcrutil.NewAmount(consensusInfo.RelayFee)
+		if err != nil {
+			return nil, err
+		}
 		info.RelayFee = consensusInfo.RelayFee
+		info.RelayFeeAtoms = int64(relayFee)
 		info.Errors = consensusInfo.Errors
 	}
 
@@ -1822,10 +2041,9 @@ func decodeAddress(s string, params *chaincfg.Params) (stdaddr.Address, error) {
 		return pubKeyAddr, nil
 	}
 
-	addr, err := stdaddr.DecodeAddress(s, params)
+	addr, err := addrcheck.Decode(s, params)
 	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
-			"invalid address %q: decode failed: %#q", s, err)
+		return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
 	}
 	return addr, nil
 }
@@ -2138,6 +2356,162 @@ func (s *Server) importScript(ctx context.Context, icmd any) (any, error) {
 	return nil, nil
 }
 
+// importLegacyKeys handles an importlegacykeys request by importing each
+// private key of a legacy keypool wallet dump to the imported account.  When
+// rescanning, the rescan begins at the earliest birthday of any newly
+// imported key.
+func (s *Server) importLegacyKeys(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportLegacyKeysCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	rescan := true
+	if cmd.Rescan != nil {
+		rescan = *cmd.Rescan
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if rescan && !ok {
+		return nil, errNoNetwork
+	}
+
+	keys, err := wallet.ParseLegacyKeyDump(strings.NewReader(cmd.Dump),
+		w.ChainParams())
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
+	}
+
+	res, err := w.ImportLegacyKeys(ctx, keys)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+
+	if rescan && res.RescanFrom >= 0 {
+		// TODO: This is not synchronized with process shutdown and
+		// will cause panics when the DB is closed mid-transaction.
+		go w.RescanFromHeight(context.Background(), n, res.RescanFrom)
+	}
+
+	imported := make([]string, len(res.Imported))
+	for i, a := range res.Imported {
+		imported[i] = a.String()
+	}
+	return &types.ImportLegacyKeysResult{
+		Imported:   imported,
+		Existing:   res.Existing,
+		RescanFrom: res.RescanFrom,
+	}, nil
+}
+
+// exportTicket handles an exportticket request by returning the voting rights
+// of a ticket, for import by another wallet with importticket.
+func (s *Server) exportTicket(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExportTicketCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	ticketHash, err := chainhash.NewHashFromStr(cmd.TicketHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	e, err := w.ExportTicket(ctx, ticketHash)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCNoTxInfo, err)
+		}
+		return nil, err
+	}
+
+	var b strings.Builder
+	b.Grow(2 * e.Ticket.SerializeSize())
+	err = e.Ticket.Serialize(hex.NewEncoder(&b))
+	if err != nil {
+		return nil, err
+	}
+	res := &types.ExportTicketResult{
+		Network:      w.ChainParams().Name,
+		Ticket:       b.String(),
+		VotingKeys:   make([]string, 0, len(e.VotingKeys)),
+		RedeemScript: hex.EncodeToString(e.RedeemScript),
+	}
+	if e.BlockHash != nil {
+		res.BlockHash = e.BlockHash.String()
+	}
+	for _, wif := range e.VotingKeys {
+		res.VotingKeys = append(res.VotingKeys, wif.String())
+	}
+	return res, nil
+}
+
+// importTicket handles an importticket request by registering a ticket
+// exported by another wallet with exportticket for voting.
+func (s *Server) importTicket(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ImportTicketCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var export types.ExportTicketResult
+	err := json.Unmarshal([]byte(cmd.Export), &export)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"invalid ticket export: %v", err)
+	}
+	params := w.ChainParams()
+	if export.Network != params.Name {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"ticket export is for network %q", export.Network)
+	}
+	e := new(wallet.TicketExport)
+	e.Ticket = new(wire.MsgTx)
+	err = e.Ticket.Deserialize(hex.NewDecoder(strings.NewReader(export.Ticket)))
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	if export.BlockHash != "" {
+		e.BlockHash, err = chainhash.NewHashFromStr(export.BlockHash)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+	}
+	for _, k := range export.VotingKeys {
+		wif, err := dcrutil.DecodeWIF(k, params.PrivateKeyID)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey,
+				"WIF decode failed: %v", err)
+		}
+		e.VotingKeys = append(e.VotingKeys, wif)
+	}
+	if export.RedeemScript != "" {
+		e.RedeemScript, err = hex.DecodeString(export.RedeemScript)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+	}
+
+	err = w.ImportTicket(ctx, e)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
 func (s *Server) importXpub(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.ImportXpubCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -2179,6 +2553,38 @@ func (s *Server) createNewAccount(ctx context.Context, icmd any) (any, error) {
 	return nil, nil
 }
 
+// reloadConfig handles a reloadconfig request by reloading the configuration
+// file and command line options, applying the changed options which may be
+// changed while running, and reporting the changed options which require a
+// restart.
+func (s *Server) reloadConfig(ctx context.Context, icmd any) (any, error) {
+	if s.cfg.ReloadConfig == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "configuration reloading is not supported")
+	}
+	reload, err := s.cfg.ReloadConfig()
+	if err != nil {
+		return nil, err
+	}
+	res := &types.ReloadConfigResult{
+		Applied:         reload.Applied,
+		RestartRequired: reload.RestartRequired,
+		Failed:          make([]types.ReloadConfigFailure, 0, len(reload.Failed)),
+	}
+	if res.Applied == nil {
+		res.Applied = []string{}
+	}
+	if res.RestartRequired == nil {
+		res.RestartRequired = []string{}
+	}
+	for _, f := range reload.Failed {
+		res.Failed = append(res.Failed, types.ReloadConfigFailure{
+			Option: f.Option,
+			Error:  f.Err.Error(),
+		})
+	}
+	return res, nil
+}
+
 // renameAccount handles a renameaccount request by renaming an account.
 // If the account does not exist an appropriate error will be returned.
 func (s *Server) renameAccount(ctx context.Context, icmd any) (any, error) {
@@ -2250,6 +2656,7 @@ func (s *Server) getMultisigOutInfo(ctx context.Context, icmd any) (any, error)
 		Pubkeys:      pubkeys,
 		TxHash:       p2shOutput.OutPoint.Hash.String(),
 		Amount:       p2shOutput.OutputAmount.ToCoin(),
+		AmountAtoms:  int64(p2shOutput.OutputAmount),
 	}
 	if !p2shOutput.ContainingBlock.None() {
 		result.BlockHeight = uint32(p2shOutput.ContainingBlock.Height)
@@ -2482,6 +2889,66 @@ func (s *Server) getPeerInfo(ctx context.Context, icmd any) (any, error) {
 	return infos, nil
 }
 
+// getStakeEarnings returns the lifetime stake earnings of the wallet and the
+// earnings of each period.
+func (s *Server) getStakeEarnings(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetStakeEarningsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var period wallet.EarningsPeriod
+	switch *cmd.Period {
+	case "day":
+		period = wallet.EarningsDaily
+	case "week":
+		period = wallet.EarningsWeekly
+	case "month":
+		period = wallet.EarningsMonthly
+	case "year":
+		period = wallet.EarningsYearly
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"unknown period %q", *cmd.Period)
+	}
+
+	lifetime, periods, err := w.StakeEarnings(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.GetStakeEarningsResult{
+		Tickets:         lifetime.Tickets,
+		Votes:           lifetime.Votes,
+		Rewards:         lifetime.Rewards.ToCoin(),
+		RewardsAtoms:    int64(lifetime.Rewards),
+		TicketFees:      lifetime.TicketFees.ToCoin(),
+		TicketFeesAtoms: int64(lifetime.TicketFees),
+		PoolFees:        lifetime.PoolFees.ToCoin(),
+		PoolFeesAtoms:   int64(lifetime.PoolFees),
+		Net:             lifetime.Net().ToCoin(),
+		NetAtoms:        int64(lifetime.Net()),
+		Periods:         make([]types.StakeEarningsResult, len(periods)),
+	}
+	for i := range periods {
+		p := &periods[i]
+		res.Periods[i] = types.StakeEarningsResult{
+			Start:           p.Start.Format(time.DateOnly),
+			Tickets:         p.Tickets,
+			Votes:           p.Votes,
+			Rewards:         p.Rewards.ToCoin(),
+			RewardsAtoms:    int64(p.Rewards),
+			TicketFees:      p.TicketFees.ToCoin(),
+			TicketFeesAtoms: int64(p.TicketFees),
+			PoolFees:        p.PoolFees.ToCoin(),
+			PoolFeesAtoms:   int64(p.PoolFees),
+			Net:             p.Net().ToCoin(),
+			NetAtoms:        int64(p.Net()),
+		}
+	}
+	return res, nil
+}
+
 // getStakeInfo gets a large amounts of information about the stake environment
 // and a number of statistics about local staking in the wallet.
 func (s *Server) getStakeInfo(ctx context.Context, icmd any) (any, error) {
@@ -2511,9 +2978,11 @@ func (s *Server) getStakeInfo(ctx context.Context, icmd any) (any, error) {
 	}
 
 	resp := &types.GetStakeInfoResult{
-		BlockHeight:  sinfo.BlockHeight,
-		Difficulty:   sinfo.Sdiff.ToCoin(),
-		TotalSubsidy: sinfo.TotalSubsidy.ToCoin(),
+		BlockHeight:       sinfo.BlockHeight,
+		Difficulty:        sinfo.Sdiff.ToCoin(),
+		DifficultyAtoms:   int64(sinfo.Sdiff),
+		TotalSubsidy:      sinfo.TotalSubsidy.ToCoin(),
+		TotalSubsidyAtoms: int64(sinfo.TotalSubsidy),
 
 		OwnMempoolTix:  sinfo.OwnMempoolTix,
 		Immature:       sinfo.Immature,
@@ -2599,6 +3068,7 @@ func (s *Server) getTransaction(ctx context.Context, icmd any) (any, error) {
 		Time:            txd.Received.Unix(),
 		TimeReceived:    txd.Received.Unix(),
 		WalletConflicts: []string{}, // Not saved
+		Tree:            wallet.TxTree(txd.TxType),
 		//Generated:     compat.IsEitherCoinBaseTx(&details.MsgTx),
 	}
 
@@ -2614,6 +3084,7 @@ func (s *Server) getTransaction(ctx context.Context, icmd any) (any, error) {
 		creditTotal dcrutil.Amount
 		fee         dcrutil.Amount
 		negFeeF64   float64
+		negFeeAtoms int64
 	)
 	for _, deb := range txd.Debits {
 		debitTotal += deb.Amount
@@ -2629,24 +3100,34 @@ func (s *Server) getTransaction(ctx context.Context, icmd any) (any, error) {
 		}
 		fee = debitTotal - outputTotal
 		negFeeF64 = (-fee).ToCoin()
+		negFeeAtoms = int64(-fee)
 	}
 	ret.Amount = (creditTotal - debitTotal).ToCoin()
+	ret.AmountAtoms = int64(creditTotal - debitTotal)
 	ret.Fee = negFeeF64
+	ret.FeeAtoms = negFeeAtoms
 
 	details, err := w.ListTransactionDetails(ctx, txHash)
 	if err != nil {
 		return nil, err
 	}
+	labels, err := w.OutputLabels(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
 	ret.Details = make([]types.GetTransactionDetailsResult, len(details))
 	for i, d := range details {
 		ret.Details[i] = types.GetTransactionDetailsResult{
 			Account:           d.Account,
 			Address:           d.Address,
 			Amount:            d.Amount,
+			AmountAtoms:       d.AmountAtoms,
 			Category:          d.Category,
 			InvolvesWatchOnly: d.InvolvesWatchOnly,
 			Fee:               d.Fee,
+			FeeAtoms:          d.FeeAtoms,
 			Vout:              d.Vout,
+			Label:             labels[d.Vout],
 		}
 	}
 
@@ -2733,6 +3214,44 @@ func (s *Server) getTxOut(ctx context.Context, icmd any) (any, error) {
 	}, nil
 }
 
+// getUTXOStats handles a getutxostats request by returning the number, dust
+// count, total and median value, and consolidation fee of the spendable
+// unspent outputs of each account.
+func (s *Server) getUTXOStats(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.GetUTXOStatsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "minconf must be non-negative")
+	}
+
+	stats, err := w.UTXOStats(ctx, minConf)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]types.GetUTXOStatsResult, 0, len(stats))
+	for i := range stats {
+		st := &stats[i]
+		accountName, err := w.AccountName(ctx, st.Account)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, types.GetUTXOStatsResult{
+			AccountName:      accountName,
+			Count:            st.Count,
+			DustCount:        st.DustCount,
+			Total:            st.Total.ToCoin(),
+			Median:           st.Median.ToCoin(),
+			ConsolidationFee: st.ConsolidationFee.ToCoin(),
+		})
+	}
+	return results, nil
+}
+
 // getVoteChoices handles a getvotechoices request by returning configured vote
 // preferences for each agenda of the latest supported stake version.
 func (s *Server) getVoteChoices(ctx context.Context, icmd any) (any, error) {
@@ -2951,6 +3470,7 @@ func (s *Server) listReceivedByAccount(ctx context.Context, icmd any) (any, erro
 		jsonResults = append(jsonResults, types.ListReceivedByAccountResult{
 			Account:       result.AccountName,
 			Amount:        result.TotalReceived.ToCoin(),
+			AmountAtoms:   int64(result.TotalReceived),
 			Confirmations: uint64(result.LastConfirmation),
 		})
 	}
@@ -3049,6 +3569,7 @@ func (s *Server) listReceivedByAddress(ctx context.Context, icmd any) (any, erro
 		ret[idx] = types.ListReceivedByAddressResult{
 			Address:       address,
 			Amount:        addrData.amount.ToCoin(),
+			AmountAtoms:   int64(addrData.amount),
 			Confirmations: uint64(addrData.confirmations),
 			TxIDs:         addrData.tx,
 		}
@@ -3225,33 +3746,193 @@ func (s *Server) listUnspent(ctx context.Context, icmd any) (any, error) {
 	return result, nil
 }
 
-// lockUnspent handles the lockunspent command.
-func (s *Server) lockUnspent(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.LockUnspentCmd)
+// listWalletEvents handles a listwalletevents request by returning recorded
+// wallet events.
+func (s *Server) listWalletEvents(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ListWalletEventsCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
-
-	switch {
-	case cmd.Unlock && len(cmd.Transactions) == 0:
-		w.ResetLockedOutpoints()
-	default:
-		for _, input := range cmd.Transactions {
-			txHash, err := chainhash.NewHashFromStr(input.Txid)
-			if err != nil {
-				return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
-			}
-			if cmd.Unlock {
-				w.UnlockOutpoint(txHash, input.Vout)
-			} else {
-				w.LockOutpoint(txHash, input.Vout)
-			}
-		}
+	if *cmd.Count < 0 || *cmd.From < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"count and from must be non-negative")
+	}
+
+	events, err := w.WalletEvents(ctx, *cmd.Count, *cmd.From)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.WalletEventResult, len(events))
+	for i, e := range events {
+		res[i] = types.WalletEventResult{
+			Seq:    e.Seq,
+			Time:   e.Time.Unix(),
+			Kind:   e.Kind.String(),
+			Height: e.Height,
+			Detail: e.Detail,
+		}
+		if e.Hash != nil {
+			res[i].Hash = e.Hash.String()
+		}
+	}
+	return res, nil
+}
+
+// listVSPTickets handles a listvsptickets request by grouping the wallet's
+// tickets by their associated VSP.
+func (s *Server) listVSPTickets(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var rpc *dcrd.RPC
+	n, _ := w.NetworkBackend()
+	if chainSyncer, ok := n.(*chain.Syncer); ok {
+		rpc = chainSyncer.RPC()
+	}
+	vsps, err := w.TicketsByVSP(ctx, rpc)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ListVSPTicketsResult, 0, len(vsps))
+	for _, v := range vsps {
+		r := types.ListVSPTicketsResult{
+			Host: v.Host,
+			Immature: v.Counts[wallet.TicketStatusUnmined] +
+				v.Counts[wallet.TicketStatusImmature],
+			Live:    v.Counts[wallet.TicketStatusLive],
+			Voted:   v.Counts[wallet.TicketStatusVoted],
+			Missed:  v.Counts[wallet.TicketStatusMissed],
+			Expired: v.Counts[wallet.TicketStatusExpired],
+			Unspent: v.Counts[wallet.TicketStatusUnspent],
+			Tickets: make([]string, 0, len(v.Tickets)),
+		}
+		for _, t := range v.Tickets {
+			r.Tickets = append(r.Tickets, t.Ticket.Hash.String())
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// lockUnspent handles the lockunspent command.
+func (s *Server) lockUnspent(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.LockUnspentCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	switch {
+	case cmd.Unlock && len(cmd.Transactions) == 0:
+		w.ResetLockedOutpoints()
+	default:
+		for _, input := range cmd.Transactions {
+			txHash, err := chainhash.NewHashFromStr(input.Txid)
+			if err != nil {
+				return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+			}
+			if cmd.Unlock {
+				w.UnlockOutpoint(txHash, input.Vout)
+			} else {
+				w.LockOutpoint(txHash, input.Vout)
+			}
+		}
 	}
 	return true, nil
 }
 
+// sweepPlanArgs parses the common arguments of the plansweep and
+// executesweepplan requests.
+func sweepPlanArgs(ctx context.Context, w *wallet.Wallet, accountNames []string, address string,
+	minConf *int, feeRate *float64) (accounts []uint32, dest stdaddr.Address, minconf int32,
+	feePerKb dcrutil.Amount, err error) {
+
+	if len(accountNames) == 0 {
+		return nil, nil, 0, 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"no accounts to sweep")
+	}
+	for _, name := range accountNames {
+		account, err := w.AccountNumber(ctx, name)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, nil, 0, 0, errAccountNotFound
+			}
+			return nil, nil, 0, 0, err
+		}
+		accounts = append(accounts, account)
+	}
+	dest, err = decodeAddress(address, w.ChainParams())
+	if err != nil {
+		return nil, nil, 0, 0, err
+	}
+	minconf = int32(*minConf)
+	if minconf < 0 {
+		return nil, nil, 0, 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"minconf must be non-negative")
+	}
+	feePerKb = w.RelayFee()
+	if feeRate != nil {
+		feePerKb, err = dcrutil.NewAmount(*feeRate)
+		if err != nil {
+			return nil, nil, 0, 0, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+	}
+	return accounts, dest, minconf, feePerKb, nil
+}
+
+// planSweep handles a plansweep request by returning the transactions which
+// sweep the spendable outputs of the accounts to an address, without signing
+// or publishing them.
+func (s *Server) planSweep(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.PlanSweepCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	accounts, dest, minConf, feeRate, err := sweepPlanArgs(ctx, w,
+		cmd.Accounts, cmd.Address, cmd.MinConf, cmd.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := w.PlanSweep(ctx, accounts, minConf, feeRate, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.PlanSweepResult{
+		PlanID:       plan.ID.String(),
+		Transactions: make([]types.SweepPlanTransaction, 0, len(plan.Transactions)),
+		Uneconomic:   plan.Uneconomic,
+	}
+	var totalInput, totalFee dcrutil.Amount
+	for _, ptx := range plan.Transactions {
+		var b strings.Builder
+		b.Grow(2 * ptx.Tx.SerializeSize())
+		err := ptx.Tx.Serialize(hex.NewEncoder(&b))
+		if err != nil {
+			return nil, err
+		}
+		res.Transactions = append(res.Transactions, types.SweepPlanTransaction{
+			TxHash:     ptx.Tx.TxHash().String(),
+			Hex:        b.String(),
+			Inputs:     len(ptx.Tx.TxIn),
+			TotalInput: ptx.TotalInput.ToCoin(),
+			Fee:        ptx.Fee.ToCoin(),
+			Amount:     sumOutputValues(ptx.Tx.TxOut).ToCoin(),
+		})
+		totalInput += ptx.TotalInput
+		totalFee += ptx.Fee
+	}
+	res.TotalInput = totalInput.ToCoin()
+	res.TotalFee = totalFee.ToCoin()
+
+	return res, nil
+}
+
 // purchaseTicket indicates to the wallet that a ticket should be purchased
 // using all currently available funds. If the ticket could not be purchased
 // because there are not enough eligible funds, an error will be returned.
@@ -3306,12 +3987,21 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 	if cmd.Expiry != nil {
 		expiry = int32(*cmd.Expiry)
 	}
+	expiryBlocks := int32(0)
+	if cmd.ExpiryBlocks != nil {
+		expiryBlocks = int32(*cmd.ExpiryBlocks)
+	}
 
 	dontSignTx := false
 	if cmd.DontSignTx != nil {
 		dontSignTx = *cmd.DontSignTx
 	}
 
+	feeRate, err := feeRateArg(cmd.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+
 	var mixedAccount uint32
 	var mixedAccountBranch uint32
 	var mixedSplitAccount uint32
@@ -3365,7 +4055,9 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 		SourceAccount: account,
 		MinConf:       minConf,
 		Expiry:        expiry,
+		ExpiryBlocks:  expiryBlocks,
 		DontSignTx:    dontSignTx,
+		FeeRate:       feeRate,
 
 		// CSPP
 		Mixing:             s.cfg.Mixing,
@@ -3379,11 +4071,30 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd any) (any, error) {
 
 	ticketsResponse, err := w.PurchaseTickets(ctx, n, request)
 	if err != nil {
+		if errors.Is(err, errors.Invalid) && cmd.FeeRate != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
 		return nil, err
 	}
 	ticketsTx := ticketsResponse.Tickets
 	splitTx := ticketsResponse.SplitTx
 
+	// When verbose, describe the fees of the published split transaction
+	// and tickets.
+	if !dontSignTx && cmd.Verbose != nil && *cmd.Verbose {
+		result := &types.PurchaseTicketVerboseResult{
+			Tickets: make([]types.AuthoredTxResult, len(ticketsTx)),
+		}
+		if splitTx != nil {
+			r := authoredTxResult(splitTx)
+			result.SplitTx = &r
+		}
+		for i, tx := range ticketsTx {
+			result.Tickets[i] = authoredTxResult(tx)
+		}
+		return result, nil
+	}
+
 	// If dontSignTx is false, we return the TicketHashes of the published txs.
 	if !dontSignTx {
 		hashes := ticketsResponse.TicketHashes
@@ -3490,40 +4201,109 @@ func makeOutputs(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params)
 	return outputs, nil
 }
 
-// sendPairs creates and sends payment transactions.
-// It returns the transaction hash in string format upon success
-// All errors are returned in dcrjson.RPCError format
-func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32) (string, error) {
-	changeAccount := account
+// sendChangeAccount returns the account receiving change when sending from
+// account.  Change from the mixed account is returned to the mixing change
+// account when mixing is enabled.
+func (s *Server) sendChangeAccount(ctx context.Context, w *wallet.Wallet, account uint32) (uint32, error) {
 	if s.cfg.Mixing && s.cfg.MixAccount != "" && s.cfg.MixChangeAccount != "" {
 		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
 		if err != nil {
-			return "", err
+			return 0, err
 		}
 		if account == mixAccount {
-			changeAccount, err = w.AccountNumber(ctx, s.cfg.MixChangeAccount)
-			if err != nil {
-				return "", err
-			}
+			return w.AccountNumber(ctx, s.cfg.MixChangeAccount)
 		}
 	}
+	return account, nil
+}
+
+// sendPairs creates and sends payment transactions.
+// It returns the transaction hash in string format upon success
+// All errors are returned in dcrjson.RPCError format
+func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount,
+	account uint32, minconf int32, feeRate dcrutil.Amount) (*wire.MsgTx, error) {
+
+	changeAccount, err := s.sendChangeAccount(ctx, w, account)
+	if err != nil {
+		return nil, err
+	}
 
 	outputs, err := makeOutputs(amounts, w.ChainParams())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf)
+	tx, err := w.SendOutputsFeeRate(ctx, outputs, account, changeAccount,
+		minconf, feeRate)
 	if err != nil {
 		if errors.Is(err, errors.Locked) {
-			return "", errWalletUnlockNeeded
+			return nil, errWalletUnlockNeeded
 		}
 		if errors.Is(err, errors.InsufficientBalance) {
-			return "", rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
 		}
-		return "", err
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
 	}
 
-	return txSha.String(), nil
+	return tx, nil
+}
+
+// sendResult returns the result of a send command paying a transaction: the
+// transaction hash, or when verbose, the transaction's fee and fee rate.
+func sendResult(tx *wire.MsgTx, verbose *bool) any {
+	if verbose != nil && *verbose {
+		return authoredTxResult(tx)
+	}
+	return tx.TxHash().String()
+}
+
+// feeRateArg returns the fee rate per kB of an optional fee rate parameter.
+// A nil fee rate returns zero, selecting the wallet's relay fee.
+func feeRateArg(r *types.FeeRate) (dcrutil.Amount, error) {
+	if r == nil {
+		return 0, nil
+	}
+	if math.IsNaN(r.Rate) || math.IsInf(r.Rate, 0) || r.Rate <= 0 {
+		return 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"fee rate must be positive")
+	}
+	var perKB float64
+	switch r.Unit {
+	case types.FeeRateAtomsPerKB:
+		perKB = r.Rate
+	case types.FeeRateAtomsPerByte:
+		perKB = r.Rate * 1000
+	default:
+		return 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"unknown fee rate unit %q (must be %q or %q)", r.Unit,
+			types.FeeRateAtomsPerKB, types.FeeRateAtomsPerByte)
+	}
+	rounded := math.Round(perKB)
+	if math.Abs(perKB-rounded) > 1e-6 {
+		return 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"fee rate must be a whole number of %s", types.FeeRateAtomsPerKB)
+	}
+	if rounded > dcrutil.MaxAmount {
+		return 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"fee rate exceeds the maximum amount")
+	}
+	return dcrutil.Amount(rounded), nil
+}
+
+// authoredTxResult describes the fee paid by a transaction authored by the
+// wallet.
+func authoredTxResult(tx *wire.MsgTx) types.AuthoredTxResult {
+	fee, feeRate := wallet.TxFee(tx)
+	size := tx.SerializeSize()
+	return types.AuthoredTxResult{
+		TxHash:              tx.TxHash().String(),
+		Size:                size,
+		Fee:                 fee.ToCoin(),
+		FeeRate:             int64(feeRate),
+		FeeRateAtomsPerByte: float64(fee) / float64(size),
+	}
 }
 
 // sendAmountToTreasury creates and sends payment transactions to the treasury.
@@ -4123,7 +4903,9 @@ func (s *Server) redeemMultiSigOuts(ctx context.Context, icmd any) (any, error)
 }
 
 // rescanWallet initiates a rescan of the block chain for wallet data, blocking
-// until the rescan completes or exits with an error.
+// until the rescan completes or exits with an error.  Websocket clients are
+// sent rescanprogress notifications as the rescan proceeds, and a
+// rescanfinished notification when it completes.
 func (s *Server) rescanWallet(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.RescanWalletCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -4136,8 +4918,138 @@ func (s *Server) rescanWallet(ctx context.Context, icmd any) (any, error) {
 		return nil, errNoNetwork
 	}
 
-	err := w.RescanFromHeight(ctx, n, int32(*cmd.BeginHeight))
-	return nil, err
+	wsc := websocketClientFromContext(ctx)
+	if wsc == nil {
+		err := w.RescanFromHeight(ctx, n, int32(*cmd.BeginHeight))
+		return nil, err
+	}
+
+	progress := make(chan wallet.RescanProgress, 1)
+	go w.RescanProgressFromHeight(ctx, n, int32(*cmd.BeginHeight), progress)
+
+	var err error
+	var last wallet.RescanProgress
+	for p := range progress {
+		if p.Err != nil {
+			err = p.Err
+			continue
+		}
+		last = p
+		ntfn := types.NewRescanProgressNtfn(p.ScannedThrough, p.Percent(),
+			p.RelevantTxs)
+		sendNotification(ctx, wsc, ntfn)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if last.ScannedThrough == 0 {
+		_, last.ScannedThrough = w.MainChainTip(ctx)
+	}
+	ntfn := types.NewRescanFinishedNtfn(last.ScannedThrough, last.RelevantTxs)
+	sendNotification(ctx, wsc, ntfn)
+	return nil, nil
+}
+
+// sendNotification marshals and sends a JSON-RPC notification to a websocket
+// client.  Notifications are not sent to clients which have disconnected.
+func sendNotification(ctx context.Context, wsc *websocketClient, ntfn any) {
+	b, err := dcrjson.MarshalCmd("1.0", nil, ntfn)
+	if err != nil {
+		log.Errorf("Failed to marshal notification: %v", err)
+		return
+	}
+	err = wsc.send(b)
+	if err != nil {
+		log.Debugf("Notification not sent to client %s: %v",
+			remoteAddr(ctx), err)
+	}
+}
+
+// resendTransaction handles a resendtransaction request by immediately
+// republishing a single unmined wallet transaction after checking that it may
+// still be accepted to the mempool.  Errors from the network backend are
+// reported in the result rather than as an RPC error.
+func (s *Server) resendTransaction(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ResendTransactionCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	hash, err := chainhash.NewHashFromStr(cmd.TxHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+
+	tx, err := w.ResendableTransaction(ctx, hash)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCNoTxInfo, err)
+		}
+		return nil, err
+	}
+
+	res := &types.ResendTransactionResult{
+		TxHash:  hash.String(),
+		Relayed: true,
+	}
+	err = n.PublishTransactions(ctx, tx)
+	if err != nil {
+		res.Relayed = false
+		res.Error = err.Error()
+	}
+	return res, nil
+}
+
+// selfCheck handles a selfcheck request by re-deriving the wallet's unspent
+// outputs and balance from the blockchain and reporting any differences from
+// the transaction store.
+func (s *Server) selfCheck(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	r, err := w.SelfCheck(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.SelfCheckResult{
+		ScannedThrough:       r.ScannedThrough,
+		ExpectedBalance:      r.ExpectedBalance.ToCoin(),
+		ExpectedBalanceAtoms: int64(r.ExpectedBalance),
+		ActualBalance:        r.ActualBalance.ToCoin(),
+		ActualBalanceAtoms:   int64(r.ActualBalance),
+		ExpectedUnspent:      r.ExpectedUnspent,
+		ActualUnspent:        r.ActualUnspent,
+		Discrepancies:        make([]types.SelfCheckDiscrepancy, 0, len(r.Discrepancies)),
+	}
+	for i := range r.Discrepancies {
+		d := &r.Discrepancies[i]
+		var outPoint string
+		if d.Bucket == wallet.SelfCheckBucketUnspent {
+			outPoint = d.OutPoint.String()
+		}
+		res.Discrepancies = append(res.Discrepancies, types.SelfCheckDiscrepancy{
+			Bucket:        d.Bucket,
+			OutPoint:      outPoint,
+			Expected:      d.Expected.ToCoin(),
+			ExpectedAtoms: int64(d.Expected),
+			Actual:        d.Actual.ToCoin(),
+			ActualAtoms:   int64(d.Actual),
+			Reason:        d.Reason,
+		})
+	}
+	return res, nil
 }
 
 // spendOutputsInputSource creates an input source from a wallet and a list of
@@ -4246,9 +5158,9 @@ func (s *Server) spendOutputs(ctx context.Context, icmd any) (any, error) {
 		inputs = append(inputs, wire.NewTxIn(op, wire.NullValueIn, nil))
 	}
 	for _, output := range cmd.Outputs {
-		addr, err := stdaddr.DecodeAddress(output.Address, params)
+		addr, err := addrcheck.Decode(output.Address, params)
 		if err != nil {
-			return nil, err
+			return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
 		}
 		amount, err := dcrutil.NewAmount(output.Amount)
 		if err != nil {
@@ -4327,6 +5239,7 @@ func (s *Server) ticketInfo(ctx context.Context, icmd any) (any, error) {
 			info := types.TicketInfoResult{
 				Hash:        t.Ticket.Hash.String(),
 				Cost:        dcrutil.Amount(out.Value).ToCoin(),
+				CostAtoms:   out.Value,
 				BlockHeight: -1,
 				Status:      status.String(),
 			}
@@ -4367,6 +5280,18 @@ func (s *Server) ticketInfo(ctx context.Context, icmd any) (any, error) {
 			}
 			info.VSPHost = host
 
+			fee, err := w.VSPFeePaymentForTicket(ctx, t.Ticket.Hash)
+			if err != nil && !errors.Is(err, errors.NotExist) {
+				return false, err
+			}
+			if fee != nil {
+				info.VSPFeeHash = fee.Hash.String()
+				info.VSPFee = fee.Amount.ToCoin()
+				info.VSPFeeAtoms = int64(fee.Amount)
+				info.VSPFeeStatus = fee.Status.String()
+				info.VSPFeeConfirmations = fee.Confirmations
+			}
+
 			res = append(res, info)
 		}
 		return false, nil
@@ -4418,8 +5343,20 @@ func (s *Server) sendFrom(ctx context.Context, icmd any) (any, error) {
 	pairs := map[string]dcrutil.Amount{
 		cmd.ToAddress: amt,
 	}
+	pairs, _, err = resolveDestinations(ctx, w, pairs, nil)
+	if err != nil {
+		return nil, err
+	}
+	feeRate, err := feeRateArg(cmd.FeeRate)
+	if err != nil {
+		return nil, err
+	}
 
-	return s.sendPairs(ctx, w, pairs, account, minConf)
+	tx, err := s.sendPairs(ctx, w, pairs, account, minConf, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	return sendResult(tx, cmd.Verbose), nil
 }
 
 // sendMany handles a sendmany RPC request by creating a new transaction
@@ -4460,45 +5397,467 @@ func (s *Server) sendMany(ctx context.Context, icmd any) (any, error) {
 		}
 		pairs[k] = amt
 	}
-
-	return s.sendPairs(ctx, w, pairs, account, minConf)
-}
-
-// sendToAddress handles a sendtoaddress RPC request by creating a new
-// transaction spending unspent transaction outputs for a wallet to another
-// payment address.  Leftover inputs not sent to the payment address or a fee
-// for the miner are sent back to a new address in the wallet.  Upon success,
-// the TxID for the created transaction is returned.
-func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
-	cmd := icmd.(*types.SendToAddressCmd)
-	w, ok := s.walletLoader.LoadedWallet()
-	if !ok {
-		return nil, errUnloadedWallet
+	var labels map[string]string
+	if cmd.Labels != nil {
+		labels = *cmd.Labels
 	}
-
-	// Transaction comments are not yet supported.  Error instead of
-	// pretending to save them.
-	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
-		return nil, rpcErrorf(dcrjson.ErrRPCUnimplemented, "transaction comments are unsupported")
+	pairs, labels, err = resolveDestinations(ctx, w, pairs, labels)
+	if err != nil {
+		return nil, err
 	}
 
-	amt, err := dcrutil.NewAmount(cmd.Amount)
+	feeRate, err := feeRateArg(cmd.FeeRate)
 	if err != nil {
 		return nil, err
 	}
 
-	// Check that signed integer parameters are positive.
-	if amt < 0 {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
+	split := cmd.Split != nil && *cmd.Split
+	if len(labels) == 0 && !split {
+		tx, err := s.sendPairs(ctx, w, pairs, account, minConf, feeRate)
+		if err != nil {
+			return nil, err
+		}
+		return sendResult(tx, cmd.Verbose), nil
 	}
-
-	// Mock up map of address and amount pairs.
-	pairs := map[string]dcrutil.Amount{
-		cmd.Address: amt,
+	txs, err := s.sendLabeledPairs(ctx, w, pairs, labels, account, minConf,
+		split, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	if !split {
+		return sendResult(txs[0], cmd.Verbose), nil
+	}
+	if cmd.Verbose != nil && *cmd.Verbose {
+		results := make([]types.AuthoredTxResult, len(txs))
+		for i, tx := range txs {
+			results[i] = authoredTxResult(tx)
+		}
+		return results, nil
+	}
+	hashes := make([]string, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.TxHash().String()
+	}
+	return hashes, nil
+}
+
+// sendLabeledPairs creates and sends payment transactions, recording labels
+// for outputs paying to labeled addresses.  Outputs are paid in the order of
+// their addresses.  When split is true, outputs are divided across multiple
+// transactions if necessary to satisfy the maximum transaction size.  On
+// errors, the hashes of any transactions which were already published are
+// included in the error message.
+func (s *Server) sendLabeledPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount,
+	labels map[string]string, account uint32, minconf int32, split bool,
+	feeRate dcrutil.Amount) ([]*wire.MsgTx, error) {
+
+	for addr := range labels {
+		if _, ok := amounts[addr]; !ok {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"label for address %q which is not paid", addr)
+		}
+	}
+	changeAccount, err := s.sendChangeAccount(ctx, w, account)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(amounts))
+	for addr := range amounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	outputs := make([]*wire.TxOut, 0, len(addrs))
+	outputLabels := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		out, err := makeOutputs(map[string]dcrutil.Amount{addr: amounts[addr]},
+			w.ChainParams())
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, out[0])
+		outputLabels = append(outputLabels, labels[addr])
+	}
+
+	txs, err := w.SendLabeledOutputs(ctx, outputs, outputLabels, account,
+		changeAccount, minconf, split, feeRate)
+	if err != nil {
+		hashStrs := make([]string, len(txs))
+		for i, tx := range txs {
+			hashStrs[i] = tx.TxHash().String()
+		}
+		if len(hashStrs) != 0 {
+			err = errors.Errorf("%w (published transactions: %s)", err,
+				strings.Join(hashStrs, ", "))
+		}
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		if errors.Is(err, errors.InsufficientBalance) {
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		if errors.Is(err, txauthor.ErrTxTooLarge) || errors.Is(err, wallet.ErrTxOutputLimit) ||
+			errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return txs, nil
+}
+
+// createPaymentTemplate handles a createpaymenttemplate request by recording
+// a payment template which pays fixed amounts to fixed addresses.  Templates
+// are executed on demand with executetemplate, and when a non-zero interval is
+// provided, automatically every interval blocks.
+func (s *Server) createPaymentTemplate(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.CreatePaymentTemplateCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that signed integer parameters are positive.
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+	if *cmd.Interval < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative interval")
+	}
+
+	pairs := make(map[string]dcrutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := dcrutil.NewAmount(v)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		pairs[k] = amt
+	}
+	outputs, err := makeOutputs(pairs, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	// Record outputs in a stable order.
+	sort.Slice(outputs, func(i, j int) bool {
+		return bytes.Compare(outputs[i].PkScript, outputs[j].PkScript) < 0
+	})
+
+	err = w.CreatePaymentTemplate(ctx, &udb.PaymentTemplate{
+		Name:     cmd.Name,
+		Account:  account,
+		MinConf:  minConf,
+		Outputs:  outputs,
+		Interval: *cmd.Interval,
+	})
+	if err != nil {
+		if errors.Is(err, errors.Invalid) || errors.Is(err, errors.Exist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// executeSweepPlan handles an executesweepplan request by signing and
+// publishing the transactions of a sweep plan, provided that the plan
+// recomputed from the request arguments is unchanged.
+func (s *Server) executeSweepPlan(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExecuteSweepPlanCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	planID, err := chainhash.NewHashFromStr(cmd.PlanID)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	accounts, dest, minConf, feeRate, err := sweepPlanArgs(ctx, w,
+		cmd.Accounts, cmd.Address, cmd.MinConf, cmd.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := w.ExecuteSweepPlan(ctx, planID, accounts, minConf,
+		feeRate, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, len(hashes))
+	for i, hash := range hashes {
+		res[i] = hash.String()
+	}
+	return res, nil
+}
+
+// executeTemplate handles an executetemplate request by paying the outputs of
+// a recorded payment template.  The hash of the published transaction is
+// returned.
+func (s *Server) executeTemplate(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.ExecuteTemplateCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hash, err := w.ExecutePaymentTemplate(ctx, cmd.Name)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		if errors.Is(err, errors.InsufficientBalance) {
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		return nil, err
+	}
+	return hash.String(), nil
+}
+
+// listPaymentTemplates handles a listpaymenttemplates request by describing
+// every recorded payment template.
+func (s *Server) listPaymentTemplates(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	templates, err := w.PaymentTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := w.ChainParams()
+	res := make([]types.PaymentTemplateResult, 0, len(templates))
+	for _, t := range templates {
+		accountName, err := w.AccountName(ctx, t.Account)
+		if err != nil {
+			return nil, err
+		}
+		outputs := make([]types.PaymentTemplateOutput, 0, len(t.Outputs))
+		for _, out := range t.Outputs {
+			var addr string
+			_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, params)
+			if len(addrs) == 1 {
+				addr = addrs[0].String()
+			}
+			outputs = append(outputs, types.PaymentTemplateOutput{
+				Address:     addr,
+				Amount:      dcrutil.Amount(out.Value).ToCoin(),
+				AmountAtoms: out.Value,
+			})
+		}
+		r := types.PaymentTemplateResult{
+			Name:     t.Name,
+			Account:  accountName,
+			Outputs:  outputs,
+			MinConf:  t.MinConf,
+			Interval: t.Interval,
+		}
+		if t.Interval > 0 {
+			r.NextHeight = t.NextHeight
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// deletePaymentTemplate handles a deletepaymenttemplate request by removing
+// a recorded payment template.
+func (s *Server) deletePaymentTemplate(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DeletePaymentTemplateCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.DeletePaymentTemplate(ctx, cmd.Name)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// addContact handles an addcontact request by recording a new address book
+// contact.
+func (s *Server) addContact(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AddContactCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.AddContact(ctx, cmd.Name, cmd.Address)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) || errors.Is(err, errors.Exist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// updateContact handles an updatecontact request by changing the address of
+// a recorded address book contact.
+func (s *Server) updateContact(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.UpdateContactCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.UpdateContact(ctx, cmd.Name, cmd.Address)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) || errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// listContacts handles a listcontacts request by describing all recorded
+// address book contacts.
+func (s *Server) listContacts(ctx context.Context, icmd any) (any, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	contacts, err := w.Contacts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ContactResult, 0, len(contacts))
+	for _, c := range contacts {
+		res = append(res, types.ContactResult{
+			Name:    c.Name,
+			Address: c.Address,
+		})
+	}
+	return res, nil
+}
+
+// deleteContact handles a deletecontact request by removing a recorded
+// address book contact.
+func (s *Server) deleteContact(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.DeleteContactCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.DeleteContact(ctx, cmd.Name)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// resolveDestination returns the address string of a payment destination,
+// which may be either an address or the name of an address book contact.
+// Contact names are never valid addresses.  Destinations which are not
+// contacts are returned unmodified, so that any error describing an invalid
+// address is reported when decoding it.
+func resolveDestination(ctx context.Context, w *wallet.Wallet, dest string) (string, error) {
+	addr, err := w.ContactAddress(ctx, dest)
+	if errors.Is(err, errors.NotExist) {
+		return dest, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
+// resolveDestinations replaces address book contact names used as keys of
+// the amounts and labels maps with their addresses.
+func resolveDestinations(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount,
+	labels map[string]string) (map[string]dcrutil.Amount, map[string]string, error) {
+
+	resolvedAmounts := make(map[string]dcrutil.Amount, len(amounts))
+	for dest, amt := range amounts {
+		addr, err := resolveDestination(ctx, w, dest)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, ok := resolvedAmounts[addr]; ok {
+			return nil, nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"destination %q pays an address which is already paid", dest)
+		}
+		resolvedAmounts[addr] = amt
+	}
+	var resolvedLabels map[string]string
+	if labels != nil {
+		resolvedLabels = make(map[string]string, len(labels))
+		for dest, label := range labels {
+			addr, err := resolveDestination(ctx, w, dest)
+			if err != nil {
+				return nil, nil, err
+			}
+			resolvedLabels[addr] = label
+		}
+	}
+	return resolvedAmounts, resolvedLabels, nil
+}
+
+// sendToAddress handles a sendtoaddress RPC request by creating a new
+// transaction spending unspent transaction outputs for a wallet to another
+// payment address.  Leftover inputs not sent to the payment address or a fee
+// for the miner are sent back to a new address in the wallet.  Upon success,
+// the TxID for the created transaction is returned.
+func (s *Server) sendToAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SendToAddressCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	// Transaction comments are not yet supported.  Error instead of
+	// pretending to save them.
+	if !isNilOrEmpty(cmd.Comment) || !isNilOrEmpty(cmd.CommentTo) {
+		return nil, rpcErrorf(dcrjson.ErrRPCUnimplemented, "transaction comments are unsupported")
+	}
+
+	amt, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that signed integer parameters are positive.
+	if amt < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
+	}
+
+	// Mock up map of address and amount pairs.
+	pairs := map[string]dcrutil.Amount{
+		cmd.Address: amt,
+	}
+	pairs, _, err = resolveDestinations(ctx, w, pairs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	feeRate, err := feeRateArg(cmd.FeeRate)
+	if err != nil {
+		return nil, err
 	}
 
 	// sendtoaddress always spends from the default account, this matches bitcoind
-	return s.sendPairs(ctx, w, pairs, udb.DefaultAccountNum, 1)
+	tx, err := s.sendPairs(ctx, w, pairs, udb.DefaultAccountNum, 1, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	return sendResult(tx, cmd.Verbose), nil
 }
 
 // sendToMultiSig handles a sendtomultisig RPC request by creating a new
@@ -4643,7 +6002,13 @@ func (s *Server) setTxFee(ctx context.Context, icmd any) (any, error) {
 	if err != nil {
 		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-	w.SetRelayFee(relayFee)
+	err = w.SetRelayFee(relayFee)
+	if errors.Is(err, errors.Policy) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	// A boolean true result is returned upon success.
 	return true, nil
@@ -5099,9 +6464,9 @@ func (src *scriptChangeSource) ScriptSize() int {
 }
 
 func makeScriptChangeSource(address string, params *chaincfg.Params) (*scriptChangeSource, error) {
-	destinationAddress, err := stdaddr.DecodeAddress(address, params)
+	destinationAddress, err := addrcheck.Decode(address, params)
 	if err != nil {
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
 	}
 	version, script := destinationAddress.PaymentScript()
 	source := &scriptChangeSource{
@@ -5118,6 +6483,179 @@ func sumOutputValues(outputs []*wire.TxOut) (totalOutput dcrutil.Amount) {
 	return totalOutput
 }
 
+// simnetRPC returns the consensus RPC client of a simnet wallet.  Simnet
+// helper methods error on other networks and when the wallet is not
+// synchronized over dcrd RPC.
+func simnetRPC(w *wallet.Wallet) (*dcrd.RPC, error) {
+	if w.ChainParams().Net != wire.SimNet {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc,
+			"method is only available on simnet")
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+	chainSyncer, ok := n.(*chain.Syncer)
+	if !ok {
+		return nil, rpcErrorf(dcrjson.ErrRPCClientNotConnected,
+			"method requires dcrd RPC synchronization")
+	}
+	return chainSyncer.RPC(), nil
+}
+
+// generateBlocks mines blocks with the CPU miner of the consensus server and
+// waits for the wallet to connect the last generated block.
+func generateBlocks(ctx context.Context, w *wallet.Wallet, rpc *dcrd.RPC,
+	numBlocks uint32) ([]*chainhash.Hash, error) {
+
+	hashes, err := rpc.Generate(ctx, numBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+	last := hashes[len(hashes)-1]
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if tipHash, _ := w.MainChainTip(ctx); tipHash == *last {
+			return hashes, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, rpcErrorf(dcrjson.ErrRPCMisc, "wallet did not "+
+				"connect generated block %v: %v", last, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// simnetAdvance handles the simnetadvance command.  Blocks are mined to
+// advance the chain, and the time recorded by block headers, for tests of
+// maturity and expiry.
+func (s *Server) simnetAdvance(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SimnetAdvanceCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	rpc, err := simnetRPC(w)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.NumBlocks == 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"numblocks must be positive")
+	}
+
+	hashes, err := generateBlocks(ctx, w, rpc, cmd.NumBlocks)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]string, len(hashes))
+	for i, h := range hashes {
+		res[i] = h.String()
+	}
+	return res, nil
+}
+
+// simnetFundAccount handles the simnetfundaccount command.  Funds are sent
+// from another account to a new address of the account, and a block is mined
+// to confirm the payment.
+func (s *Server) simnetFundAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SimnetFundAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	rpc, err := simnetRPC(w)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		return nil, err
+	}
+	fromAccount, err := w.AccountNumber(ctx, *cmd.FromAccount)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if amount <= 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"amount must be positive")
+	}
+
+	addr, err := w.NewExternalAddress(ctx, account, wallet.WithGapPolicyWrap())
+	if err != nil {
+		return nil, err
+	}
+	tx, err := s.sendPairs(ctx, w, map[string]dcrutil.Amount{
+		addr.String(): amount,
+	}, fromAccount, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := generateBlocks(ctx, w, rpc, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SimnetFundAccountResult{
+		TxHash:    tx.TxHash().String(),
+		BlockHash: hashes[0].String(),
+	}, nil
+}
+
+// simnetReorg handles the simnetreorg command.  The consensus server
+// invalidates the main chain block depth blocks below the next block, and
+// mines a longer replacement chain, reorganizing depth blocks.  The
+// invalidated blocks are not reconsidered.
+func (s *Server) simnetReorg(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SimnetReorgCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	rpc, err := simnetRPC(w)
+	if err != nil {
+		return nil, err
+	}
+
+	oldTip, tipHeight := w.MainChainTip(ctx)
+	if cmd.Depth == 0 || int64(cmd.Depth) > int64(tipHeight) {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"depth must be between 1 and the main chain height %d", tipHeight)
+	}
+	forkHeight := tipHeight - int32(cmd.Depth) + 1
+	info, err := w.BlockInfo(ctx, wallet.NewBlockIdentifierFromHeight(forkHeight))
+	if err != nil {
+		return nil, err
+	}
+	err = rpc.InvalidateBlock(ctx, &info.Hash)
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := generateBlocks(ctx, w, rpc, cmd.Depth+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SimnetReorgResult{
+		OldTip: oldTip.String(),
+		NewTip: hashes[len(hashes)-1].String(),
+		Height: forkHeight + int32(cmd.Depth),
+	}, nil
+}
+
 // sweepAccount handles the sweepaccount command.
 func (s *Server) sweepAccount(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.SweepAccountCmd)
@@ -5173,11 +6711,14 @@ func (s *Server) sweepAccount(ctx context.Context, icmd any) (any, error) {
 		return nil, err
 	}
 
+	totalOutput := sumOutputValues(tx.Tx.TxOut)
 	res := &types.SweepAccountResult{
-		UnsignedTransaction:       b.String(),
-		TotalPreviousOutputAmount: tx.TotalInput.ToCoin(),
-		TotalOutputAmount:         sumOutputValues(tx.Tx.TxOut).ToCoin(),
-		EstimatedSignedSize:       uint32(tx.EstimatedSignedSerializeSize),
+		UnsignedTransaction:            b.String(),
+		TotalPreviousOutputAmount:      tx.TotalInput.ToCoin(),
+		TotalPreviousOutputAmountAtoms: int64(tx.TotalInput),
+		TotalOutputAmount:              totalOutput.ToCoin(),
+		TotalOutputAmountAtoms:         int64(totalOutput),
+		EstimatedSignedSize:            uint32(tx.EstimatedSignedSerializeSize),
 	}
 
 	return res, nil
@@ -5280,6 +6821,42 @@ func (s *Server) validatePreDCP0005CF(ctx context.Context, icmd any) (any, error
 	return err == nil, err
 }
 
+// verifyExternalAddress handles the verifyexternaladdress command by asking
+// the configured external signer to display and confirm a wallet address.
+func (s *Server) verifyExternalAddress(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.VerifyExternalAddressCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if s.cfg.ExternalSigner == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc,
+			"no external signer is configured")
+	}
+
+	addr, err := decodeAddress(cmd.Address, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := w.VerifyExternalAddress(ctx, s.cfg.ExternalSigner, addr)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAddressNotInWallet
+		}
+		return nil, err
+	}
+
+	return &types.VerifyExternalAddressResult{
+		Address:   addr.String(),
+		Account:   v.Account,
+		Branch:    v.Branch,
+		Index:     v.Child,
+		Confirmed: v.Confirmed,
+	}, nil
+}
+
 // verifyMessage handles the verifymessage command by verifying the provided
 // compact signature for the given address and message.
 func (s *Server) verifyMessage(ctx context.Context, icmd any) (any, error) {
@@ -5288,9 +6865,9 @@ func (s *Server) verifyMessage(ctx context.Context, icmd any) (any, error) {
 	var valid bool
 
 	// Decode address and base64 signature from the request.
-	addr, err := stdaddr.DecodeAddress(cmd.Address, s.activeNet)
+	addr, err := addrcheck.Decode(cmd.Address, s.activeNet)
 	if err != nil {
-		return nil, err
+		return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
 	}
 	sig, err := base64.StdEncoding.DecodeString(cmd.Signature)
 	if err != nil {
@@ -5380,7 +6957,7 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 		coinType = 0
 	}
 
-	unlocked := !(w.Locked())
+	unlocked := !(w.Locked() || s.sessionLocked(ctx))
 	fi := w.RelayFee()
 	voteBits := w.VoteBits()
 	var voteVersion uint32
@@ -5393,6 +6970,7 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 		Unlocked:         unlocked,
 		CoinType:         coinType,
 		TxFee:            fi.ToCoin(),
+		TxFeeAtoms:       int64(fi),
 		VoteBits:         voteBits.Bits,
 		VoteBitsExtended: hex.EncodeToString(voteBits.ExtendedBits),
 		VoteVersion:      voteVersion,
@@ -5401,6 +6979,14 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 		ManualTickets:    w.ManualTickets(),
 	}
 
+	vvs, err := w.VoteVersionStatus(ctx)
+	if err != nil {
+		log.Errorf("Failed to get vote version status: %v", err)
+	} else {
+		wi.NetworkStakeVersion = vvs.NetworkStakeVersion
+		wi.VoteVersionOutdated = vvs.Outdated()
+	}
+
 	birthState, err := w.BirthState(ctx)
 	if err != nil {
 		log.Errorf("Failed to get birth state: %v", err)
@@ -5410,6 +6996,36 @@ func (s *Server) walletInfo(ctx context.Context, icmd any) (any, error) {
 		wi.BirthHeight = birthState.Height
 	}
 
+	for _, c := range w.TipChecks() {
+		r := types.TipCheckResult{
+			Source:       c.Source,
+			Time:         c.Time.Unix(),
+			Height:       c.Height,
+			WalletHeight: c.WalletHeight,
+			Diverged:     c.Diverged,
+		}
+		if c.Err != nil {
+			r.Error = c.Err.Error()
+		}
+		wi.TipChecks = append(wi.TipChecks, r)
+	}
+
+	if s.cfg.Supervisor != nil {
+		for _, st := range s.cfg.Supervisor.Status() {
+			r := types.SubsystemResult{
+				Name:     st.Name,
+				State:    st.State.String(),
+				Started:  st.Started.Unix(),
+				Restarts: st.Restarts,
+			}
+			if st.LastError != nil {
+				r.LastError = st.LastError.Error()
+				r.LastErrorTime = st.LastErrorTime.Unix()
+			}
+			wi.Subsystems = append(wi.Subsystems, r)
+		}
+	}
+
 	return wi, nil
 }
 
@@ -5422,7 +7038,7 @@ func (s *Server) walletIsLocked(ctx context.Context, icmd any) (any, error) {
 		return nil, errUnloadedWallet
 	}
 
-	return w.Locked(), nil
+	return w.Locked() || s.sessionLocked(ctx), nil
 }
 
 // walletLock handles a walletlock request by locking the all account
@@ -5434,7 +7050,13 @@ func (s *Server) walletLock(ctx context.Context, icmd any) (any, error) {
 		return nil, errUnloadedWallet
 	}
 
+	// A session-bound unlock may only be locked by its owner.  The wallet
+	// already appears locked to all other clients.
+	if s.sessionLocked(ctx) {
+		return nil, nil
+	}
 	w.Lock()
+	s.setSessionUnlock(nil)
 	return nil, nil
 }
 
@@ -5442,6 +7064,10 @@ func (s *Server) walletLock(ctx context.Context, icmd any) (any, error) {
 // wallet. The decryption key is saved in the wallet until timeout seconds
 // expires, after which the wallet is locked. A timeout of 0 leaves the wallet
 // unlocked indefinitely.
+//
+// A session-bound unlock ties the unlocked wallet to the websocket connection
+// of the request.  Until the wallet is locked or the connection closes, all
+// other clients see the wallet as locked.
 func (s *Server) walletPassphrase(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.WalletPassphraseCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -5449,13 +7075,33 @@ func (s *Server) walletPassphrase(ctx context.Context, icmd any) (any, error) {
 		return nil, errUnloadedWallet
 	}
 
+	var session *websocketClient
+	if cmd.Session != nil && *cmd.Session {
+		session = websocketClientFromContext(ctx)
+		if session == nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"session-bound unlock requires a websocket connection")
+		}
+		// Record the owner before unlocking so other clients never
+		// observe the unlocked wallet.
+		s.setSessionUnlock(session)
+	}
+
 	timeout := time.Second * time.Duration(cmd.Timeout)
 	var unlockAfter <-chan time.Time
 	if timeout != 0 {
 		unlockAfter = time.After(timeout)
 	}
 	err := w.Unlock(ctx, []byte(cmd.Passphrase), unlockAfter)
-	return nil, err
+	if err != nil {
+		// Failed unlocks lock the wallet.
+		s.setSessionUnlock(nil)
+		return nil, err
+	}
+	if session == nil {
+		s.setSessionUnlock(nil)
+	}
+	return nil, nil
 }
 
 // walletPassphraseChange responds to the walletpassphrasechange request
@@ -5600,6 +7246,43 @@ func (s *Server) setAccountPassphrase(ctx context.Context, icmd any) (any, error
 	return nil, err
 }
 
+// setVotingPassphrase handles a setvotingpassphrase request by setting or
+// changing the passphrase of the voting domain.
+func (s *Server) setVotingPassphrase(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetVotingPassphraseCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := w.SetVotingPassphrase(ctx, []byte(cmd.Passphrase))
+	return nil, err
+}
+
+// setVotingAccount handles a setvotingaccount request by adding an account to
+// or removing an account from the voting domain.
+func (s *Server) setVotingAccount(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetVotingAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	if cmd.Voting == nil || *cmd.Voting {
+		err = w.AddVotingAccount(ctx, account)
+	} else {
+		err = w.RemoveVotingAccount(ctx, account)
+	}
+	return nil, err
+}
+
 func (s *Server) accountUnlocked(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.AccountUnlockedCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -5634,6 +7317,53 @@ func (s *Server) accountUnlocked(ctx context.Context, icmd any) (any, error) {
 	}, nil
 }
 
+// accountSpendPolicy handles an accountspendpolicy request by returning the
+// spend policy of an account.
+func (s *Server) accountSpendPolicy(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.AccountSpendPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	policy, err := w.AccountSpendPolicy(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return policy.String(), nil
+}
+
+// setAccountSpendPolicy handles a setaccountspendpolicy request by recording
+// the spend policy of an account.
+func (s *Server) setAccountSpendPolicy(ctx context.Context, icmd any) (any, error) {
+	cmd := icmd.(*types.SetAccountSpendPolicyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	policy, err := udb.ParseSpendPolicy(cmd.Policy)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	err = w.SetAccountSpendPolicy(ctx, account, policy)
+	return nil, err
+}
+
 func (s *Server) unlockAccount(ctx context.Context, icmd any) (any, error) {
 	cmd := icmd.(*types.UnlockAccountCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -4,26 +4,39 @@ package jsonrpc
 
 func helpDescsEnUS() map[string]string {
 	return map[string]string{
-		"abandontransaction":        "abandontransaction \"hash\"\n\nRemove an unconfirmed transaction and all dependent transactions\n\nArguments:\n1. hash (string, required) Hash of transaction to remove\n\nResult:\nNothing\n",
+		"abandontransaction":        "abandontransaction \"hash\"\n\nRemove an unconfirmed transaction and all dependent transactions, making the outputs they spend available again. Mined transactions, and transactions in the mempool of a dcrd RPC backend, may not be abandoned\n\nArguments:\n1. hash (string, required) Hash of transaction to remove\n\nResult:\nNothing\n",
 		"accountaddressindex":       "accountaddressindex \"account\" branch\n\nGet the current address index for some account branch\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n\nResult:\nn (numeric) The address index for this account branch\n",
+		"accountspendpolicy":        "accountspendpolicy \"account\"\n\nReport the spend policy (hot, warm, or cold) of an account\n\nArguments:\n1. account (string, required) Account name\n\nResult:\n\"value\" (string) The spend policy of the account\n",
 		"accountsyncaddressindex":   "accountsyncaddressindex \"account\" branch index\n\nSynchronize an account branch to some passed address index\n\nArguments:\n1. account (string, required)  String for the account\n2. branch  (numeric, required) Number for the branch (0=external, 1=internal)\n3. index   (numeric, required) The address index to synchronize to\n\nResult:\nNothing\n",
 		"accountunlocked":           "accountunlocked \"account\"\n\nReport account encryption and locked status\n\nArguments:\n1. account (string, required) Account name\n\nResult:\n{\n \"encrypted\": true|false, (boolean) Whether the account is individually encrypted with a separate passphrase\n \"unlocked\": true|false,  (boolean) If the individually encrypted account is unlocked. Omitted for unencrypted accounts.\n}                         \n",
+		"addcontact":                "addcontact \"name\" \"address\"\n\nRecords a named address book contact for an external payment address.\nContact names may be used in place of addresses by the sendfrom, sendmany, and sendtoaddress methods.\n\nArguments:\n1. name    (string, required) Unique name of the contact, which may not itself be an address\n2. address (string, required) Payment address of the contact, which must be valid for the wallet's network\n\nResult:\nNothing\n",
 		"addmultisigaddress":        "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
 		"addtransaction":            "addtransaction \"blockhash\" \"transaction\"\n\nManually record a transaction mined in a main chain block\n\nArguments:\n1. blockhash   (string, required) Hash of block which mines transaction\n2. transaction (string, required) Hex-encoded serialized transaction\n\nResult:\nNothing\n",
 		"auditreuse":                "auditreuse (since)\n\nReports outputs identifying address reuse\n\nArguments:\n1. since (numeric, optional) Only report reusage since some main chain block height\n\nResult:\n{\n \"Array of outpoints referencing the reused address\": Reused address, (object) Object keying reused addresses to arrays of outpoint strings\n ...\n}\n",
 		"consolidate":               "consolidate inputs (\"account\" \"address\")\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs  (numeric, required) Number of UTXOs to consolidate as inputs\n2. account (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction\n",
 		"createmultisig":            "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
 		"createnewaccount":          "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
+		"createpaymenttemplate":     "createpaymenttemplate \"name\" \"account\" {\"address\":amount,...} (interval=0 minconf=1)\n\nRecords a named payment template which pays fixed amounts to fixed addresses from an account.\nTemplates are paid with executetemplate, and are paid automatically every interval blocks when the interval is non-zero.\n\nArguments:\n1. name    (string, required) Unique name of the payment template\n2. account (string, required) Account to pay from and return change to\n3. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to pay the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to pay each address\n ...\n}\n4. interval (numeric, optional, default=0) Number of blocks between automatic payments, or 0 to only pay on demand\n5. minconf  (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n\nResult:\nNothing\n",
 		"createrawtransaction":      "createrawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\n\nReturns a new transaction spending the provided inputs and sending to the provided addresses.\nThe transaction inputs are not signed in the created transaction.\nThe signrawtransaction RPC command provided by wallet must be used to sign the resulting transaction.\n\nArguments:\n1. inputs (array of object, required) The inputs to the transaction\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n2. amounts (object, required) JSON object with the destination addresses as keys and amounts as values\n{\n \"address\": n.nnn, (object) The destination address as the key and the amount in DCR as the value\n ...\n}\n3. locktime (numeric, optional) Locktime value; a non-zero value will also locktime-activate the inputs\n4. expiry   (numeric, optional) Expiry value; a non-zero value when the transaction expiry\n\nResult:\n\"value\" (string) Hex-encoded bytes of the serialized transaction\n",
 		"createsignature":           "createsignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\n\nGenerate a signature for a transaction input script.\n\nArguments:\n1. address               (string, required)  The address of the private key to use to create the signature.\n2. inputindex            (numeric, required) The index of the transaction input to sign.\n3. hashtype              (numeric, required) The signature hash flags to use.\n4. previouspkscript      (string, required)  The hex encoded previous output script or P2SH redeem script.\n5. serializedtransaction (string, required)  The hex encoded transaction to add input signatures to.\n\nResult:\n{\n \"signature\": \"value\", (string) The hex encoded signature.\n \"publickey\": \"value\", (string) The hex encoded serialized compressed pubkey of the address.\n}                      \n",
+		"deletecontact":             "deletecontact \"name\"\n\nRemoves a recorded address book contact.\n\nArguments:\n1. name (string, required) Name of the contact\n\nResult:\nNothing\n",
+		"deletepaymenttemplate":     "deletepaymenttemplate \"name\"\n\nRemoves a recorded payment template.\n\nArguments:\n1. name (string, required) Name of the payment template\n\nResult:\nNothing\n",
 		"disapprovepercent":         "disapprovepercent\n\nReturns the wallet's current block disapprove percent per vote. i.e. 100 means that all votes disapprove the block they are called on. Only used for testing purposes.\n\nArguments:\nNone\n\nResult:\nn (numeric) The disapprove percent. When voting, this percent of votes will randomly disapprove the block they are called on.\n",
 		"discoverusage":             "discoverusage (\"startblock\" discoveraccounts gaplimit)\n\nPerform address and/or account discovery\n\nArguments:\n1. startblock       (string, optional)  Hash of block to begin discovery from, or null to scan from the genesis block\n2. discoveraccounts (boolean, optional) Perform account discovery in addition to address discovery.  Requires unlocked wallet.\n3. gaplimit         (numeric, optional) Allowed unused address gap.\n\nResult:\nNothing\n",
+		"droptransactionhistory":    "droptransactionhistory (keepunmined=true)\n\nRemoves all transaction history from the wallet and rebuilds it by rescanning the main chain from the wallet birthday, blocking until the rescan completes.\nBlock headers and committed filters are kept.\nOther transaction processing is paused while the history is removed.\n\nArguments:\n1. keepunmined (boolean, optional, default=true) Add unmined transactions back to the wallet after the rescan, except those conflicting with the rescanned history\n\nResult:\n{\n \"rescanfrom\": n,     (numeric) The height the main chain was rescanned from\n \"unminedkept\": n,    (numeric) The number of unmined transactions added back to the wallet\n \"unmineddropped\": n, (numeric) The number of unmined transactions discarded\n}                     \n",
 		"dumpprivkey":               "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
-		"fundrawtransaction":        "fundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\n\nAdds unsigned inputs and change output to a raw transaction\n\nArguments:\n1. hexstring   (string, required) Serialized transaction in hex encoding\n2. fundaccount (string, required) Account of outputs to spend in transaction\n3. options     (object, optional) Object to specify fixed change address, alternative fee rate, and confirmation target\n{\n \"changeaddress\": \"value\", (string)  Provide a change address rather than deriving one from the funding account\n \"feerate\": n.nnn,         (numeric) Alternative fee rate\n \"conf_target\": n,         (numeric) Required confirmations of selected previous outputs\n}                          \n\nResult:\n{\n \"hex\": \"value\", (string)  Funded transaction in hex encoding\n \"fee\": n.nnn,   (numeric) Absolute fee of funded transaction\n}                \n",
+		"dumpwallet":                "dumpwallet \"filename\" confirm\n\nWrites every private key and redeem script of the wallet to a new file, along with the account label, derivation path, and first use time of each.\nThe wallet must be unlocked for this request to succeed.  Existing files are never overwritten.\n\nArguments:\n1. filename (string, required)  Path of the file to create on the wallet server\n2. confirm  (boolean, required) Must be true to confirm the export of all private keys\n\nResult:\n{\n \"filename\": \"value\", (string) Path of the created file\n}                     \n",
+		"executesweepplan":          "executesweepplan \"planid\" [\"accounts\",...] \"address\" (minconf=1 feerate)\n\nSigns and publishes the transactions of a sweep plan returned by plansweep.\nThe plan is recomputed from the remaining arguments, which must match those passed to plansweep, and is only executed if its ID is unchanged.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. planid   (string, required)             The plan ID returned by plansweep\n2. accounts (array of string, required)    The accounts to sweep\n3. address  (string, required)             The destination address\n4. minconf  (numeric, optional, default=1) Minimum number of block confirmations of swept outputs\n5. feerate  (numeric, optional)            Fee rate in DCR/kB (default is the wallet's relay fee)\n\nResult:\n[\"value\",...] (array of string) The transaction hashes of the published transactions\n",
+		"executetemplate":           "executetemplate \"name\"\n\nPays the outputs of a recorded payment template.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. name (string, required) Name of the payment template\n\nResult:\n\"value\" (string) The transaction hash of the payment\n",
+		"exportticket":              "exportticket \"tickethash\"\n\nExports the voting rights of a ticket, which may be imported by another wallet with importticket.\nThe result contains private keys and must be kept secret.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. tickethash (string, required) Hash of the ticket purchase transaction\n\nResult:\n{\n \"network\": \"value\",          (string)          Network the ticket was exported from\n \"ticket\": \"value\",           (string)          Serialized ticket purchase transaction in hex encoding\n \"blockhash\": \"value\",        (string)          Hash of the block the ticket is mined in, omitted for unmined tickets\n \"votingkeys\": [\"value\",...], (array of string) WIF-encoded private keys of the ticket's voting address\n \"redeemscript\": \"value\",     (string)          Hex encoded redeem script of a P2SH voting address\n}                             \n",
+		"fundrawtransaction":        "fundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\n\nAdds unsigned inputs and change output to a raw transaction\n\nArguments:\n1. hexstring   (string, required) Serialized transaction in hex encoding\n2. fundaccount (string, required) Account of outputs to spend in transaction\n3. options     (object, optional) Object to specify fixed change address, alternative fee rate, and confirmation target\n{\n \"changeaddress\": \"value\", (string)  Provide a change address rather than deriving one from the funding account\n \"feerate\": n.nnn,         (numeric) Alternative fee rate\n \"conf_target\": n,         (numeric) Required confirmations of selected previous outputs\n}                          \n\nResult:\n{\n \"hex\": \"value\", (string)  Funded transaction in hex encoding\n \"fee\": n.nnn,   (numeric) Absolute fee of funded transaction\n \"feeatoms\": n,  (numeric) Absolute fee of funded transaction in atoms\n}                \n",
 		"getaccount":                "getaccount \"address\"\n\nLookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
 		"getaccountaddress":         "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
 		"getaddressesbyaccount":     "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
-		"getbalance":                "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account (string, optional)             The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"balances\": [{                         (array of object) Balances for all accounts.\n  \"accountname\": \"value\",               (string)          Name of account.\n  \"immaturecoinbaserewards\": n.nnn,     (numeric)         Immature Coinbase reward coins.\n  \"immaturestakegeneration\": n.nnn,     (numeric)         Number of immature stake coins.\n  \"lockedbytickets\": n.nnn,             (numeric)         Coins locked by tickets.\n  \"spendable\": n.nnn,                   (numeric)         Spendable number of coins.\n  \"total\": n.nnn,                       (numeric)         Total amount of coins.\n  \"unconfirmed\": n.nnn,                 (numeric)         Unconfirmed number of coins.\n  \"votingauthority\": n.nnn,             (numeric)         Coins for voting authority.\n },...],                                                  \n \"blockhash\": \"value\",                  (string)          Block hash.\n \"totalimmaturecoinbaserewards\": n.nnn, (numeric)         Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": n.nnn, (numeric)         Total number of immature stake coins.\n \"totallockedbytickets\": n.nnn,         (numeric)         Total number of coins locked by tickets.\n \"totalspendable\": n.nnn,               (numeric)         Total number of spendable number of coins.\n \"cumulativetotal\": n.nnn,              (numeric)         Total number of coins.\n \"totalunconfirmed\": n.nnn,             (numeric)         Total number of unconfirmed coins.\n \"totalvotingauthority\": n.nnn,         (numeric)         Total number of coins for voting authority.\n}                                       \n",
+		"getattestation":            "getattestation\n\nReturns the most recently recorded signed attestation of the wallet's state, allowing external monitors to detect wallet downtime and tampering of reported state.\nThe signature is a DER-encoded ECDSA signature by the attestation public key over the BLAKE-256 hash of the message.\nAttestations are recorded periodically when the attestinterval option is set.\n\nArguments:\nNone\n\nResult:\n{\n \"sequence\": n,           (numeric) Sequence number of the attestation, starting at zero\n \"time\": n,               (numeric) Unix time the attestation was recorded\n \"tiphash\": \"value\",      (string)  Hash of the wallet's main chain tip block\n \"tipheight\": n,          (numeric) Height of the wallet's main chain tip block\n \"balanceshash\": \"value\", (string)  Hash committing to the balances of every account\n \"ticketcount\": n,        (numeric) Number of unspent tickets owned by the wallet\n \"prevhash\": \"value\",     (string)  Hash of the previous attestation's message, or all zeros for the first attestation\n \"message\": \"value\",      (string)  Hex-encoded serialized attestation message which is signed\n \"pubkey\": \"value\",       (string)  Hex-encoded compressed public key of the attestation signing key\n \"signature\": \"value\",    (string)  Hex-encoded signature of the attestation message\n}                         \n",
+		"getauditlog":               "getauditlog (count=100 from=0)\n\nReturns recorded mutating requests from the RPC audit log, oldest first.\n\nArguments:\n1. count (numeric, optional, default=100) Maximum number of requests to return\n2. from  (numeric, optional, default=0)   Number of most recent requests to skip\n\nResult:\n[{\n \"time\": n,               (numeric)         The Unix time at which the request completed\n \"method\": \"value\",       (string)          The requested method\n \"params\": [\"value\",...], (array of string) The JSON encoding of each request parameter, with secret parameters redacted\n \"client\": \"value\",       (string)          The network address of the client\n \"certificate\": \"value\",  (string)          The common name of the TLS client certificate, if used for authentication\n \"txids\": [\"value\",...],  (array of string) Transaction hashes returned by the request\n \"error\": \"value\",        (string)          The error returned by the request, if it failed\n},...]\n",
+		"getbalance":                "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account (string, optional)             The account name to query the balance for, \"parent/*\" to consider a parent account and all of its sub-accounts, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"balances\": [{                          (array of object) Balances for all accounts.\n  \"accountname\": \"value\",                (string)          Name of account.\n  \"immaturecoinbaserewards\": n.nnn,      (numeric)         Immature Coinbase reward coins.\n  \"immaturecoinbaserewardsatoms\": n,     (numeric)         Immature coinbase reward atoms.\n  \"immaturestakegeneration\": n.nnn,      (numeric)         Number of immature stake coins.\n  \"immaturestakegenerationatoms\": n,     (numeric)         Immature stake atoms.\n  \"lockedbytickets\": n.nnn,              (numeric)         Coins locked by tickets.\n  \"lockedbyticketsatoms\": n,             (numeric)         Atoms locked by tickets.\n  \"spendable\": n.nnn,                    (numeric)         Spendable number of coins.\n  \"spendableatoms\": n,                   (numeric)         Spendable atoms.\n  \"total\": n.nnn,                        (numeric)         Total amount of coins.\n  \"totalatoms\": n,                       (numeric)         Total amount in atoms.\n  \"unconfirmed\": n.nnn,                  (numeric)         Unconfirmed number of coins.\n  \"unconfirmedatoms\": n,                 (numeric)         Unconfirmed atoms.\n  \"votingauthority\": n.nnn,              (numeric)         Coins for voting authority.\n  \"votingauthorityatoms\": n,             (numeric)         Atoms for voting authority.\n  \"effective\": n.nnn,                    (numeric)         Coins which can be spent right now: spendable coins, which exclude outputs spent by the wallet's own unmined transactions, plus unconfirmed change returned by those transactions.\n  \"effectiveatoms\": n,                   (numeric)         Effective balance in atoms.\n },...],                                                   \n \"blockhash\": \"value\",                   (string)          Block hash.\n \"totalimmaturecoinbaserewards\": n.nnn,  (numeric)         Total number of immature coinbase reward coins.\n \"totalimmaturecoinbaserewardsatoms\": n, (numeric)         Total immature coinbase reward atoms.\n \"totalimmaturestakegeneration\": n.nnn,  (numeric)         Total number of immature stake coins.\n \"totalimmaturestakegenerationatoms\": n, (numeric)         Total immature stake atoms.\n \"totallockedbytickets\": n.nnn,          (numeric)         Total number of coins locked by tickets.\n \"totallockedbyticketsatoms\": n,         (numeric)         Total atoms locked by tickets.\n \"totalspendable\": n.nnn,                (numeric)         Total number of spendable number of coins.\n \"totalspendableatoms\": n,               (numeric)         Total spendable atoms.\n \"cumulativetotal\": n.nnn,               (numeric)         Total number of coins.\n \"cumulativetotalatoms\": n,              (numeric)         Total amount in atoms.\n \"totalunconfirmed\": n.nnn,              (numeric)         Total number of unconfirmed coins.\n \"totalunconfirmedatoms\": n,             (numeric)         Total unconfirmed atoms.\n \"totalvotingauthority\": n.nnn,          (numeric)         Total number of coins for voting authority.\n \"totalvotingauthorityatoms\": n,         (numeric)         Total atoms for voting authority.\n \"totaleffective\": n.nnn,                (numeric)         Total number of coins which can be spent right now.\n \"totaleffectiveatoms\": n,               (numeric)         Total effective balance in atoms.\n}                                        \n",
+		"getbalancehistory":         "getbalancehistory (days=30)\n\nReturns the daily balance snapshots of the most recent days, oldest first.\nSnapshots are only recorded when the wallet is run with the balancesnapshots option, and days on which the wallet was not running have no snapshot.\n\nArguments:\n1. days (numeric, optional, default=30) Number of most recent UTC days, including the current day, to return snapshots for\n\nResult:\n[{\n \"date\": \"value\",           (string)  The UTC date of the snapshot (YYYY-MM-DD)\n \"time\": n,                 (numeric) The Unix time at which the snapshot was recorded\n \"height\": n,               (numeric) The main chain tip height when the snapshot was recorded\n \"total\": n.nnn,            (numeric) Total balance of all accounts\n \"totalatoms\": n,           (numeric) Total balance of all accounts in atoms\n \"lockedbytickets\": n.nnn,  (numeric) Balance locked by tickets\n \"lockedbyticketsatoms\": n, (numeric) Balance locked by tickets in atoms\n \"ticketcount\": n,          (numeric) Number of unspent tickets\n \"rewards\": n.nnn,          (numeric) Vote rewards earned since the previous snapshot\n \"rewardsatoms\": n,         (numeric) Vote rewards earned since the previous snapshot in atoms\n},...]\n",
 		"getbestblock":              "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
 		"getbestblockhash":          "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
 		"getblockcount":             "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
@@ -32,78 +45,98 @@ func helpDescsEnUS() map[string]string {
 		"getblock":                  "getblock \"hash\" (verbose=true verbosetx=false)\n\nReturns information about a block given its hash.\n\nArguments:\n1. hash      (string, required)                 The hash of the block\n2. verbose   (boolean, optional, default=true)  Specifies the block is returned as a JSON object instead of hex-encoded string\n3. verbosetx (boolean, optional, default=false) Specifies that each transaction is returned as a JSON object and only applies if the verbose flag is true (dcrd extension)\n\nResult:\n{\n \"hash\": \"value\",               (string)          The hash of the block (same as provided)\n \"powhash\": \"value\",            (string)          The Proof-of-Work hash of the block (same as hash prior to DCP0011 activation)\n \"confirmations\": n,            (numeric)         The number of confirmations\n \"size\": n,                     (numeric)         The size of the block\n \"height\": n,                   (numeric)         The height of the block in the block chain\n \"version\": n,                  (numeric)         The block version\n \"merkleroot\": \"value\",         (string)          Root hash of the merkle tree\n \"stakeroot\": \"value\",          (string)          The block's sstx hashes the were included\n \"tx\": [\"value\",...],           (array of string) The transaction hashes (only when verbosetx=false)\n \"rawtx\": [{                    (array of object) The transactions as JSON objects (only when verbosetx=true)\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in DCR\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"stx\": [\"value\",...],          (array of string) The block's sstx hashes the were included\n \"rawstx\": [{                   (array of object) The block's raw sstx hashes the were included\n  \"hex\": \"value\",               (string)          Hex-encoded transaction\n  \"txid\": \"value\",              (string)          The hash of the transaction\n  \"version\": n,                 (numeric)         The transaction version\n  \"locktime\": n,                (numeric)         The transaction lock time\n  \"expiry\": n,                  (numeric)         The transacion expiry\n  \"vin\": [{                     (array of object) The transaction inputs as JSON objects\n   \"coinbase\": \"value\",         (string)          The hex-encoded bytes of the signature script (coinbase txns only)\n   \"stakebase\": \"value\",        (string)          The hex-encoded bytes of the signature script (vote txns only)\n   \"treasurybase\": true|false,  (boolean)         Whether or not the input is a treasury base (treasurybase txns only)\n   \"treasuryspend\": \"value\",    (string)          The hex-encoded bytes of the signature script (treasury spend txns only)\n   \"txid\": \"value\",             (string)          The hash of the origin transaction (non-coinbase txns only)\n   \"vout\": n,                   (numeric)         The index of the output being redeemed from the origin transaction (non-coinbase txns only)\n   \"tree\": n,                   (numeric)         The tree of the transaction\n   \"sequence\": n,               (numeric)         The script sequence number\n   \"amountin\": n.nnn,           (numeric)         The amount in\n   \"blockheight\": n,            (numeric)         The block height of the origin transaction\n   \"blockindex\": n,             (numeric)         The block idx of the origin transaction\n   \"scriptSig\": {               (object)          The signature script used to redeem the origin transaction as a JSON object (non-coinbase txns only)\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n   },                                             \n  },...],                                         \n  \"vout\": [{                    (array of object) The transaction outputs as JSON objects\n   \"value\": n.nnn,              (numeric)         The amount in DCR\n   \"n\": n,                      (numeric)         The index of this transaction output\n   \"version\": n,                (numeric)         The version of the public key script\n   \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n    \"asm\": \"value\",             (string)          Disassembly of the script\n    \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n    \"reqSigs\": n,               (numeric)         The number of required signatures\n    \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n    \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n    \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n    \"version\": n,               (numeric)         The script version\n   },                                             \n  },...],                                         \n  \"blockhash\": \"value\",         (string)          The hash of the block that contains the transaction\n  \"blockheight\": n,             (numeric)         The height of the block that contains the transaction\n  \"blockindex\": n,              (numeric)         The index within the array of transactions contained by the block\n  \"confirmations\": n,           (numeric)         Number of confirmations of the block\n  \"time\": n,                    (numeric)         Transaction time in seconds since 1 Jan 1970 GMT\n  \"blocktime\": n,               (numeric)         Block time in seconds since the 1 Jan 1970 GMT\n },...],                                          \n \"time\": n,                     (numeric)         The block time in seconds since 1 Jan 1970 GMT\n \"mediantime\": n,               (numeric)         The median block time over the last 11 blocks\n \"nonce\": n,                    (numeric)         The block nonce\n \"votebits\": n,                 (numeric)         The block's voting results\n \"finalstate\": \"value\",         (string)          The block's finalstate\n \"voters\": n,                   (numeric)         The number votes in the block\n \"freshstake\": n,               (numeric)         The number of new tickets in the block\n \"revocations\": n,              (numeric)         The number of revocations in the block\n \"poolsize\": n,                 (numeric)         The size of the live ticket pool\n \"bits\": \"value\",               (string)          The bits which represent the block difficulty\n \"sbits\": n.nnn,                (numeric)         The stake difficulty of the block\n \"extradata\": \"value\",          (string)          Extra data field for the requested block\n \"stakeversion\": n,             (numeric)         Stake Version of the block\n \"difficulty\": n.nnn,           (numeric)         The proof-of-work difficulty as a multiple of the minimum difficulty\n \"chainwork\": \"value\",          (string)          The total number of hashes expected to produce the chain up to the block in hex\n \"previousblockhash\": \"value\",  (string)          The hash of the previous block\n \"nextblockhash\": \"value\",      (string)          The hash of the next block (only if there is one)\n}                               \n",
 		"getcoinjoinsbyacct":        "getcoinjoinsbyacct\n\nGet coinjoin outputs by account.\n\nArguments:\nNone\n\nResult:\n{\n \"Accounts name\": Coinjoin outputs sum., (object) Return a map of account's name and its coinjoin outputs sum.\n ...\n}\n",
 		"getcurrentnet":             "getcurrentnet\n\nGet Decred network the wallet is connected to.\n\nArguments:\nNone\n\nResult:\nn (numeric) The network identifier\n",
-		"getinfo":                   "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in DCR/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
+		"getinfo":                   "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"balanceatoms\": n,     (numeric) The balance of all accounts in atoms\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"paytxfeeatoms\": n,    (numeric) The fee per kB used for authored transactions in atoms\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in DCR/KB\n \"relayfeeatoms\": n,    (numeric) The minimum relay fee for non-free transactions in atoms/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
 		"getmasterpubkey":           "getmasterpubkey (\"account\")\n\nRequests the master pubkey from the wallet.\n\nArguments:\n1. account (string, optional) The account to get the master pubkey for\n\nResult:\n\"value\" (string) The master pubkey for the wallet\n",
-		"getmultisigoutinfo":        "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n}                          \n",
+		"getmultisigoutinfo":        "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n \"amountatoms\": n,         (numeric)         Amount contained in atoms.\n}                          \n",
 		"getnewaddress":             "getnewaddress (\"account\" \"gappolicy\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account   (string, optional) Account name the new address will belong to (default=\"default\")\n2. gappolicy (string, optional) String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\"\n\nResult:\n\"value\" (string) The payment address\n",
 		"getpeerinfo":               "getpeerinfo\n\nReturns data on remote peers when in spv mode.\n\nArguments:\nNone\n\nResult:\n{\n \"id\": n,              (numeric) A unique node ID\n \"addr\": \"value\",      (string)  The remote IP address and port of the peer\n \"addrlocal\": \"value\", (string)  The local IP address and port of the peer\n \"services\": \"value\",  (string)  Services bitmask which represents the services supported by the peer\n \"version\": n,         (numeric) The protocol version of the peer\n \"subver\": \"value\",    (string)  The user agent of the peer\n \"startingheight\": n,  (numeric) The latest block height the peer knew about when the connection was established\n \"banscore\": n,        (numeric) The ban score\n}                      \n",
 		"getrawchangeaddress":       "getrawchangeaddress (\"account\")\n\nGenerates and returns a new internal payment address for use as a change address in raw transactions.\n\nArguments:\n1. account (string, optional) Account name the new internal address will belong to (default=\"default\")\n\nResult:\n\"value\" (string) The internal payment address\n",
 		"getreceivedbyaccount":      "getreceivedbyaccount \"account\" (minconf=1)\n\nReturns the total amount received by addresses of some account, including spent outputs.\n\nArguments:\n1. account (string, required)             Account name to query total received amount for\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in decred\n",
 		"getreceivedbyaddress":      "getreceivedbyaddress \"address\" (minconf=1)\n\nReturns the total amount received by a single address, including spent outputs.\n\nArguments:\n1. address (string, required)             Payment address which received outputs to include in total\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an output's value is included in the total\n\nResult:\nn.nnn (numeric) The total received amount valued in decred\n",
-		"getstakeinfo":              "getstakeinfo\n\nReturns statistics about staking from the wallet.\n\nArguments:\nNone\n\nResult:\n{\n \"blockheight\": n,          (numeric) Current block height for stake info.\n \"difficulty\": n.nnn,       (numeric) Current stake difficulty.\n \"totalsubsidy\": n.nnn,     (numeric) Total amount of coins earned by proof-of-stake voting\n \"ownmempooltix\": n,        (numeric) Number of tickets submitted by this wallet currently in mempool\n \"immature\": n,             (numeric) Number of tickets from this wallet that are in the blockchain but which are not yet mature\n \"unspent\": n,              (numeric) Number of unspent tickets\n \"voted\": n,                (numeric) Number of votes cast by this wallet\n \"revoked\": n,              (numeric) Number of missed tickets that were missed and then revoked\n \"unspentexpired\": n,       (numeric) Number of unspent tickets which are past expiry\n \"poolsize\": n,             (numeric) Number of live tickets in the ticket pool.\n \"allmempooltix\": n,        (numeric) Number of tickets currently in the mempool\n \"live\": n,                 (numeric) Number of mature, active tickets owned by this wallet\n \"proportionlive\": n.nnn,   (numeric) (Live / PoolSize)\n \"missed\": n,               (numeric) Number of missed tickets (failure to vote, not including expired)\n \"proportionmissed\": n.nnn, (numeric) (Missed / (Missed + Voted))\n \"expired\": n,              (numeric) Number of tickets that have expired\n}                           \n",
+		"getstakeearnings":          "getstakeearnings (period=\"month\")\n\nReturns the lifetime stake earnings of the wallet and the earnings of each period in which tickets were purchased or votes were cast.\nRewards are attributed to the period of the vote and fees to the period of the ticket purchase.\nOnly tickets the wallet has voting authority for are included, and ticket fees are only known for tickets funded entirely by the wallet.\n\nArguments:\n1. period (string, optional, default=\"month\") Period length to group earnings by (\"day\", \"week\", \"month\", or \"year\"); periods begin at midnight UTC and weeks begin on Monday\n\nResult:\n{\n \"tickets\": n,          (numeric)         Number of tickets purchased\n \"votes\": n,            (numeric)         Number of votes cast\n \"rewards\": n.nnn,      (numeric)         Vote rewards earned, as the stakebase sum of each vote\n \"rewardsatoms\": n,     (numeric)         Vote rewards earned in atoms\n \"ticketfees\": n.nnn,   (numeric)         Transaction fees paid to purchase tickets\n \"ticketfeesatoms\": n,  (numeric)         Transaction fees paid to purchase tickets in atoms\n \"poolfees\": n.nnn,     (numeric)         Fees paid to VSPs\n \"poolfeesatoms\": n,    (numeric)         Fees paid to VSPs in atoms\n \"net\": n.nnn,          (numeric)         Vote rewards less ticket and pool fees\n \"netatoms\": n,         (numeric)         Vote rewards less ticket and pool fees in atoms\n \"periods\": [{          (array of object) Stake earnings of each period, oldest first\n  \"start\": \"value\",     (string)          The UTC date the period begins (YYYY-MM-DD)\n  \"tickets\": n,         (numeric)         Number of tickets purchased\n  \"votes\": n,           (numeric)         Number of votes cast\n  \"rewards\": n.nnn,     (numeric)         Vote rewards earned, as the stakebase sum of each vote\n  \"rewardsatoms\": n,    (numeric)         Vote rewards earned in atoms\n  \"ticketfees\": n.nnn,  (numeric)         Transaction fees paid to purchase tickets\n  \"ticketfeesatoms\": n, (numeric)         Transaction fees paid to purchase tickets in atoms\n  \"poolfees\": n.nnn,    (numeric)         Fees paid to VSPs\n  \"poolfeesatoms\": n,   (numeric)         Fees paid to VSPs in atoms\n  \"net\": n.nnn,         (numeric)         Vote rewards less ticket and pool fees\n  \"netatoms\": n,        (numeric)         Vote rewards less ticket and pool fees in atoms\n },...],                                  \n}                       \n",
+		"getstakeinfo":              "getstakeinfo\n\nReturns statistics about staking from the wallet.\n\nArguments:\nNone\n\nResult:\n{\n \"blockheight\": n,          (numeric) Current block height for stake info.\n \"difficulty\": n.nnn,       (numeric) Current stake difficulty.\n \"difficultyatoms\": n,      (numeric) Current stake difficulty in atoms.\n \"totalsubsidy\": n.nnn,     (numeric) Total amount of coins earned by proof-of-stake voting\n \"totalsubsidyatoms\": n,    (numeric) Total amount earned by proof-of-stake voting in atoms\n \"ownmempooltix\": n,        (numeric) Number of tickets submitted by this wallet currently in mempool\n \"immature\": n,             (numeric) Number of tickets from this wallet that are in the blockchain but which are not yet mature\n \"unspent\": n,              (numeric) Number of unspent tickets\n \"voted\": n,                (numeric) Number of votes cast by this wallet\n \"revoked\": n,              (numeric) Number of missed tickets that were missed and then revoked\n \"unspentexpired\": n,       (numeric) Number of unspent tickets which are past expiry\n \"poolsize\": n,             (numeric) Number of live tickets in the ticket pool.\n \"allmempooltix\": n,        (numeric) Number of tickets currently in the mempool\n \"live\": n,                 (numeric) Number of mature, active tickets owned by this wallet\n \"proportionlive\": n.nnn,   (numeric) (Live / PoolSize)\n \"missed\": n,               (numeric) Number of missed tickets (failure to vote, not including expired)\n \"proportionmissed\": n.nnn, (numeric) (Missed / (Missed + Voted))\n \"expired\": n,              (numeric) Number of tickets that have expired\n}                           \n",
 		"gettickets":                "gettickets includeimmature\n\nReturning the hashes of the tickets currently owned by wallet.\n\nArguments:\n1. includeimmature (boolean, required) If true include immature tickets in the results.\n\nResult:\n{\n \"hashes\": [\"value\",...], (array of string) Hashes of the tickets owned by the wallet encoded as strings\n}                         \n",
-		"gettransaction":            "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in decred\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"vout\": n,                       (numeric)         The transaction output index\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n}                                  \n",
+		"gettransaction":            "gettransaction \"txid\" (includewatchonly=false)\n\nReturns a JSON object with details regarding a transaction relevant to this wallet.\n\nArguments:\n1. txid             (string, required)                 Hash of the transaction to query\n2. includewatchonly (boolean, optional, default=false) Also consider transactions involving watched addresses\n\nResult:\n{\n \"amount\": n.nnn,                  (numeric)         The total amount this transaction credits to the wallet, valued in decred\n \"amountatoms\": n,                 (numeric)         The total amount this transaction credits to the wallet in atoms\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value, or 0 if 'txid' is not a sent transaction\n \"feeatoms\": n,                    (numeric)         The fee in atoms\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"txid\": \"value\",                  (string)          The transaction hash\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"details\": [{                     (array of object) Additional details for each recorded wallet credit and debit\n  \"account\": \"value\",              (string)          DEPRECATED -- Unset\n  \"address\": \"value\",              (string)          The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input\n  \"amount\": n.nnn,                 (numeric)         The amount of a received output\n  \"amountatoms\": n,                (numeric)         The amount of a received output in atoms\n  \"category\": \"value\",             (string)          The kind of detail: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs\n  \"involveswatchonly\": true|false, (boolean)         Unset\n  \"fee\": n.nnn,                    (numeric)         The included fee for a sent transaction\n  \"feeatoms\": n,                   (numeric)         The included fee for a sent transaction in atoms\n  \"vout\": n,                       (numeric)         The transaction output index\n  \"label\": \"value\",                (string)          The label recorded for the output, if any\n },...],                                             \n \"hex\": \"value\",                   (string)          The transaction encoded as a hexadecimal string\n \"type\": \"value\",                  (string)          The type of transaction (regular, ticket, vote, or revocation)\n \"tree\": n,                        (numeric)         The block tree the transaction is included in (0 for regular, 1 for stake)\n \"ticketstatus\": \"value\",          (string)          Status of ticket (if transaction is a ticket)\n}                                  \n",
 		"gettxout":                  "gettxout \"txid\" vout tree (includemempool=true)\n\nReturns information about an unspent transaction output.\n\nArguments:\n1. txid           (string, required)                The hash of the transaction\n2. vout           (numeric, required)               The index of the output\n3. tree           (numeric, required)               The tree of the transaction\n4. includemempool (boolean, optional, default=true) Include the mempool when true\n\nResult:\n{\n \"bestblock\": \"value\",        (string)          The block hash that contains the transaction output\n \"confirmations\": n,          (numeric)         The number of confirmations\n \"value\": n.nnn,              (numeric)         The transaction amount in DCR\n \"scriptPubKey\": {            (object)          The public key script used to pay coins as a JSON object\n  \"asm\": \"value\",             (string)          Disassembly of the script\n  \"hex\": \"value\",             (string)          Hex-encoded bytes of the script\n  \"reqSigs\": n,               (numeric)         The number of required signatures\n  \"type\": \"value\",            (string)          The type of the script (e.g. 'pubkeyhash')\n  \"addresses\": [\"value\",...], (array of string) The Decred addresses associated with this script\n  \"commitamt\": n.nnn,         (numeric)         The ticket commitment value if the script is for a staking commitment\n  \"version\": n,               (numeric)         The script version\n },                                             \n \"coinbase\": true|false,      (boolean)         Whether or not the transaction is a coinbase\n}                             \n",
 		"getunconfirmedbalance":     "getunconfirmedbalance (\"account\")\n\nCalculates the unspent output value of all unmined transaction outputs for an account.\n\nArguments:\n1. account (string, optional) The account to query the unconfirmed balance for (default=\"default\")\n\nResult:\nn.nnn (numeric) Total amount of all unmined unspent outputs of the account valued in decred.\n",
+		"getutxostats":              "getutxostats (minconf=1)\n\nReports the fragmentation of the spendable unspent outputs of each account, to help decide when to consolidate them.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations of counted outputs\n\nResult:\n[{\n \"accountname\": \"value\",    (string)  The name of the account\n \"count\": n,                (numeric) Number of spendable unspent outputs\n \"dustcount\": n,            (numeric) Number of outputs below the dust limit at the wallet's relay fee\n \"total\": n.nnn,            (numeric) Total value of the outputs\n \"median\": n.nnn,           (numeric) Median output value\n \"consolidationfee\": n.nnn, (numeric) Fee at the wallet's relay fee of a single transaction consolidating every output of the account\n},...]\n",
 		"getvotechoices":            "getvotechoices (\"tickethash\")\n\nRetrieve the currently configured default vote choices for the latest supported stake agendas\n\nArguments:\n1. tickethash (string, optional) The hash of the ticket to return vote choices for. If the ticket has no choices set, the default vote choices are returned\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
 		"getwalletfee":              "getwalletfee\n\nGet currently set transaction fee for the wallet\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) Current tx fee (in DCR)\n",
 		"getcfilterv2":              "getcfilterv2 \"blockhash\"\n\nReturns the version 2 block filter for the given block along with the key required to query it for matches against committed scripts.\n\nArguments:\n1. blockhash (string, required) The block hash of the filter to retrieve\n\nResult:\n{\n \"blockhash\": \"value\", (string) The block hash for which the filter includes data\n \"filter\": \"value\",    (string) Hex-encoded bytes of the serialized filter\n \"key\": \"value\",       (string) The key required to query the filter for matches against committed scripts\n}                      \n",
 		"help":                      "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
 		"importcfiltersv2":          "importcfiltersv2 startheight [\"filter\",...]\n\nImports a list of v2 cfilters into the wallet. Does not perform validation on the filters\n\nArguments:\n1. startheight (numeric, required)         The starting block height for this list of cfilters\n2. filters     (array of string, required) The list of hex-encoded cfilters\n\nResult:\nNothing\n",
+		"importlegacykeys":          "importlegacykeys \"dump\" (rescan=true)\n\nImports each private key of a legacy keypool wallet dump to the 'imported' account.\nEach line of the dump begins with a WIF-encoded private key, optionally followed by the RFC3339 timestamp of the key's creation.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. dump   (string, required)                The text dump of the legacy wallet's keys\n2. rescan (boolean, optional, default=true) Rescan the blockchain from the earliest birthday of the newly imported keys\n\nResult:\n{\n \"imported\": [\"value\",...], (array of string) The P2PKH addresses of each newly imported key\n \"existing\": n,             (numeric)         The number of keys which were already imported\n \"rescanfrom\": n,           (numeric)         The block height the rescan begins at, or -1 if no keys were imported\n}                           \n",
 		"importprivkey":             "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
 		"importpubkey":              "importpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\n\nImports a compressed (33-byte) secp256k1 public key and the derived P2PKH address to the imported account.\n\nArguments:\n1. pubkey   (string, required)                The hex-encoded 33-byte compressed public key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
 		"importscript":              "importscript \"hex\" (rescan=true scanfrom)\n\nImport a redeem script.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
+		"importticket":              "importticket \"export\"\n\nImports the voting rights of a ticket exported by another wallet with exportticket.\nThe voting keys are imported to the imported account and the ticket is recorded for voting.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. export (string, required) JSON object returned by exportticket\n\nResult:\nNothing\n",
 		"importxpub":                "importxpub \"name\" \"xpub\"\n\nImport a HD extended public key as a new account.\n\nArguments:\n1. name (string, required) Name of new account\n2. xpub (string, required) Extended public key\n\nResult:\nNothing\n",
 		"listaccounts":              "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in decred, (object) JSON object with account names as keys and decred amounts as values\n ...\n}\n",
-		"listaddresstransactions":   "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listalltransactions":       "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listaddresstransactions":   "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"amountatoms\": n,                 (numeric)         The value of the transaction output in atoms\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"ticket\" for ticket purchase outputs, \"vote\" and \"revocation\" for mature vote and revocation outputs, \"immaturestake\" for immature vote and revocation outputs, or \"receive\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"feeatoms\": n,                    (numeric)         The fee for sent transactions in atoms\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"tree\": n,                        (numeric)         The block tree the transaction is included in (0 for regular, 1 for stake)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"maturityheight\": n,              (numeric)         The block height at which the output becomes spendable, or at which a ticket becomes live; omitted for unmined transactions and outputs without a maturity period\n},...]\n",
+		"listalltransactions":       "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"amountatoms\": n,                 (numeric)         The value of the transaction output in atoms\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"ticket\" for ticket purchase outputs, \"vote\" and \"revocation\" for mature vote and revocation outputs, \"immaturestake\" for immature vote and revocation outputs, or \"receive\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"feeatoms\": n,                    (numeric)         The fee for sent transactions in atoms\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"tree\": n,                        (numeric)         The block tree the transaction is included in (0 for regular, 1 for stake)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"maturityheight\": n,              (numeric)         The block height at which the output becomes spendable, or at which a ticket becomes live; omitted for unmined transactions and outputs without a maturity period\n},...]\n",
+		"listcontacts":              "listcontacts\n\nReturns a JSON array of objects describing each recorded address book contact.\n\nArguments:\nNone\n\nResult:\n[{\n \"name\": \"value\",    (string) Name of the contact\n \"address\": \"value\", (string) Payment address of the contact\n},...]\n",
 		"listlockunspent":           "listlockunspent (\"account\")\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\n1. account (string, optional) If set, only returns outpoints from this account that are marked as locked\n\nResult:\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n",
-		"listreceivedbyaccount":     "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in decred\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
-		"listreceivedbyaddress":     "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in decred\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
-		"listsinceblock":            "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
-		"listtransactions":          "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listunspent":               "listunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n4. account   (string, optional)                   If set, only return unspent outputs from this account\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  The redeemScript if scriptPubKey is P2SH\n \"amount\": n.nnn,         (numeric) The amount of the output valued in decred\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
+		"listpaymenttemplates":      "listpaymenttemplates\n\nReturns a JSON array of objects describing each recorded payment template.\n\nArguments:\nNone\n\nResult:\n[{\n \"name\": \"value\",     (string)          Name of the payment template\n \"account\": \"value\",  (string)          Account paid from\n \"outputs\": [{        (array of object) Outputs paid by the template\n  \"address\": \"value\", (string)          The address paid\n  \"amount\": n.nnn,    (numeric)         The amount paid in DCR\n  \"amountatoms\": n,   (numeric)         The amount paid in atoms\n },...],                                \n \"minconf\": n,        (numeric)         Minimum number of confirmations of spent outputs\n \"interval\": n,       (numeric)         Number of blocks between automatic payments, or 0 when only paid on demand\n \"nextheight\": n,     (numeric)         Block height of the next automatic payment\n},...]\n",
+		"listreceivedbyaccount":     "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in decred\n \"amountatoms\": n,   (numeric) Total amount received by payment addresses of the account in atoms\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
+		"listreceivedbyaddress":     "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in decred\n \"amountatoms\": n,                (numeric)         Total amount received by the payment address in atoms\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
+		"listsinceblock":            "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n  \"amountatoms\": n,                 (numeric)         The value of the transaction output in atoms\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"ticket\" for ticket purchase outputs, \"vote\" and \"revocation\" for mature vote and revocation outputs, \"immaturestake\" for immature vote and revocation outputs, or \"receive\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"feeatoms\": n,                    (numeric)         The fee for sent transactions in atoms\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"tree\": n,                        (numeric)         The block tree the transaction is included in (0 for regular, 1 for stake)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n  \"maturityheight\": n,              (numeric)         The block height at which the output becomes spendable, or at which a ticket becomes live; omitted for unmined transactions and outputs without a maturity period\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
+		"listtransactions":          "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"amountatoms\": n,                 (numeric)         The value of the transaction output in atoms\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, \"ticket\" for ticket purchase outputs, \"vote\" and \"revocation\" for mature vote and revocation outputs, \"immaturestake\" for immature vote and revocation outputs, or \"receive\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"feeatoms\": n,                    (numeric)         The fee for sent transactions in atoms\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"tree\": n,                        (numeric)         The block tree the transaction is included in (0 for regular, 1 for stake)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"maturityheight\": n,              (numeric)         The block height at which the output becomes spendable, or at which a ticket becomes live; omitted for unmined transactions and outputs without a maturity period\n},...]\n",
+		"listunspent":               "listunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n4. account   (string, optional)                   If set, only return unspent outputs from this account, or from a parent account and all of its sub-accounts when set to \"parent/*\"\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  The redeemScript if scriptPubKey is P2SH\n \"amount\": n.nnn,         (numeric) The amount of the output valued in decred\n \"amountatoms\": n,        (numeric) The amount of the output in atoms\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
+		"listvsptickets":            "listvsptickets\n\nReturns a JSON array of objects describing the tickets associated with each VSP, with counts of tickets by status.\nTickets purchased without a VSP are not included.\n\nArguments:\nNone\n\nResult:\n[{\n \"host\": \"value\",          (string)          Host of the VSP\n \"immature\": n,            (numeric)         Number of unmined and immature tickets\n \"live\": n,                (numeric)         Number of live tickets\n \"voted\": n,               (numeric)         Number of voted tickets\n \"missed\": n,              (numeric)         Number of missed tickets\n \"expired\": n,             (numeric)         Number of expired tickets\n \"unspent\": n,             (numeric)         Number of mature unspent tickets which are not known to be live or missed (SPV mode only)\n \"tickets\": [\"value\",...], (array of string) Hashes of all tickets associated with the VSP\n},...]\n",
+		"listwalletevents":          "listwalletevents (count=100 from=0)\n\nReturns recorded wallet events, oldest first.\nEvents record network backend connections and disconnections, rescans, created votes, purchased tickets, handled reorganizations, and chain tip divergence alerts. Only the most recent 10000 events are retained.\n\nArguments:\n1. count (numeric, optional, default=100) Maximum number of events to return\n2. from  (numeric, optional, default=0)   Number of most recent events to skip\n\nResult:\n[{\n \"seq\": n,          (numeric) The sequence number of the event\n \"time\": n,         (numeric) The Unix time at which the event was recorded\n \"kind\": \"value\",   (string)  The kind of event (connected, disconnected, rescanstarted, rescanfinished, votecreated, ticketpurchased, reorg, or tipdivergence)\n \"height\": n,       (numeric) The block height the event relates to: the main chain tip height, rescan start or end height, voted block height, or reorganization fork height\n \"hash\": \"value\",   (string)  The hash of the rescan start block, vote, ticket, or new main chain tip block, if any\n \"detail\": \"value\", (string)  Additional details of the event\n},...]\n",
 		"lockaccount":               "lockaccount \"account\"\n\nLock an individually-encrypted account\n\nArguments:\n1. account (string, required) Account to lock\n\nResult:\nNothing\n",
 		"lockunspent":               "lockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
 		"mixaccount":                "mixaccount\n\nMix all outputs of an account.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"mixoutput":                 "mixoutput \"outpoint\"\n\nMix a specific output.\n\nArguments:\n1. outpoint (string, required) Outpoint (in form \"txhash:index\") to mix\n\nResult:\nNothing\n",
 		"processunmanagedticket":    "processunmanagedticket \"tickethash\"\n\nProcesses tickets for vsp client based on ticket hash.\n\nArguments:\n1. tickethash (string, required) The ticket hash of ticket to be processed by the vsp client.\n\nResult:\nNothing\n",
-		"purchaseticket":            "purchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx)\n\nPurchase ticket using available funds.\n\nArguments:\n1. fromaccount (string, required)             The account to use for purchase (default=\"default\")\n2. spendlimit  (numeric, required)            Limit on the amount to spend on ticket\n3. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n4. numtickets  (numeric, optional, default=1) The number of tickets to purchase\n5. expiry      (numeric, optional)            Height at which the purchase tickets expire\n6. comment     (string, optional)             Unused\n7. dontsigntx  (boolean, optional)            Return unsigned split and ticket transactions instead of signing and publishing\n\nResult:\n\"value\" (string) Hash of the resulting ticket\n",
+		"plansweep":                 "plansweep [\"accounts\",...] \"address\" (minconf=1 feerate)\n\nPlans the transactions to sweep all spendable outputs of one or more accounts to an address, using as few transactions as the maximum transaction size allows.\nThe plan is deterministic and is returned for confirmation without being signed or published; use executesweepplan to execute it.\n\nArguments:\n1. accounts (array of string, required)    The accounts to sweep\n2. address  (string, required)             The destination address\n3. minconf  (numeric, optional, default=1) Minimum number of block confirmations of swept outputs\n4. feerate  (numeric, optional)            Fee rate in DCR/kB (default is the wallet's relay fee)\n\nResult:\n{\n \"planid\": \"value\",    (string)          ID of the plan, committing to every planned transaction\n \"transactions\": [{    (array of object) The planned transactions\n  \"txhash\": \"value\",   (string)          The transaction hash\n  \"hex\": \"value\",      (string)          The unsigned transaction\n  \"inputs\": n,         (numeric)         Number of transaction inputs\n  \"totalinput\": n.nnn, (numeric)         Total value of the transaction inputs\n  \"fee\": n.nnn,        (numeric)         Transaction fee\n  \"amount\": n.nnn,     (numeric)         Value paid to the destination address\n },...],                                 \n \"totalinput\": n.nnn,  (numeric)         Total value of the swept outputs\n \"totalfee\": n.nnn,    (numeric)         Total fee of the planned transactions\n \"uneconomic\": n,      (numeric)         Number of outputs which are not swept because their value does not pay the fee to spend them\n}                      \n",
+		"purchaseticket":            "purchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx expiryblocks verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\n\nPurchase ticket using available funds.\n\nArguments:\n1. fromaccount  (string, required)                 The account to use for purchase (default=\"default\")\n2. spendlimit   (numeric, required)                Limit on the amount to spend on ticket\n3. minconf      (numeric, optional, default=1)     Minimum number of block confirmations required\n4. numtickets   (numeric, optional, default=1)     The number of tickets to purchase\n5. expiry       (numeric, optional)                Height at which the purchase tickets expire\n6. comment      (string, optional)                 Unused\n7. dontsigntx   (boolean, optional)                Return unsigned split and ticket transactions instead of signing and publishing\n8. expiryblocks (numeric, optional)                Number of blocks in which the purchase may be mined before it expires, limited to the end of the current ticket price window (cannot be used with expiry)\n9. verbose      (boolean, optional, default=false) Return the fees and achieved fee rates of the published split transaction and tickets instead of the ticket hashes (ignored with dontsigntx)\n10. feerate     (object, optional)                 Fee rate paid by the split transaction and tickets (default is the wallet's relay fee)\n{\n \"rate\": n.nnn,   (numeric) Fee rate in the selected unit, which must be a whole number of atoms/kB\n \"unit\": \"value\", (string)  Fee rate unit, either \"atoms/kB\" or \"atoms/B\"\n}                 \n\nResult (verbose=false):\n\"value\" (string) Hash of the resulting ticket\n\nResult (verbose=true):\n{\n \"splittx\": {                   (object)          The split transaction funding the tickets, if one was published\n  \"txhash\": \"value\",            (string)          The transaction hash\n  \"size\": n,                    (numeric)         Serialized size of the transaction in bytes\n  \"fee\": n.nnn,                 (numeric)         Fee paid by the transaction\n  \"feerate\": n,                 (numeric)         Achieved fee rate in atoms/kB\n  \"feerateatomsperbyte\": n.nnn, (numeric)         Achieved fee rate in atoms/B\n },                                               \n \"tickets\": [{                  (array of object) The published tickets\n  \"txhash\": \"value\",            (string)          The transaction hash\n  \"size\": n,                    (numeric)         Serialized size of the transaction in bytes\n  \"fee\": n.nnn,                 (numeric)         Fee paid by the transaction\n  \"feerate\": n,                 (numeric)         Achieved fee rate in atoms/kB\n  \"feerateatomsperbyte\": n.nnn, (numeric)         Achieved fee rate in atoms/B\n },...],                                          \n}                               \n",
 		"redeemmultisigout":         "redeemmultisigout \"hash\" index tree (\"address\")\n\nTakes the input and constructs a P2PKH paying to the specified address.\n\nArguments:\n1. hash    (string, required)  Hash of the input transaction\n2. index   (numeric, required) Idx of the input transaction\n3. tree    (numeric, required) Tree the transaction is on.\n4. address (string, optional)  Address to pay to.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
 		"redeemmultisigouts":        "redeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\n\nTakes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\n\nArguments:\n1. fromscraddress (string, required)  Input script hash address.\n2. toaddress      (string, optional)  Address to look for (if not internal addresses).\n3. number         (numeric, optional) Number of outpoints found.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
+		"reloadconfig":              "reloadconfig\n\nReloads the configuration file and command line options without restarting.\nChanges to the debuglevel and txfee options, and to the ticketbuyer balancetomaintainabsolute, limit, skipfinalblocks, onlyfinalblocks, maxprice, minreturn, minconf, and nochangeinputs options, are applied to the running wallet.\nChanges to any other option require a restart.\nOn Unix systems, the configuration is also reloaded when the process receives SIGHUP.\n\nArguments:\nNone\n\nResult:\n{\n \"applied\": [\"value\",...],         (array of string) Changed options which were applied\n \"restartrequired\": [\"value\",...], (array of string) Changed options which only take effect after a restart\n \"failed\": [{                      (array of object) Changed options which could not be applied\n  \"option\": \"value\",               (string)          The option name\n  \"error\": \"value\",                (string)          The reason the option could not be applied\n },...],                                             \n}                                  \n",
 		"renameaccount":             "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
-		"rescanwallet":              "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
-		"sendfrom":                  "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (numeric, required)            Amount to send to the payment address valued in decred\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"rescanwallet":              "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error. Websocket clients are sent rescanprogress notifications as the rescan proceeds and a rescanfinished notification when it completes\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
+		"resendtransaction":         "resendtransaction \"txhash\"\n\nImmediately republishes an unmined wallet transaction to the network rather than waiting for the periodic resend of all unmined transactions.\nThe transaction is first checked for mempool acceptance: it must not be expired, double spend another transaction, or pay high fees.\n\nArguments:\n1. txhash (string, required) Hash of the unmined transaction to resend\n\nResult:\n{\n \"txhash\": \"value\",     (string)  Hash of the resent transaction\n \"relayed\": true|false, (boolean) Whether the transaction was accepted for relay by the network backend\n \"error\": \"value\",      (string)  The reason the transaction was rejected for relay, if it was not relayed\n}                       \n",
+		"selfcheck":                 "selfcheck\n\nRe-derives the wallet's unspent outputs and mined balance by scanning every main chain block, and reports differences from the wallet's transaction store.\nThe scan is performed in memory and the wallet is not modified.\n\nArguments:\nNone\n\nResult:\n{\n \"scannedthrough\": n,       (numeric)         Height of the main chain tip block the check scanned through\n \"expectedbalance\": n.nnn,  (numeric)         Mined balance re-derived from the blockchain, excluding ticket purchases\n \"expectedbalanceatoms\": n, (numeric)         Re-derived mined balance in atoms\n \"actualbalance\": n.nnn,    (numeric)         Mined balance recorded by the transaction store\n \"actualbalanceatoms\": n,   (numeric)         Recorded mined balance in atoms\n \"expectedunspent\": n,      (numeric)         Number of unspent outputs re-derived from the blockchain\n \"actualunspent\": n,        (numeric)         Number of mined unspent outputs recorded by the transaction store\n \"discrepancies\": [{        (array of object) Differences between the re-derived state and the transaction store\n  \"bucket\": \"value\",        (string)          Transaction store bucket the discrepancy was found in (unspent or balance)\n  \"outpoint\": \"value\",      (string)          The differing output, omitted for balance discrepancies\n  \"expected\": n.nnn,        (numeric)         Amount re-derived from the blockchain\n  \"expectedatoms\": n,       (numeric)         Re-derived amount in atoms\n  \"actual\": n.nnn,          (numeric)         Amount recorded by the transaction store\n  \"actualatoms\": n,         (numeric)         Recorded amount in atoms\n  \"reason\": \"value\",        (string)          Description of the discrepancy\n },...],                                      \n}                           \n",
+		"sendfrom":                  "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\" verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)                 Account to pick unspent outputs from\n2. toaddress   (string, required)                 Address or address book contact name to pay\n3. amount      (numeric, required)                Amount to send to the payment address valued in decred\n4. minconf     (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)                 Unused\n6. commentto   (string, optional)                 Unused\n7. verbose     (boolean, optional, default=false) Return the fee and achieved fee rate of the sent transaction instead of its hash\n8. feerate     (object, optional)                 Fee rate paid by the transaction (default is the wallet's relay fee)\n{\n \"rate\": n.nnn,   (numeric) Fee rate in the selected unit, which must be a whole number of atoms/kB\n \"unit\": \"value\", (string)  Fee rate unit, either \"atoms/kB\" or \"atoms/B\"\n}                 \n\nResult (verbose=false):\n\"value\" (string) The transaction hash of the sent transaction\n\nResult (verbose=true):\n{\n \"txhash\": \"value\",            (string)  The transaction hash\n \"size\": n,                    (numeric) Serialized size of the transaction in bytes\n \"fee\": n.nnn,                 (numeric) Fee paid by the transaction\n \"feerate\": n,                 (numeric) Achieved fee rate in atoms/kB\n \"feerateatomsperbyte\": n.nnn, (numeric) Achieved fee rate in atoms/B\n}                              \n",
 		"sendfromtreasury":          "sendfromtreasury \"key\" amounts\n\nSend from treasury balance to multiple recipients.\n\nArguments:\n1. key     (string, required) Politeia public key\n2. amounts (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendmany":                  "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendmany":                  "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" {\"address\":\"label\",...} split=false verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address or address book contact name to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses or address book contact names as keys and output amounts valued in decred to send to each address\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment (string, optional)             Unused\n5. labels  (object, optional)             Labels recorded for the outputs paying to some addresses\n{\n \"Address to label\": Label of the output paying to the address, (object) JSON object using payment addresses as keys and output labels as values\n ...\n}\n6. split   (boolean, optional, default=false) Divide the outputs across multiple transactions when they can not be paid by a single transaction without exceeding the maximum transaction size\n7. verbose (boolean, optional, default=false) Return the fees and achieved fee rates of the sent transactions instead of their hashes\n8. feerate (object, optional)                 Fee rate paid by the transactions (default is the wallet's relay fee)\n{\n \"rate\": n.nnn,   (numeric) Fee rate in the selected unit, which must be a whole number of atoms/kB\n \"unit\": \"value\", (string)  Fee rate unit, either \"atoms/kB\" or \"atoms/B\"\n}                 \n\nResult (split=false verbose=false):\n\"value\" (string) The transaction hash of the sent transaction\n\nResult (split=true verbose=false):\n[\"value\",...] (array of string) The transaction hashes of all sent transactions\n\nResult (split=false verbose=true):\n{\n \"txhash\": \"value\",            (string)  The transaction hash\n \"size\": n,                    (numeric) Serialized size of the transaction in bytes\n \"fee\": n.nnn,                 (numeric) Fee paid by the transaction\n \"feerate\": n,                 (numeric) Achieved fee rate in atoms/kB\n \"feerateatomsperbyte\": n.nnn, (numeric) Achieved fee rate in atoms/B\n}                              \n\nResult (split=true verbose=true):\n[{\n \"txhash\": \"value\",            (string)  The transaction hash\n \"size\": n,                    (numeric) Serialized size of the transaction in bytes\n \"fee\": n.nnn,                 (numeric) Fee paid by the transaction\n \"feerate\": n,                 (numeric) Achieved fee rate in atoms/kB\n \"feerateatomsperbyte\": n.nnn, (numeric) Achieved fee rate in atoms/B\n},...]\n",
 		"sendrawtransaction":        "sendrawtransaction \"hextx\" (allowhighfees=false)\n\nSubmits the serialized, hex-encoded transaction to the local peer and relays it to the network.\n\nArguments:\n1. hextx         (string, required)                 Serialized, hex-encoded signed transaction\n2. allowhighfees (boolean, optional, default=false) Whether or not to allow insanely high fees\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtoaddress":             "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (numeric, required) Amount to send to the payment address valued in decred\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtoaddress":             "sendtoaddress \"address\" amount (\"comment\" \"commentto\" verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)                 Address or address book contact name to pay\n2. amount    (numeric, required)                Amount to send to the payment address valued in decred\n3. comment   (string, optional)                 Unused\n4. commentto (string, optional)                 Unused\n5. verbose   (boolean, optional, default=false) Return the fee and achieved fee rate of the sent transaction instead of its hash\n6. feerate   (object, optional)                 Fee rate paid by the transaction (default is the wallet's relay fee)\n{\n \"rate\": n.nnn,   (numeric) Fee rate in the selected unit, which must be a whole number of atoms/kB\n \"unit\": \"value\", (string)  Fee rate unit, either \"atoms/kB\" or \"atoms/B\"\n}                 \n\nResult (verbose=false):\n\"value\" (string) The transaction hash of the sent transaction\n\nResult (verbose=true):\n{\n \"txhash\": \"value\",            (string)  The transaction hash\n \"size\": n,                    (numeric) Serialized size of the transaction in bytes\n \"fee\": n.nnn,                 (numeric) Fee paid by the transaction\n \"feerate\": n,                 (numeric) Achieved fee rate in atoms/kB\n \"feerateatomsperbyte\": n.nnn, (numeric) Achieved fee rate in atoms/B\n}                              \n",
 		"sendtomultisig":            "sendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a multisig address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Unused\n2. amount      (numeric, required)            Amount to send to the payment address valued in decred\n3. pubkeys     (array of string, required)    Pubkey to send to.\n4. nrequired   (numeric, optional, default=1) The number of signatures required to redeem outputs paid to this address\n5. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n6. comment     (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
 		"sendtotreasury":            "sendtotreasury amount\n\nSend decred to treasury\n\nArguments:\n1. amount (numeric, required) Amount to send to treasury\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
 		"setaccountpassphrase":      "setaccountpassphrase \"account\" \"passphrase\"\n\nIndividually encrypt or change per-account passphrase\n\nArguments:\n1. account    (string, required) Account to modify\n2. passphrase (string, required) New passphrase to use.\nIf this is the empty string, the account passphrase is removed and the account becomes encrypted by the global wallet passhprase.\n\nResult:\nNothing\n",
+		"setaccountspendpolicy":     "setaccountspendpolicy \"account\" \"policy\"\n\nSet the spend policy of an account.\nOutputs of hot accounts may be spent by any request or automated wallet process.\nOutputs of warm accounts may only be spent by manual RPC requests.\nOutputs of cold accounts are watch-only and may not be spent or signed for.\n\nArguments:\n1. account (string, required) Account to modify\n2. policy  (string, required) The spend policy (hot, warm, or cold)\n\nResult:\nNothing\n",
 		"setdisapprovepercent":      "setdisapprovepercent percent\n\nSets the wallet's block disapprove percent per vote. The wallet will randomly disapprove blocks with this percent of votes. Only used for testing purposes and will fail on mainnet.\n\nArguments:\n1. percent (numeric, required) The percent of votes to disapprove blocks. i.e. 100 means that all votes disapprove the block they are called on. Must be between zero and one hundred.\n\nResult:\nNothing\n",
 		"settreasurypolicy":         "settreasurypolicy \"key\" \"policy\" (\"ticket\")\n\nSet a voting policy for treasury spends by a particular key\n\nArguments:\n1. key    (string, required) Treasury key to set policy for\n2. policy (string, required) Voting policy for a treasury key (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket treasury key policy\n\nResult:\nNothing\n",
 		"settspendpolicy":           "settspendpolicy \"hash\" \"policy\" (\"ticket\")\n\nSet a voting policy for a treasury spend transaction\n\nArguments:\n1. hash   (string, required) Hash of treasury spend transaction to set policy for\n2. policy (string, required) Voting policy for a tspend transaction (invalid/abstain, yes, or no)\n3. ticket (string, optional) Ticket hash to set a per-ticket tspend approval policy\n\nResult:\nNothing\n",
-		"settxfee":                  "settxfee amount\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee per kB of the serialized tx size valued in decred\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"settxfee":                  "settxfee amount\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.  Fees below the minimum relay fee of the chain server are rejected.\n\nArguments:\n1. amount (numeric, required) The new fee per kB of the serialized tx size valued in decred\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
 		"setvotechoice":             "setvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\n\nSets choices for defined agendas in the latest stake version supported by this software\n\nArguments:\n1. agendaid   (string, required) The ID for the agenda to modify\n2. choiceid   (string, required) The ID for the choice to choose\n3. tickethash (string, optional) The hash of the ticket to set choices for\n\nResult:\nNothing\n",
+		"setvotingaccount":          "setvotingaccount \"account\" (voting=true)\n\nAdds an account to or removes an account from the voting domain.\nVoting account keys are encrypted by the voting domain key and remain unlocked when the wallet is locked.\nAdding an account requires both the voting domain and the account keys to be unlocked.\n\nArguments:\n1. account (string, required)                Account to modify\n2. voting  (boolean, optional, default=true) Add the account to the voting domain, or remove it when false\n\nResult:\nNothing\n",
+		"setvotingpassphrase":       "setvotingpassphrase \"passphrase\"\n\nSets the voting passphrase protecting the voting domain key, creating the key if no voting passphrase is set.\nChanging an existing voting passphrase requires the voting domain to be unlocked.\nThe voting domain is unlocked after the passphrase is set.\n\nArguments:\n1. passphrase (string, required) New voting passphrase\n\nResult:\nNothing\n",
 		"signmessage":               "signmessage \"address\" \"message\"\n\nSigns a message using the private key of a payment address.\n\nArguments:\n1. address (string, required) Payment address of private key used to sign the message with\n2. message (string, required) Message to sign\n\nResult:\n\"value\" (string) The signed message encoded as a base64 string\n",
 		"signrawtransaction":        "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
 		"signrawtransactions":       "signrawtransactions [\"rawtx\",...] (send=true)\n\nSigns transaction inputs using private keys from this wallet and request for a list of transactions.\n\n\nArguments:\n1. rawtxs (array of string, required)       A list of transactions to sign (and optionally send).\n2. send   (boolean, optional, default=true) Set true to send the transactions after signing.\n\nResult:\n{\n \"results\": [{             (array of object) Returned values from the signrawtransactions command.\n  \"signingresult\": {       (object)          Success or failure of signing.\n   \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n   \"complete\": true|false, (boolean)         Whether all input signatures have been created\n   \"errors\": [{            (array of object) Script verification errors (if exists)\n    \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n    \"vout\": n,             (numeric)         The output index of the referenced previous output\n    \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n    \"sequence\": n,         (numeric)         Script sequence number\n    \"error\": \"value\",      (string)          Verification or signing error related to the input\n   },...],                                   \n  },                                         \n  \"sent\": true|false,      (boolean)         Tells if the transaction was sent.\n  \"txhash\": \"value\",       (string)          The hash of the signed tx.\n },...],                                     \n}                          \n",
+		"simnetadvance":             "simnetadvance numblocks\n\nMines blocks with the CPU miner of the consensus server, advancing the chain height and block time, and waits for the wallet to connect them.\nOnly available on simnet with dcrd RPC synchronization, and requires dcrd to be configured with a mining address.\n\nArguments:\n1. numblocks (numeric, required) Number of blocks to mine\n\nResult:\n[\"value\",...] (array of string) Hashes of the mined blocks\n",
+		"simnetfundaccount":         "simnetfundaccount \"account\" amount (fromaccount=\"default\")\n\nSends funds from another account to a new address of an account and mines a block to confirm the payment.\nOnly available on simnet with dcrd RPC synchronization, and requires dcrd to be configured with a mining address.\n\nArguments:\n1. account     (string, required)                    Account to fund\n2. amount      (numeric, required)                   Amount to send\n3. fromaccount (string, optional, default=\"default\") Account to pick unspent outputs from\n\nResult:\n{\n \"txhash\": \"value\",    (string) Hash of the funding transaction\n \"blockhash\": \"value\", (string) Hash of the block mined to confirm the funding transaction\n}                      \n",
+		"simnetreorg":               "simnetreorg depth\n\nReorganizes the most recent blocks of the main chain by invalidating the block depth blocks below the next block with the consensus server and mining a longer replacement chain.\nThe invalidated blocks are not reconsidered.\nOnly available on simnet with dcrd RPC synchronization, and requires dcrd to be configured with a mining address.\n\nArguments:\n1. depth (numeric, required) Number of main chain blocks to reorganize\n\nResult:\n{\n \"oldtip\": \"value\", (string)  Hash of the main chain tip before the reorganization\n \"newtip\": \"value\", (string)  Hash of the main chain tip after the reorganization\n \"height\": n,       (numeric) Height of the main chain tip after the reorganization\n}                   \n",
 		"spendoutputs":              "spendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\n\nCreate, sign, and publish a transaction spending the specified wallet outputs, and paying an array of address/amount pairs.\nOutputs must belong to the specified account, and change (if needed) is returned to an internal address of the same account.\n\nArguments:\n1. account           (string, required)          Account of specified previous outpoints, and account used to return change\n2. previousoutpoints (array of string, required) Array of outpoints in string encoding (\"hash:index\")\n3. outputs           (array of object, required) Array of JSON objects, each specifying an address string and amount\n[{\n \"address\": \"value\", (string)  Address to pay\n \"amount\": n.nnn,    (numeric) Amount to pay the address\n},...]\n\nResult:\n\"value\" (string) The published transaction hash\n",
-		"sweepaccount":              "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",     (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn, (numeric) The total transaction input amount.\n \"totaloutputamount\": n.nnn,         (numeric) The total transaction output amount.\n \"estimatedsignedsize\": n,           (numeric) The estimated size of the transaction when signed.\n}                                    \n",
+		"sweepaccount":              "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",      (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn,  (numeric) The total transaction input amount.\n \"totalpreviousoutputamountatoms\": n, (numeric) The total transaction input amount in atoms.\n \"totaloutputamount\": n.nnn,          (numeric) The total transaction output amount.\n \"totaloutputamountatoms\": n,         (numeric) The total transaction output amount in atoms.\n \"estimatedsignedsize\": n,            (numeric) The estimated size of the transaction when signed.\n}                                     \n",
 		"syncstatus":                "syncstatus\n\nReturns information about this wallet's synchronization to the network.\n\nArguments:\nNone\n\nResult:\n{\n \"synced\": true|false,               (boolean) Whether or not the wallet is fully caught up to the network.\n \"initialblockdownload\": true|false, (boolean) Best guess of whether this wallet is in the initial block download mode used to catch up the blockchain when it is far behind.\n \"headersfetchprogress\": n.nnn,      (numeric) Estimated progress of the headers fetching stage of the current sync process.\n}                                    \n",
-		"ticketinfo":                "ticketinfo (startheight=0)\n\nReturns details of each wallet ticket transaction\n\nArguments:\n1. startheight (numeric, optional, default=0) Specify the starting block height to scan from\n\nResult:\n[{\n \"hash\": \"value\",               (string)          Transaction hash of the ticket\n \"cost\": n.nnn,                 (numeric)         Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase\n \"votingaddress\": \"value\",      (string)          Address of 0th output, which describes the requirements to spend the ticket\n \"status\": \"value\",             (string)          Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)\n \"blockhash\": \"value\",          (string)          Hash of block ticket is mined in\n \"blockheight\": n,              (numeric)         Height of block ticket is mined in\n \"vote\": \"value\",               (string)          Transaction hash of vote which spends the ticket\n \"revocation\": \"value\",         (string)          Transaction hash of revocation which spends the ticket\n \"choices\": [{                  (array of object) Vote preferences set for the ticket\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n \"vsphost\": \"value\",            (string)          VSP Host associated with the ticket (if any)\n},...]\n",
+		"ticketinfo":                "ticketinfo (startheight=0)\n\nReturns details of each wallet ticket transaction\n\nArguments:\n1. startheight (numeric, optional, default=0) Specify the starting block height to scan from\n\nResult:\n[{\n \"hash\": \"value\",               (string)          Transaction hash of the ticket\n \"cost\": n.nnn,                 (numeric)         Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase\n \"costatoms\": n,                (numeric)         Amount paid to purchase the ticket in atoms\n \"votingaddress\": \"value\",      (string)          Address of 0th output, which describes the requirements to spend the ticket\n \"status\": \"value\",             (string)          Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)\n \"blockhash\": \"value\",          (string)          Hash of block ticket is mined in\n \"blockheight\": n,              (numeric)         Height of block ticket is mined in\n \"vote\": \"value\",               (string)          Transaction hash of vote which spends the ticket\n \"revocation\": \"value\",         (string)          Transaction hash of revocation which spends the ticket\n \"choices\": [{                  (array of object) Vote preferences set for the ticket\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n \"vsphost\": \"value\",            (string)          VSP Host associated with the ticket (if any)\n \"vspfeehash\": \"value\",         (string)          Transaction hash of the fee paid to the VSP (if any)\n \"vspfee\": n.nnn,               (numeric)         Amount paid to the VSP\n \"vspfeeatoms\": n,              (numeric)         Amount paid to the VSP in atoms\n \"vspfeestatus\": \"value\",       (string)          Status of the VSP fee payment (started, paid, errored, confirmed)\n \"vspfeeconfirmations\": n,      (numeric)         Number of block confirmations of the VSP fee transaction\n},...]\n",
 		"treasurypolicy":            "treasurypolicy (\"key\" \"ticket\")\n\nReturn voting policies for treasury spend transactions by key\n\nArguments:\n1. key    (string, optional) Return the policy for a particular key\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no key provided):\n[{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n},...]\n\nResult (key specified):\n{\n \"key\": \"value\",    (string) Treasury key associated with a policy\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket treasury key approval policy\n}                   \n",
 		"tspendpolicy":              "tspendpolicy (\"hash\" \"ticket\")\n\nReturn voting policies for treasury spend transactions\n\nArguments:\n1. hash   (string, optional) Return the policy for a particular tspend hash\n2. ticket (string, optional) Return policies used by a specific ticket hash\n\nResult (no tspend hash provided):\n[{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n},...]\n\nResult (tspend hash specified):\n{\n \"hash\": \"value\",   (string) Treasury spend transaction hash\n \"policy\": \"value\", (string) Voting policy description (abstain, yes, or no)\n \"ticket\": \"value\", (string) Ticket hash of a per-ticket tspend approval policy\n}                   \n",
 		"unlockaccount":             "unlockaccount \"account\" \"passphrase\"\n\nUnlock an individually-encrypted account\n\nArguments:\n1. account    (string, required) Account to unlock\n2. passphrase (string, required) Account passphrase\n\nResult:\nNothing\n",
+		"updatecontact":             "updatecontact \"name\" \"address\"\n\nChanges the payment address of a recorded address book contact.\n\nArguments:\n1. name    (string, required) Name of the contact\n2. address (string, required) New payment address of the contact, which must be valid for the wallet's network\n\nResult:\nNothing\n",
 		"validateaddress":           "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): pubkey, account, addresses, hex, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkeyaddr\": \"value\",      (string)          The pubkey for this payment address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n \"accountn\": n,              (numeric)         The account number. This number plus 2 ^ 31 is the HD account the address was derived from. Not available for imported accounts. Only present for BIP0044 derived addresses.\n \"branch\": n,                (numeric)         The HD branch. Only present for BIP0044 derived addresses.\n \"index\": n,                 (numeric)         The HD index. Only present for BIP0044 derived addresses.\n}                            \n",
 		"validatepredcp0005cf":      "validatepredcp0005cf\n\nValidate whether all stored cfilters from before DCP0005 activation are correct according to the expected hardcoded hash\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the cfilters are valid\n",
+		"verifyexternaladdress":     "verifyexternaladdress \"address\"\n\nAsks the configured external signing device to derive and display a wallet address, returning whether the device confirmed the address.\nThe address is first rederived from the account extended public key to detect tampered receive addresses.\n\nArguments:\n1. address (string, required) The wallet address to verify\n\nResult:\n{\n \"address\": \"value\",      (string)  The verified address\n \"account\": n,            (numeric) The account number of the address\n \"branch\": n,             (numeric) The BIP0044 branch of the address\n \"index\": n,              (numeric) The BIP0044 child index of the address\n \"confirmed\": true|false, (boolean) Whether the external signer confirmed the address\n}                         \n",
 		"verifymessage":             "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
 		"version":                   "version\n\nReturns application and API versions (semver) keyed by their names\n\nArguments:\nNone\n\nResult:\n{\n \"Program or API name\": Object containing the semantic version, (object) Version objects keyed by the program or API name\n ...\n}\n",
-		"walletinfo":                "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false, (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"spv\": true|false,             (boolean) Whether or not wallet is syncing in SPV mode\n \"unlocked\": true|false,        (boolean) Whether or not the wallet is unlocked\n \"cointype\": n,                 (numeric) Active coin type. Not available for watching-only wallets.\n \"txfee\": n.nnn,                (numeric) Transaction fee per kB of the serialized tx size in coins\n \"votebits\": n,                 (numeric) Vote bits setting\n \"votebitsextended\": \"value\",   (string)  Extended vote bits setting\n \"voteversion\": n,              (numeric) Version of votes that will be generated\n \"voting\": true|false,          (boolean) Whether or not the wallet is currently voting tickets\n \"vsp\": \"value\",                (string)  VSP URL used when purchasing tickets\n \"manualtickets\": true|false,   (boolean) Whether or not the wallet is only accepting tickets manually\n \"birthhash\": \"value\",          (string)  The wallet birth hash.\n \"birthheight\": n,              (numeric) The wallet birth height.\n}                               \n",
+		"walletinfo":                "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false,     (boolean)         Whether or not the wallet is currently connected to the daemon RPC\n \"spv\": true|false,                 (boolean)         Whether or not wallet is syncing in SPV mode\n \"unlocked\": true|false,            (boolean)         Whether or not the wallet is unlocked\n \"cointype\": n,                     (numeric)         Active coin type. Not available for watching-only wallets.\n \"txfee\": n.nnn,                    (numeric)         Transaction fee per kB of the serialized tx size in coins\n \"txfeeatoms\": n,                   (numeric)         Transaction fee per kB of the serialized tx size in atoms\n \"votebits\": n,                     (numeric)         Vote bits setting\n \"votebitsextended\": \"value\",       (string)          Extended vote bits setting\n \"voteversion\": n,                  (numeric)         Version of votes that will be generated\n \"networkstakeversion\": n,          (numeric)         Stake version of the main chain tip block\n \"voteversionoutdated\": true|false, (boolean)         Whether the network has upgraded to a newer stake version than the wallet supports, causing its votes to abstain on newer agendas\n \"voting\": true|false,              (boolean)         Whether or not the wallet is currently voting tickets\n \"vsp\": \"value\",                    (string)          VSP URL used when purchasing tickets\n \"manualtickets\": true|false,       (boolean)         Whether or not the wallet is only accepting tickets manually\n \"birthhash\": \"value\",              (string)          The wallet birth hash.\n \"birthheight\": n,                  (numeric)         The wallet birth height.\n \"tipchecks\": [{                    (array of object) The most recent cross-checks of the main chain tip against each configured tip source, omitted when no sources are configured\n  \"source\": \"value\",                (string)          The tip source\n  \"time\": n,                        (numeric)         The Unix time of the check\n  \"height\": n,                      (numeric)         The best block height reported by the source\n  \"walletheight\": n,                (numeric)         The main chain tip height of the wallet\n  \"diverged\": true|false,           (boolean)         Whether the heights differ by more than the allowed number of blocks\n  \"error\": \"value\",                 (string)          The error querying the source, if any\n },...],                                              \n \"subsystems\": [{                   (array of object) The status of each long-running subsystem run by the wallet process\n  \"name\": \"value\",                  (string)          The name of the subsystem\n  \"state\": \"value\",                 (string)          The state of the subsystem (running, restarting, stopped, or failed)\n  \"started\": n,                     (numeric)         The Unix time the current or most recent run of the subsystem started\n  \"restarts\": n,                    (numeric)         The number of times the subsystem was restarted after a failure\n  \"lasterror\": \"value\",             (string)          The most recent failure of the subsystem, if any\n  \"lasterrortime\": n,               (numeric)         The Unix time of the most recent failure, if any\n },...],                                              \n}                                   \n",
 		"walletislocked":            "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
 		"walletlock":                "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
-		"walletpassphrase":          "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.\n\nResult:\nNothing\n",
+		"walletpassphrase":          "walletpassphrase \"passphrase\" timeout (session=false)\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)                 The wallet passphrase\n2. timeout    (numeric, required)                The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.\n3. session    (boolean, optional, default=false) Bind the unlock to the websocket connection of this request. Other clients see the wallet as locked and cannot use private keys, and the wallet is locked when the connection closes.\n\nResult:\nNothing\n",
 		"walletpassphrasechange":    "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
 		"walletpubpassphrasechange": "walletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet's public passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
 	}
@@ -113,4 +146,4 @@ var localeHelpDescs = map[string]func() map[string]string{
 	"en_US": helpDescsEnUS,
 }
 
-var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naccountunlocked \"account\"\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddtransaction \"blockhash\" \"transaction\"\nauditreuse (since)\nconsolidate inputs (\"account\" \"address\")\ncreatemultisig nrequired [\"key\",...]\ncreatenewaccount \"account\"\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ncreatesignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\ndisapprovepercent\ndiscoverusage (\"startblock\" discoveraccounts gaplimit)\ndumpprivkey \"address\"\nfundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetbestblock\ngetbestblockhash\ngetblockcount\ngetblockhash index\ngetblockheader \"hash\" (verbose=true)\ngetblock \"hash\" (verbose=true verbosetx=false)\ngetcoinjoinsbyacct\ngetcurrentnet\ngetinfo\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetpeerinfo\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetstakeinfo\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngettxout \"txid\" vout tree (includemempool=true)\ngetunconfirmedbalance (\"account\")\ngetvotechoices (\"tickethash\")\ngetwalletfee\ngetcfilterv2 \"blockhash\"\nhelp (\"command\")\nimportcfiltersv2 startheight [\"filter\",...]\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nimportxpub \"name\" \"xpub\"\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistlockunspent (\"account\")\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\nlockaccount \"account\"\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nmixaccount\nmixoutput \"outpoint\"\nprocessunmanagedticket \"tickethash\"\npurchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx)\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendfromtreasury \"key\" amounts\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\nsendrawtransaction \"hextx\" (allowhighfees=false)\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsendtotreasury amount\nsetaccountpassphrase \"account\" \"passphrase\"\nsetdisapprovepercent percent\nsettreasurypolicy \"key\" \"policy\" (\"ticket\")\nsettspendpolicy \"hash\" \"policy\" (\"ticket\")\nsettxfee amount\nsetvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nspendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nsyncstatus\nticketinfo (startheight=0)\ntreasurypolicy (\"key\" \"ticket\")\ntspendpolicy (\"hash\" \"ticket\")\nunlockaccount \"account\" \"passphrase\"\nvalidateaddress \"address\"\nvalidatepredcp0005cf\nverifymessage \"address\" \"signature\" \"message\"\nversion\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrase \"passphrase\" timeout\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\""
+var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountspendpolicy \"account\"\naccountsyncaddressindex \"account\" branch index\naccountunlocked \"account\"\naddcontact \"name\" \"address\"\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddtransaction \"blockhash\" \"transaction\"\nauditreuse (since)\nconsolidate inputs (\"account\" \"address\")\ncreatemultisig nrequired [\"key\",...]\ncreatenewaccount \"account\"\ncreatepaymenttemplate \"name\" \"account\" {\"address\":amount,...} (interval=0 minconf=1)\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ncreatesignature \"address\" inputindex hashtype \"previouspkscript\" \"serializedtransaction\"\ndeletecontact \"name\"\ndeletepaymenttemplate \"name\"\ndisapprovepercent\ndiscoverusage (\"startblock\" discoveraccounts gaplimit)\ndroptransactionhistory (keepunmined=true)\ndumpprivkey \"address\"\ndumpwallet \"filename\" confirm\nexecutesweepplan \"planid\" [\"accounts\",...] \"address\" (minconf=1 feerate)\nexecutetemplate \"name\"\nexportticket \"tickethash\"\nfundrawtransaction \"hexstring\" \"fundaccount\" ({\"changeaddress\":changeaddress,\"feerate\":feerate,\"conftarget\":conftarget})\ngetaccount \"address\"\ngetaccountaddress \"account\"\ngetaddressesbyaccount \"account\"\ngetattestation\ngetauditlog (count=100 from=0)\ngetbalance (\"account\" minconf=1)\ngetbalancehistory (days=30)\ngetbestblock\ngetbestblockhash\ngetblockcount\ngetblockhash index\ngetblockheader \"hash\" (verbose=true)\ngetblock \"hash\" (verbose=true verbosetx=false)\ngetcoinjoinsbyacct\ngetcurrentnet\ngetinfo\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetpeerinfo\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetstakeearnings (period=\"month\")\ngetstakeinfo\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngettxout \"txid\" vout tree (includemempool=true)\ngetunconfirmedbalance (\"account\")\ngetutxostats (minconf=1)\ngetvotechoices (\"tickethash\")\ngetwalletfee\ngetcfilterv2 \"blockhash\"\nhelp (\"command\")\nimportcfiltersv2 startheight [\"filter\",...]\nimportlegacykeys \"dump\" (rescan=true)\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportpubkey \"pubkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nimportticket \"export\"\nimportxpub \"name\" \"xpub\"\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistcontacts\nlistlockunspent (\"account\")\nlistpaymenttemplates\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...] \"account\")\nlistvsptickets\nlistwalletevents (count=100 from=0)\nlockaccount \"account\"\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nmixaccount\nmixoutput \"outpoint\"\nprocessunmanagedticket \"tickethash\"\nplansweep [\"accounts\",...] \"address\" (minconf=1 feerate)\npurchaseticket \"fromaccount\" spendlimit (minconf=1 numtickets=1 expiry \"comment\" dontsigntx expiryblocks verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nreloadconfig\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nresendtransaction \"txhash\"\nselfcheck\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\" verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\nsendfromtreasury \"key\" amounts\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" {\"address\":\"label\",...} split=false verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\nsendrawtransaction \"hextx\" (allowhighfees=false)\nsendtoaddress \"address\" amount (\"comment\" \"commentto\" verbose=false {\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"})\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsendtotreasury amount\nsetaccountpassphrase \"account\" \"passphrase\"\nsetaccountspendpolicy \"account\" \"policy\"\nsetdisapprovepercent percent\nsettreasurypolicy \"key\" \"policy\" (\"ticket\")\nsettspendpolicy \"hash\" \"policy\" (\"ticket\")\nsettxfee amount\nsetvotechoice \"agendaid\" \"choiceid\" (\"tickethash\")\nsetvotingaccount \"account\" (voting=true)\nsetvotingpassphrase \"passphrase\"\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nsimnetadvance numblocks\nsimnetfundaccount \"account\" amount (fromaccount=\"default\")\nsimnetreorg depth\nspendoutputs \"account\" [\"previousoutpoint\",...] [{\"address\":\"value\",\"amount\":n.nnn},...]\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nsyncstatus\nticketinfo (startheight=0)\ntreasurypolicy (\"key\" \"ticket\")\ntspendpolicy (\"hash\" \"ticket\")\nunlockaccount \"account\" \"passphrase\"\nupdatecontact \"name\" \"address\"\nvalidateaddress \"address\"\nvalidatepredcp0005cf\nverifyexternaladdress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nversion\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrase \"passphrase\" timeout (session=false)\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpubpassphrasechange \"oldpassphrase\" \"newpassphrase\""
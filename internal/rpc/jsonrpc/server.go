@@ -22,6 +22,7 @@ import (
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/loader"
 	"decred.org/dcrwallet/v5/rpc/jsonrpc/types"
+	"decred.org/dcrwallet/v5/wallet"
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrjson/v4"
 	dcrdtypes "github.com/decred/dcrd/rpc/jsonrpc/types/v4"
@@ -75,12 +76,27 @@ type Server struct {
 
 	requestShutdownChan chan struct{}
 
+	// sessionUnlock is the websocket client which unlocked the wallet
+	// with a session-bound unlock, or nil.  While set, requests from all
+	// other clients see the wallet as locked.
+	sessionUnlock   *websocketClient
+	sessionUnlockMu sync.Mutex
+
 	activeNet *chaincfg.Params
 }
 
 type handler struct {
 	fn     func(*Server, context.Context, any) (any, error)
 	noHelp bool
+
+	// usesKeys describes methods which use private keys of the unlocked
+	// wallet.  These are refused for clients other than the owner of a
+	// session-bound unlock.
+	usesKeys bool
+
+	// mutates describes methods which modify the wallet or publish
+	// transactions.  These are recorded to the audit log.
+	mutates bool
 }
 
 // jsonAuthFail sends a message back to the client if the http auth is rejected.
@@ -161,8 +177,10 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 				return
 			}
 			ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
+			ctx = withRequestCertificate(ctx, r)
 			ctx, cancel := context.WithCancel(ctx)
 			wsc := newWebsocketClient(conn, cancel, authenticated)
+			ctx = withWebsocketClient(ctx, wsc)
 			server.websocketClientRPC(ctx, wsc)
 		}))
 
@@ -173,6 +191,15 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 	return server
 }
 
+// withRequestCertificate records the subject common name of the verified TLS
+// client certificate of the HTTP request, if any, in the context.
+func withRequestCertificate(ctx context.Context, r *http.Request) context.Context {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return ctx
+	}
+	return withClientCertificate(ctx, r.TLS.PeerCertificates[0].Subject.CommonName)
+}
+
 // httpBasicAuth returns the UTF-8 bytes of the HTTP Basic authentication
 // string:
 //
@@ -242,7 +269,23 @@ func (s *Server) Stop() {
 // known) and handled accordingly.
 func (s *Server) handlerClosure(ctx context.Context, request *dcrjson.Request) lazyHandler {
 	log.Debugf("RPC method %q invoked by %v", request.Method, remoteAddr(ctx))
-	return lazyApplyHandler(s, ctx, request)
+	// Client requests may spend the outputs of warm accounts.
+	ctx = wallet.WithManualSpend(ctx)
+	h := lazyApplyHandler(s, ctx, request)
+	if !s.cfg.OmitFloatAmounts {
+		return h
+	}
+	return func() (any, *dcrjson.RPCError) {
+		res, jsonErr := h()
+		if jsonErr != nil || res == nil {
+			return res, jsonErr
+		}
+		stripped, err := omitFloatAmounts(res)
+		if err != nil {
+			return nil, convertError(err)
+		}
+		return stripped, nil
+	}
 }
 
 // errNoAuth represents an error where authentication could not succeed
@@ -460,10 +503,44 @@ out:
 
 	// allow client to disconnect after all handler goroutines are done
 	wsc.wg.Wait()
+	s.endSessionUnlock(wsc)
 	close(wsc.responses)
 	s.wg.Done()
 }
 
+// sessionLocked returns whether the wallet must appear locked to the client
+// of a request because another websocket client holds a session-bound unlock.
+func (s *Server) sessionLocked(ctx context.Context) bool {
+	s.sessionUnlockMu.Lock()
+	owner := s.sessionUnlock
+	s.sessionUnlockMu.Unlock()
+	return owner != nil && owner != websocketClientFromContext(ctx)
+}
+
+// setSessionUnlock records the websocket client holding a session-bound
+// unlock.  A nil client records that any unlock is not bound to a session.
+func (s *Server) setSessionUnlock(wsc *websocketClient) {
+	s.sessionUnlockMu.Lock()
+	s.sessionUnlock = wsc
+	s.sessionUnlockMu.Unlock()
+}
+
+// endSessionUnlock locks the wallet if the disconnected websocket client held
+// a session-bound unlock.
+func (s *Server) endSessionUnlock(wsc *websocketClient) {
+	s.sessionUnlockMu.Lock()
+	defer s.sessionUnlockMu.Unlock()
+	if s.sessionUnlock != wsc {
+		return
+	}
+	s.sessionUnlock = nil
+	if w, ok := s.walletLoader.LoadedWallet(); ok {
+		w.Lock()
+		log.Infof("Locked wallet after disconnect of session client %v",
+			wsc.conn.RemoteAddr())
+	}
+}
+
 func (s *Server) websocketClientSend(ctx context.Context, wsc *websocketClient) {
 	defer s.wg.Done()
 	const deadline time.Duration = 2 * time.Second
@@ -527,6 +604,7 @@ const maxRequestSize = 1024 * 1024 * 4
 // postClientRPC processes and replies to a JSON-RPC client request.
 func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 	ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
+	ctx = withRequestCertificate(ctx, r)
 
 	body := http.MaxBytesReader(w, r.Body, maxRequestSize)
 	rpcRequest, err := io.ReadAll(body)
@@ -0,0 +1,87 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcserver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+
+	"github.com/decred/dcrd/wire"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blockPageToken is the position at which a paginated listing of block
+// results continues.  Height is the block height, or -1 for unmined
+// transactions, and skip is the number of results of this block which were
+// included by previous pages.
+type blockPageToken struct {
+	height int32
+	skip   int
+}
+
+func (t *blockPageToken) encode() string {
+	var b [8]byte
+	binary.BigEndian.PutUint32(b[0:4], uint32(t.height))
+	binary.BigEndian.PutUint32(b[4:8], uint32(t.skip))
+	return base64.RawURLEncoding.EncodeToString(b[:])
+}
+
+func decodeBlockPageToken(s string) (*blockPageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) != 8 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	t := &blockPageToken{
+		height: int32(binary.BigEndian.Uint32(b[0:4])),
+		skip:   int(binary.BigEndian.Uint32(b[4:8])),
+	}
+	if t.height < -1 || t.skip < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	return t, nil
+}
+
+// encodeOutPointPageToken returns a page token continuing an outpoint-ordered
+// listing after op.
+func encodeOutPointPageToken(op *wire.OutPoint) string {
+	var b [36]byte
+	copy(b[:32], op.Hash[:])
+	binary.BigEndian.PutUint32(b[32:], op.Index)
+	return base64.RawURLEncoding.EncodeToString(b[:])
+}
+
+func decodeOutPointPageToken(s string) (*wire.OutPoint, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) != 36 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	op := new(wire.OutPoint)
+	copy(op.Hash[:], b[:32])
+	op.Index = binary.BigEndian.Uint32(b[32:])
+	return op, nil
+}
+
+// outPointLess orders outpoints by transaction hash and output index.
+func outPointLess(a, b *wire.OutPoint) bool {
+	switch bytes.Compare(a.Hash[:], b.Hash[:]) {
+	case -1:
+		return true
+	case 1:
+		return false
+	}
+	return a.Index < b.Index
+}
+
+// checkPageSize validates a requested page size.  A zero page size disables
+// pagination.
+func checkPageSize(pageSize int32) error {
+	if pageSize < 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"page size may not be negative")
+	}
+	return nil
+}
@@ -32,6 +32,7 @@ import (
 
 	"decred.org/dcrwallet/v5/chain"
 	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/addrcheck"
 	"decred.org/dcrwallet/v5/internal/cfgutil"
 	"decred.org/dcrwallet/v5/internal/loader"
 	"decred.org/dcrwallet/v5/internal/netparams"
@@ -42,6 +43,7 @@ import (
 	"decred.org/dcrwallet/v5/wallet"
 	"decred.org/dcrwallet/v5/wallet/txauthor"
 	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
 	"decred.org/dcrwallet/v5/wallet/udb"
 	"decred.org/dcrwallet/v5/walletseed"
 	"github.com/decred/dcrd/addrmgr/v2"
@@ -61,9 +63,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "8.0.0"
+	semverString = "8.6.0"
 	semverMajor  = 8
-	semverMinor  = 0
+	semverMinor  = 7
 	semverPatch  = 0
 )
 
@@ -139,9 +141,9 @@ func errorCode(err error) codes.Code {
 // network.  This should be used preferred to direct usage of
 // dcrutil.DecodeAddress, which does not perform the network check.
 func decodeAddress(a string, params *chaincfg.Params) (stdaddr.Address, error) {
-	addr, err := stdaddr.DecodeAddress(a, params)
+	addr, err := addrcheck.Decode(a, params)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid address %v: %v", a, err)
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 	}
 	return addr, nil
 }
@@ -455,11 +457,17 @@ func (s *walletServer) Rescan(req *pb.RescanRequest, svr pb.WalletService_Rescan
 	progress := make(chan wallet.RescanProgress, 1)
 	go s.wallet.RescanProgressFromHeight(svr.Context(), n, b.Height, progress)
 
+	var last wallet.RescanProgress
 	for p := range progress {
 		if p.Err != nil {
 			return translateError(p.Err)
 		}
-		resp := &pb.RescanResponse{RescannedThrough: p.ScannedThrough}
+		last = p
+		resp := &pb.RescanResponse{
+			RescannedThrough:     p.ScannedThrough,
+			Percent:              p.Percent(),
+			RelevantTransactions: uint32(p.RelevantTxs),
+		}
 		err := svr.Send(resp)
 		if err != nil {
 			return translateError(err)
@@ -470,8 +478,20 @@ func (s *walletServer) Rescan(req *pb.RescanRequest, svr pb.WalletService_Rescan
 	case <-svr.Context().Done():
 		return status.Errorf(codes.Canceled, "rescan canceled")
 	default:
-		return nil
 	}
+	if last.ScannedThrough == 0 {
+		_, last.ScannedThrough = s.wallet.MainChainTip(svr.Context())
+	}
+	err = svr.Send(&pb.RescanResponse{
+		RescannedThrough:     last.ScannedThrough,
+		Percent:              100,
+		RelevantTransactions: uint32(last.RelevantTxs),
+		Finished:             true,
+	})
+	if err != nil {
+		return translateError(err)
+	}
+	return nil
 }
 
 func zero(b []byte) {
@@ -872,6 +892,7 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 		LockedByTickets:         int64(bals.LockedByTickets),
 		VotingAuthority:         int64(bals.VotingAuthority),
 		Unconfirmed:             int64(bals.Unconfirmed),
+		Effective:               int64(bals.Effective),
 	}
 	return resp, nil
 }
@@ -933,7 +954,7 @@ func (src *scriptChangeSource) ScriptSize() int {
 }
 
 func makeScriptChangeSource(address string, params *chaincfg.Params) (*scriptChangeSource, error) {
-	destinationAddress, err := stdaddr.DecodeAddress(address, params)
+	destinationAddress, err := decodeAddress(address, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1041,6 +1062,23 @@ func (s *walletServer) BlockInfo(ctx context.Context, req *pb.BlockInfoRequest)
 }
 
 func (s *walletServer) UnspentOutputs(req *pb.UnspentOutputsRequest, svr pb.WalletService_UnspentOutputsServer) error {
+	if err := checkPageSize(req.PageSize); err != nil {
+		return err
+	}
+	paginate := req.PageSize > 0 || req.PageToken != ""
+	if paginate && req.TargetAmount != 0 {
+		return status.Errorf(codes.InvalidArgument,
+			"pagination may not be used with a target amount")
+	}
+	var after *wire.OutPoint
+	if req.PageToken != "" {
+		var err error
+		after, err = decodeOutPointPageToken(req.PageToken)
+		if err != nil {
+			return err
+		}
+	}
+
 	policy := wallet.OutputSelectionPolicy{
 		Account:               req.Account,
 		RequiredConfirmations: req.RequiredConfirmations,
@@ -1052,8 +1090,36 @@ func (s *walletServer) UnspentOutputs(req *pb.UnspentOutputsRequest, svr pb.Wall
 		return translateError(err)
 	}
 
+	// Selected inputs are in an unspecified order.  Paginated results are
+	// instead ordered by outpoint, and each page continues after the last
+	// outpoint of the previous page.
+	order := make([]int, len(inputDetail.Inputs))
+	for i := range order {
+		order[i] = i
+	}
+	var nextPageToken string
+	if paginate {
+		outPoint := func(i int) *wire.OutPoint {
+			return &inputDetail.Inputs[order[i]].PreviousOutPoint
+		}
+		sort.Slice(order, func(i, j int) bool {
+			return outPointLess(outPoint(i), outPoint(j))
+		})
+		if after != nil {
+			start := sort.Search(len(order), func(i int) bool {
+				return outPointLess(after, outPoint(i))
+			})
+			order = order[start:]
+		}
+		if req.PageSize > 0 && len(order) > int(req.PageSize) {
+			order = order[:int(req.PageSize)]
+			nextPageToken = encodeOutPointPageToken(outPoint(len(order) - 1))
+		}
+	}
+
 	var sum int64
-	for i, input := range inputDetail.Inputs {
+	for n, i := range order {
+		input := inputDetail.Inputs[i]
 		select {
 		case <-svr.Context().Done():
 			return status.Errorf(codes.Canceled, "unspentoutputs cancelled")
@@ -1074,6 +1140,9 @@ func (s *walletServer) UnspentOutputs(req *pb.UnspentOutputsRequest, svr pb.Wall
 
 			sum += unspentOutput.Amount
 			unspentOutput.AmountSum = sum
+			if n == len(order)-1 {
+				unspentOutput.NextPageToken = nextPageToken
+			}
 
 			err = svr.Send(unspentOutput)
 			if err != nil {
@@ -1184,6 +1253,18 @@ func makeTxChangeSource(destination *pb.ConstructTransactionRequest_OutputDestin
 func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.ConstructTransactionRequest) (
 	*pb.ConstructTransactionResponse, error) {
 
+	switch req.TransactionType {
+	case pb.ConstructTransactionRequest_REGULAR:
+	case pb.ConstructTransactionRequest_TICKET:
+		return s.constructTicket(ctx, req)
+	case pb.ConstructTransactionRequest_VOTE:
+		return s.constructVote(ctx, req)
+	case pb.ConstructTransactionRequest_REVOCATION:
+		return s.constructRevocation(ctx, req)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown transaction type")
+	}
+
 	chainParams := s.wallet.ChainParams()
 
 	if len(req.NonChangeOutputs) == 0 && req.ChangeDestination == nil {
@@ -1239,9 +1320,13 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 		tx.RandomizeChangePosition()
 	}
 
+	return constructTransactionResponse(tx)
+}
+
+func constructTransactionResponse(tx *txauthor.AuthoredTx) (*pb.ConstructTransactionResponse, error) {
 	var txBuf bytes.Buffer
 	txBuf.Grow(tx.Tx.SerializeSize())
-	err = tx.Tx.Serialize(&txBuf)
+	err := tx.Tx.Serialize(&txBuf)
 	if err != nil {
 		return nil, translateError(err)
 	}
@@ -1256,6 +1341,136 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 	return res, nil
 }
 
+// authoredStakeTx describes a vote or revocation, which spend a ticket
+// instead of account outputs and never include change.
+func authoredStakeTx(tx *wire.MsgTx, sigScriptSize int) *txauthor.AuthoredTx {
+	var totalInput dcrutil.Amount
+	for _, in := range tx.TxIn {
+		totalInput += dcrutil.Amount(in.ValueIn)
+	}
+	return &txauthor.AuthoredTx{
+		Tx:                           tx,
+		TotalInput:                   totalInput,
+		ChangeIndex:                  -1,
+		EstimatedSignedSerializeSize: tx.SerializeSize() + sigScriptSize,
+	}
+}
+
+func (s *walletServer) constructTicket(ctx context.Context, req *pb.ConstructTransactionRequest) (
+	*pb.ConstructTransactionResponse, error) {
+
+	chainParams := s.wallet.ChainParams()
+
+	t := req.TicketPurchase
+	if t == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ticket_purchase is required")
+	}
+	if len(req.NonChangeOutputs) != 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"non_change_outputs may not be set for tickets")
+	}
+	votingAddr, err := decodeStakeAddress(t.VotingAddress, chainParams)
+	if err != nil {
+		return nil, err
+	}
+	commitmentAddr, err := decodeStakeAddress(t.CommitmentAddress, chainParams)
+	if err != nil {
+		return nil, err
+	}
+	var changeAddr stdaddr.StakeAddress
+	if req.ChangeDestination != nil {
+		if req.ChangeDestination.Address == "" {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"ticket change_destination must be an address")
+		}
+		changeAddr, err = decodeStakeAddress(req.ChangeDestination.Address, chainParams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ticketPrice := dcrutil.Amount(t.TicketPrice)
+	if ticketPrice == 0 {
+		ticketPrice, err = s.wallet.NextStakeDifficulty(ctx)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+	feePerKb := txrules.DefaultRelayFeePerKb
+	if req.FeePerKb != 0 {
+		feePerKb = dcrutil.Amount(req.FeePerKb)
+	}
+
+	tx, err := s.wallet.NewUnsignedTicket(ctx, req.SourceAccount,
+		req.RequiredConfirmations, ticketPrice, feePerKb, votingAddr,
+		commitmentAddr, changeAddr)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return constructTransactionResponse(tx)
+}
+
+func (s *walletServer) constructVote(ctx context.Context, req *pb.ConstructTransactionRequest) (
+	*pb.ConstructTransactionResponse, error) {
+
+	v := req.Vote
+	if v == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "vote is required")
+	}
+	ticketHash, err := chainhash.NewHash(v.TicketHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ticket_hash: %v", err)
+	}
+	blockHash, err := chainhash.NewHash(v.BlockHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "block_hash: %v", err)
+	}
+	if v.VoteBits > uint32(^uint16(0)) {
+		return nil, status.Errorf(codes.InvalidArgument, "vote_bits overflows uint16")
+	}
+	voteBits := stake.VoteBits{
+		Bits:         uint16(v.VoteBits),
+		ExtendedBits: v.ExtendedVoteBits,
+	}
+
+	vote, err := s.wallet.NewUnsignedVote(ctx, ticketHash, blockHash,
+		v.BlockHeight, voteBits)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return constructTransactionResponse(authoredStakeTx(vote,
+		txsizes.RedeemP2PKHSigScriptSize))
+}
+
+func (s *walletServer) constructRevocation(ctx context.Context, req *pb.ConstructTransactionRequest) (
+	*pb.ConstructTransactionResponse, error) {
+
+	r := req.Revocation
+	if r == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "revocation is required")
+	}
+	ticketHash, err := chainhash.NewHash(r.TicketHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "ticket_hash: %v", err)
+	}
+	var prevBlockHash *chainhash.Hash
+	if len(r.PreviousBlockHash) != 0 {
+		prevBlockHash, err = chainhash.NewHash(r.PreviousBlockHash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"previous_block_hash: %v", err)
+		}
+	}
+
+	revocation, err := s.wallet.NewUnsignedRevocation(ctx, ticketHash,
+		prevBlockHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	// Automatic revocations are not signed.
+	return constructTransactionResponse(authoredStakeTx(revocation, 0))
+}
+
 func (s *walletServer) GetAccountExtendedPubKey(ctx context.Context, req *pb.GetAccountExtendedPubKeyRequest) (*pb.GetAccountExtendedPubKeyResponse, error) {
 	accExtendedPubKey, err := s.wallet.AccountXpub(ctx, req.AccountNumber)
 	if err != nil {
@@ -1409,32 +1624,98 @@ func (s *walletServer) GetTransactions(req *pb.GetTransactionsRequest,
 			"maximum transaction count may not be negative")
 	}
 
+	if err := checkPageSize(req.PageSize); err != nil {
+		return err
+	}
+	pageSize := int(req.PageSize)
+	var resume *blockPageToken
+	if req.PageToken != "" {
+		resume, err = decodeBlockPageToken(req.PageToken)
+		if err != nil {
+			return err
+		}
+		startBlock = wallet.NewBlockIdentifierFromHeight(resume.height)
+	}
+
 	ctx := server.Context()
 	txCount := 0
 
+	// When paginating, each response is held back until the next result is
+	// found, so that the final response of a page can describe where the
+	// next page begins.
+	var pending *pb.GetTransactionsResponse
+	sendPending := func() error {
+		if pending == nil {
+			return nil
+		}
+		err := server.Send(pending)
+		pending = nil
+		return err
+	}
+
 	rangeFn := func(block *wallet.Block) (bool, error) {
-		var resp *pb.GetTransactionsResponse
+		height := int32(-1)
 		if block.Header != nil {
-			resp = &pb.GetTransactionsResponse{
-				MinedTransactions: marshalBlock(block),
+			height = int32(block.Header.Height)
+		}
+		txs := block.Transactions
+		skip := 0
+		if resume != nil {
+			if resume.height == height {
+				skip = min(resume.skip, len(txs))
+			}
+			resume = nil
+		}
+		txs = txs[skip:]
+		if len(txs) == 0 {
+			return false, nil
+		}
+
+		if pageSize > 0 && txCount == pageSize {
+			pending.NextPageToken = (&blockPageToken{height, skip}).encode()
+			return true, sendPending()
+		}
+		if err := sendPending(); err != nil {
+			return true, err
+		}
+
+		n := len(txs)
+		if pageSize > 0 && txCount+n > pageSize {
+			n = pageSize - txCount
+		}
+		b := *block
+		b.Transactions = txs[:n]
+		if b.Header != nil {
+			pending = &pb.GetTransactionsResponse{
+				MinedTransactions: marshalBlock(&b),
 			}
 		} else {
-			resp = &pb.GetTransactionsResponse{
-				UnminedTransactions: marshalTransactionDetailsSlice(block.Transactions),
+			pending = &pb.GetTransactionsResponse{
+				UnminedTransactions: marshalTransactionDetailsSlice(b.Transactions),
 			}
 		}
-		txCount += len(block.Transactions)
+		txCount += n
+		if n < len(txs) {
+			pending.NextPageToken = (&blockPageToken{height, skip + n}).encode()
+			return true, sendPending()
+		}
 
 		select {
 		case <-ctx.Done():
 			return true, ctx.Err()
 		default:
-			err := server.Send(resp)
+			if pageSize > 0 {
+				return false, nil
+			}
+			err := sendPending()
 			return (err != nil) || ((targetTxCount > 0) && (txCount >= targetTxCount)), err
 		}
 	}
 
 	err = s.wallet.GetTransactions(ctx, rangeFn, startBlock, endBlock)
+	if err == nil {
+		err = sendPending()
+	}
 	if err != nil {
 		return translateError(err)
 	}
@@ -1493,38 +1774,84 @@ func (s *walletServer) GetTickets(req *pb.GetTicketsRequest,
 			"target ticket count may not be negative")
 	}
 
+	if err := checkPageSize(req.PageSize); err != nil {
+		return err
+	}
+	pageSize := int(req.PageSize)
+	var resume *blockPageToken
+	if req.PageToken != "" {
+		resume, err = decodeBlockPageToken(req.PageToken)
+		if err != nil {
+			return err
+		}
+		startBlock = wallet.NewBlockIdentifierFromHeight(resume.height)
+	}
+
 	ticketCount := 0
 	ctx := server.Context()
 
+	// When paginating, each response is held back until the next ticket is
+	// found, so that the final response of a page can describe where the
+	// next page begins.
+	var pending *pb.GetTicketsResponse
+	sendPending := func() error {
+		if pending == nil {
+			return nil
+		}
+		err := server.Send(pending)
+		pending = nil
+		return err
+	}
+
 	rangeFn := func(tickets []*wallet.TicketSummary, block *wire.BlockHeader) (bool, error) {
-		resp := &pb.GetTicketsResponse{
-			Block: marshalGetTicketBlockDetails(block),
+		height := int32(-1)
+		if block != nil {
+			height = int32(block.Height)
+		}
+		skip := 0
+		if resume != nil {
+			if resume.height == height {
+				skip = min(resume.skip, len(tickets))
+			}
+			resume = nil
 		}
 
 		// current contract for grpc GetTickets is for one ticket per response.
 		// To make sure we don't miss any while paginating, we only check for
 		// the targetTicketCount after sending all from this block.
-		for _, t := range tickets {
-			resp.Ticket = marshalTicketDetails(t)
+		for i := skip; i < len(tickets); i++ {
+			if pageSize > 0 && ticketCount == pageSize {
+				pending.NextPageToken = (&blockPageToken{height, i}).encode()
+				return true, sendPending()
+			}
+			if err := sendPending(); err != nil {
+				return true, err
+			}
 
+			t := tickets[i]
 			host, err := s.wallet.VSPHostForTicket(ctx, t.Ticket.Hash)
 			if err != nil && !errors.Is(err, errors.NotExist) {
 				return true, err
 			}
-			resp.VspHost = host
-
-			err = server.Send(resp)
-			if err != nil {
-				return true, err
+			pending = &pb.GetTicketsResponse{
+				Ticket:  marshalTicketDetails(t),
+				Block:   marshalGetTicketBlockDetails(block),
+				VspHost: host,
+			}
+			ticketCount++
+			if pageSize == 0 {
+				if err := sendPending(); err != nil {
+					return true, err
+				}
 			}
 		}
-		ticketCount += len(tickets)
 
 		select {
 		case <-ctx.Done():
 			return true, ctx.Err()
 		default:
-			return ((targetTicketCount > 0) && (ticketCount >= targetTicketCount)), nil
+			return pageSize == 0 && targetTicketCount > 0 &&
+				ticketCount >= targetTicketCount, nil
 		}
 	}
 	n, _ := s.wallet.NetworkBackend()
@@ -1533,6 +1860,9 @@ func (s *walletServer) GetTickets(req *pb.GetTicketsRequest,
 	} else {
 		err = s.wallet.GetTickets(ctx, rangeFn, startBlock, endBlock)
 	}
+	if err == nil {
+		err = sendPending()
+	}
 	if err != nil {
 		return translateError(err)
 	}
@@ -1824,6 +2154,7 @@ func (s *walletServer) PurchaseTickets(ctx context.Context,
 	}
 
 	expiry := int32(req.Expiry)
+	expiryBlocks := int32(req.ExpiryBlocks)
 	txFee := dcrutil.Amount(req.TxFee)
 
 	if txFee < 0 {
@@ -1868,6 +2199,7 @@ func (s *walletServer) PurchaseTickets(ctx context.Context,
 		SourceAccount:    req.Account,
 		MinConf:          minConf,
 		Expiry:           expiry,
+		ExpiryBlocks:     expiryBlocks,
 		DontSignTx:       dontSignTx,
 		UseVotingAccount: req.UseVotingAccount,
 		VotingAccount:    req.VotingAccount,
@@ -2271,6 +2603,7 @@ func marshalTransactionDetails(tx *wallet.TransactionSummary) *pb.TransactionDet
 		Fee:             int64(tx.Fee),
 		Timestamp:       tx.Timestamp,
 		TransactionType: marshalTxType(tx.Type),
+		Tree:            int32(tx.Tree),
 	}
 }
 
@@ -2445,6 +2778,39 @@ func (s *walletServer) AccountNotifications(req *pb.AccountNotificationsRequest,
 	}
 }
 
+func (s *walletServer) BalanceNotifications(req *pb.BalanceNotificationsRequest,
+	svr pb.WalletService_BalanceNotificationsServer) error {
+
+	n := s.wallet.NtfnServer.BalanceNotifications()
+	defer n.Done()
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case v := <-n.C:
+			resp := pb.BalanceNotificationsResponse{
+				AccountNumber:           v.Account,
+				Total:                   int64(v.Total),
+				Spendable:               int64(v.Spendable),
+				ImmatureReward:          int64(v.ImmatureCoinbaseRewards),
+				ImmatureStakeGeneration: int64(v.ImmatureStakeGeneration),
+				LockedByTickets:         int64(v.LockedByTickets),
+				VotingAuthority:         int64(v.VotingAuthority),
+				Unconfirmed:             int64(v.Unconfirmed),
+				Effective:               int64(v.Effective),
+				TxHash:                  v.TxHash[:],
+			}
+			err := svr.Send(&resp)
+			if err != nil {
+				return translateError(err)
+			}
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
 func (s *walletServer) ConfirmationNotifications(svr pb.WalletService_ConfirmationNotificationsServer) error {
 	c := s.wallet.NtfnServer.ConfirmationNotifications(svr.Context())
 	errOut := make(chan error, 2)
@@ -3521,9 +3887,9 @@ func (s *messageVerificationServer) VerifyMessage(ctx context.Context, req *pb.V
 
 	var valid bool
 
-	addr, err := stdaddr.DecodeAddress(req.Address, s.chainParams)
+	addr, err := decodeAddress(req.Address, s.chainParams)
 	if err != nil {
-		return nil, translateError(err)
+		return nil, err
 	}
 
 	// Addresses must have an associated secp256k1 private key and must be P2PKH
@@ -3774,7 +4140,9 @@ func (s *walletServer) AbandonTransaction(ctx context.Context, req *pb.AbandonTr
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction hash: %v", err)
 	}
-	err = s.wallet.AbandonTransaction(ctx, txHash)
+	n, _ := s.wallet.NetworkBackend()
+	rpc, _ := n.(wallet.MempoolQuerier) // nil rpc skips the mempool check
+	err = s.wallet.AbandonUnrelayedTransaction(ctx, rpc, txHash)
 	if err != nil {
 		return nil, translateError(err)
 	}
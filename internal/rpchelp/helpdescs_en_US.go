@@ -9,7 +9,7 @@ package rpchelp
 
 var helpDescsEnUS = map[string]string{
 	// AbandonTransactionCmd help.
-	"abandontransaction--synopsis": "Remove an unconfirmed transaction and all dependent transactions",
+	"abandontransaction--synopsis": "Remove an unconfirmed transaction and all dependent transactions, making the outputs they spend available again. Mined transactions, and transactions in the mempool of a dcrd RPC backend, may not be abandoned",
 	"abandontransaction-hash":      "Hash of transaction to remove",
 
 	// AccountAddressIndexCmd help.
@@ -18,6 +18,11 @@ var helpDescsEnUS = map[string]string{
 	"accountaddressindex-branch":    "Number for the branch (0=external, 1=internal)",
 	"accountaddressindex--result0":  "The address index for this account branch",
 
+	// AccountSpendPolicyCmd help.
+	"accountspendpolicy--synopsis": "Report the spend policy (hot, warm, or cold) of an account",
+	"accountspendpolicy-account":   "Account name",
+	"accountspendpolicy--result0":  "The spend policy of the account",
+
 	// AccountSyncAddressIndexCmd help.
 	"accountsyncaddressindex--synopsis": "Synchronize an account branch to some passed address index",
 	"accountsyncaddressindex-account":   "String for the account",
@@ -32,6 +37,16 @@ var helpDescsEnUS = map[string]string{
 	"accountunlockedresult-encrypted": "Whether the account is individually encrypted with a separate passphrase",
 	"accountunlockedresult-unlocked":  "If the individually encrypted account is unlocked. Omitted for unencrypted accounts.",
 
+	// AddContactCmd help.
+	"addcontact--synopsis": "Records a named address book contact for an external payment address.\n" +
+		"Contact names may be used in place of addresses by the sendfrom, sendmany, and sendtoaddress methods.",
+	"addcontact-name":    "Unique name of the contact, which may not itself be an address",
+	"addcontact-address": "Payment address of the contact, which must be valid for the wallet's network",
+
+	// ContactResult help.
+	"contactresult-name":    "Name of the contact",
+	"contactresult-address": "Payment address of the contact",
+
 	// AddMultisigAddressCmd help.
 	"addmultisigaddress--synopsis": "Generates and imports a multisig address and redeeming script to the 'imported' account.",
 	"addmultisigaddress-account":   "DEPRECATED -- Unused (all imported addresses belong to the imported account)",
@@ -76,6 +91,18 @@ var helpDescsEnUS = map[string]string{
 		"The wallet must be unlocked for this request to succeed.",
 	"createnewaccount-account": "Name of the new account",
 
+	// CreatePaymentTemplateCmd help.
+	"createpaymenttemplate--synopsis": "Records a named payment template which pays fixed amounts to fixed addresses from an account.\n" +
+		"Templates are paid with executetemplate, and are paid automatically every interval blocks when the interval is non-zero.",
+	"createpaymenttemplate-name":           "Unique name of the payment template",
+	"createpaymenttemplate-account":        "Account to pay from and return change to",
+	"createpaymenttemplate-amounts":        "Pairs of payment addresses and the output amount to pay each",
+	"createpaymenttemplate-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in decred to pay each address",
+	"createpaymenttemplate-amounts--key":   "Address to pay",
+	"createpaymenttemplate-amounts--value": "Amount to pay the payment address valued in decred",
+	"createpaymenttemplate-interval":       "Number of blocks between automatic payments, or 0 to only pay on demand",
+	"createpaymenttemplate-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+
 	// CreateRawTransactionCmd help.
 	"createrawtransaction--synopsis": "Returns a new transaction spending the provided inputs and sending to the provided addresses.\n" +
 		"The transaction inputs are not signed in the created transaction.\n" +
@@ -97,6 +124,14 @@ var helpDescsEnUS = map[string]string{
 	"createsignature-hashtype":              "The signature hash flags to use.",
 	"createsignature-previouspkscript":      "The hex encoded previous output script or P2SH redeem script.",
 
+	// DeleteContactCmd help.
+	"deletecontact--synopsis": "Removes a recorded address book contact.",
+	"deletecontact-name":      "Name of the contact",
+
+	// DeletePaymentTemplateCmd help.
+	"deletepaymenttemplate--synopsis": "Removes a recorded payment template.",
+	"deletepaymenttemplate-name":      "Name of the payment template",
+
 	// DisapprovePercentCmd help.
 	"disapprovepercent--synopsis": "Returns the wallet's current block disapprove percent per vote. i.e. 100 means that all votes disapprove the block they are called on. Only used for testing purposes.",
 	"disapprovepercent--result0":  "The disapprove percent. When voting, this percent of votes will randomly disapprove the block they are called on.",
@@ -107,11 +142,61 @@ var helpDescsEnUS = map[string]string{
 	"discoverusage-discoveraccounts": "Perform account discovery in addition to address discovery.  Requires unlocked wallet.",
 	"discoverusage-gaplimit":         "Allowed unused address gap.",
 
+	// DropTransactionHistoryCmd help.
+	"droptransactionhistory--synopsis": "Removes all transaction history from the wallet and rebuilds it by rescanning the main chain from the wallet birthday, blocking until the rescan completes.\n" +
+		"Block headers and committed filters are kept.\n" +
+		"Other transaction processing is paused while the history is removed.",
+	"droptransactionhistory-keepunmined": "Add unmined transactions back to the wallet after the rescan, except those conflicting with the rescanned history",
+
+	// DropTransactionHistoryResult help.
+	"droptransactionhistoryresult-rescanfrom":     "The height the main chain was rescanned from",
+	"droptransactionhistoryresult-unminedkept":    "The number of unmined transactions added back to the wallet",
+	"droptransactionhistoryresult-unmineddropped": "The number of unmined transactions discarded",
+
 	// DumpPrivKeyCmd help.
 	"dumpprivkey--synopsis": "Returns the private key in WIF encoding that controls some wallet address.",
 	"dumpprivkey-address":   "The address to return a private key for",
 	"dumpprivkey--result0":  "The WIF-encoded private key",
 
+	// DumpWalletCmd help.
+	"dumpwallet--synopsis": "Writes every private key and redeem script of the wallet to a new file, along with the account label, derivation path, and first use time of each.\n" +
+		"The wallet must be unlocked for this request to succeed.  Existing files are never overwritten.",
+	"dumpwallet-filename": "Path of the file to create on the wallet server",
+	"dumpwallet-confirm":  "Must be true to confirm the export of all private keys",
+
+	// DumpWalletResult help.
+	"dumpwalletresult-filename": "Path of the created file",
+
+	// ExecuteSweepPlanCmd help.
+	"executesweepplan--synopsis": "Signs and publishes the transactions of a sweep plan returned by plansweep.\n" +
+		"The plan is recomputed from the remaining arguments, which must match those passed to plansweep, and is only executed if its ID is unchanged.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"executesweepplan-planid":   "The plan ID returned by plansweep",
+	"executesweepplan-accounts": "The accounts to sweep",
+	"executesweepplan-address":  "The destination address",
+	"executesweepplan-minconf":  "Minimum number of block confirmations of swept outputs",
+	"executesweepplan-feerate":  "Fee rate in DCR/kB (default is the wallet's relay fee)",
+	"executesweepplan--result0": "The transaction hashes of the published transactions",
+
+	// ExecuteTemplateCmd help.
+	"executetemplate--synopsis": "Pays the outputs of a recorded payment template.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"executetemplate-name":     "Name of the payment template",
+	"executetemplate--result0": "The transaction hash of the payment",
+
+	// ExportTicketCmd help.
+	"exportticket--synopsis": "Exports the voting rights of a ticket, which may be imported by another wallet with importticket.\n" +
+		"The result contains private keys and must be kept secret.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"exportticket-tickethash": "Hash of the ticket purchase transaction",
+
+	// ExportTicketResult help.
+	"exportticketresult-network":      "Network the ticket was exported from",
+	"exportticketresult-ticket":       "Serialized ticket purchase transaction in hex encoding",
+	"exportticketresult-blockhash":    "Hash of the block the ticket is mined in, omitted for unmined tickets",
+	"exportticketresult-votingkeys":   "WIF-encoded private keys of the ticket's voting address",
+	"exportticketresult-redeemscript": "Hex encoded redeem script of a P2SH voting address",
+
 	// FundRawTransactionCmd help.
 	"fundrawtransaction--synopsis":            "Adds unsigned inputs and change output to a raw transaction",
 	"fundrawtransaction-hexstring":            "Serialized transaction in hex encoding",
@@ -122,6 +207,7 @@ var helpDescsEnUS = map[string]string{
 	"fundrawtransactionoptions-changeaddress": "Provide a change address rather than deriving one from the funding account",
 	"fundrawtransactionresult-hex":            "Funded transaction in hex encoding",
 	"fundrawtransactionresult-fee":            "Absolute fee of funded transaction",
+	"fundrawtransactionresult-feeatoms":       "Absolute fee of funded transaction in atoms",
 
 	// GetAccountAddressCmd help.
 	"getaccountaddress--synopsis": "DEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\n" +
@@ -139,28 +225,94 @@ var helpDescsEnUS = map[string]string{
 	"getaddressesbyaccount-account":   "Account name to fetch addresses for",
 	"getaddressesbyaccount--result0":  "All addresses controlled by 'account'",
 
+	// GetAttestationCmd help.
+	"getattestation--synopsis": "Returns the most recently recorded signed attestation of the wallet's state, allowing external monitors to detect wallet downtime and tampering of reported state.\n" +
+		"The signature is a DER-encoded ECDSA signature by the attestation public key over the BLAKE-256 hash of the message.\n" +
+		"Attestations are recorded periodically when the attestinterval option is set.",
+
+	// GetAttestationResult help.
+	"getattestationresult-sequence":     "Sequence number of the attestation, starting at zero",
+	"getattestationresult-time":         "Unix time the attestation was recorded",
+	"getattestationresult-tiphash":      "Hash of the wallet's main chain tip block",
+	"getattestationresult-tipheight":    "Height of the wallet's main chain tip block",
+	"getattestationresult-balanceshash": "Hash committing to the balances of every account",
+	"getattestationresult-ticketcount":  "Number of unspent tickets owned by the wallet",
+	"getattestationresult-prevhash":     "Hash of the previous attestation's message, or all zeros for the first attestation",
+	"getattestationresult-message":      "Hex-encoded serialized attestation message which is signed",
+	"getattestationresult-pubkey":       "Hex-encoded compressed public key of the attestation signing key",
+	"getattestationresult-signature":    "Hex-encoded signature of the attestation message",
+
+	// GetAuditLogCmd help.
+	"getauditlog--synopsis": "Returns recorded mutating requests from the RPC audit log, oldest first.",
+	"getauditlog-count":     "Maximum number of requests to return",
+	"getauditlog-from":      "Number of most recent requests to skip",
+
+	// AuditLogEntryResult help.
+	"auditlogentryresult-time":        "The Unix time at which the request completed",
+	"auditlogentryresult-method":      "The requested method",
+	"auditlogentryresult-params":      "The JSON encoding of each request parameter, with secret parameters redacted",
+	"auditlogentryresult-client":      "The network address of the client",
+	"auditlogentryresult-certificate": "The common name of the TLS client certificate, if used for authentication",
+	"auditlogentryresult-txids":       "Transaction hashes returned by the request",
+	"auditlogentryresult-error":       "The error returned by the request, if it failed",
+
 	// GetBalanceCmd help.
 	"getbalance--synopsis": "Calculates and returns the balance of all accounts.",
 	"getbalance-minconf":   "Minimum number of block confirmations required before an unspent output's value is included in the balance",
-	"getbalance-account":   "The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")",
-
-	"getbalanceresult-balances":                       "Balances for all accounts.",
-	"getaccountbalanceresult-accountname":             "Name of account.",
-	"getaccountbalanceresult-immaturecoinbaserewards": "Immature Coinbase reward coins.",
-	"getaccountbalanceresult-immaturestakegeneration": "Number of immature stake coins.",
-	"getaccountbalanceresult-lockedbytickets":         "Coins locked by tickets.",
-	"getaccountbalanceresult-spendable":               "Spendable number of coins.",
-	"getaccountbalanceresult-total":                   "Total amount of coins.",
-	"getaccountbalanceresult-unconfirmed":             "Unconfirmed number of coins.",
-	"getaccountbalanceresult-votingauthority":         "Coins for voting authority.",
-	"getbalanceresult-blockhash":                      "Block hash.",
-	"getbalanceresult-totalimmaturecoinbaserewards":   "Total number of immature coinbase reward coins.",
-	"getbalanceresult-totalimmaturestakegeneration":   "Total number of immature stake coins.",
-	"getbalanceresult-totallockedbytickets":           "Total number of coins locked by tickets.",
-	"getbalanceresult-totalspendable":                 "Total number of spendable number of coins.",
-	"getbalanceresult-cumulativetotal":                "Total number of coins.",
-	"getbalanceresult-totalunconfirmed":               "Total number of unconfirmed coins.",
-	"getbalanceresult-totalvotingauthority":           "Total number of coins for voting authority.",
+	"getbalance-account":   "The account name to query the balance for, \"parent/*\" to consider a parent account and all of its sub-accounts, or \"*\" to consider all accounts (default=\"*\")",
+
+	"getbalanceresult-balances":                            "Balances for all accounts.",
+	"getaccountbalanceresult-accountname":                  "Name of account.",
+	"getaccountbalanceresult-immaturecoinbaserewards":      "Immature Coinbase reward coins.",
+	"getaccountbalanceresult-immaturecoinbaserewardsatoms": "Immature coinbase reward atoms.",
+	"getaccountbalanceresult-immaturestakegeneration":      "Number of immature stake coins.",
+	"getaccountbalanceresult-immaturestakegenerationatoms": "Immature stake atoms.",
+	"getaccountbalanceresult-lockedbytickets":              "Coins locked by tickets.",
+	"getaccountbalanceresult-lockedbyticketsatoms":         "Atoms locked by tickets.",
+	"getaccountbalanceresult-spendable":                    "Spendable number of coins.",
+	"getaccountbalanceresult-spendableatoms":               "Spendable atoms.",
+	"getaccountbalanceresult-total":                        "Total amount of coins.",
+	"getaccountbalanceresult-totalatoms":                   "Total amount in atoms.",
+	"getaccountbalanceresult-unconfirmed":                  "Unconfirmed number of coins.",
+	"getaccountbalanceresult-unconfirmedatoms":             "Unconfirmed atoms.",
+	"getaccountbalanceresult-votingauthority":              "Coins for voting authority.",
+	"getaccountbalanceresult-votingauthorityatoms":         "Atoms for voting authority.",
+	"getaccountbalanceresult-effective":                    "Coins which can be spent right now: spendable coins, which exclude outputs spent by the wallet's own unmined transactions, plus unconfirmed change returned by those transactions.",
+	"getaccountbalanceresult-effectiveatoms":               "Effective balance in atoms.",
+	"getbalanceresult-blockhash":                           "Block hash.",
+	"getbalanceresult-totalimmaturecoinbaserewards":        "Total number of immature coinbase reward coins.",
+	"getbalanceresult-totalimmaturecoinbaserewardsatoms":   "Total immature coinbase reward atoms.",
+	"getbalanceresult-totalimmaturestakegeneration":        "Total number of immature stake coins.",
+	"getbalanceresult-totalimmaturestakegenerationatoms":   "Total immature stake atoms.",
+	"getbalanceresult-totallockedbytickets":                "Total number of coins locked by tickets.",
+	"getbalanceresult-totallockedbyticketsatoms":           "Total atoms locked by tickets.",
+	"getbalanceresult-totalspendable":                      "Total number of spendable number of coins.",
+	"getbalanceresult-totalspendableatoms":                 "Total spendable atoms.",
+	"getbalanceresult-cumulativetotal":                     "Total number of coins.",
+	"getbalanceresult-cumulativetotalatoms":                "Total amount in atoms.",
+	"getbalanceresult-totalunconfirmed":                    "Total number of unconfirmed coins.",
+	"getbalanceresult-totalunconfirmedatoms":               "Total unconfirmed atoms.",
+	"getbalanceresult-totalvotingauthority":                "Total number of coins for voting authority.",
+	"getbalanceresult-totalvotingauthorityatoms":           "Total atoms for voting authority.",
+	"getbalanceresult-totaleffective":                      "Total number of coins which can be spent right now.",
+	"getbalanceresult-totaleffectiveatoms":                 "Total effective balance in atoms.",
+
+	// GetBalanceHistoryCmd help.
+	"getbalancehistory--synopsis": "Returns the daily balance snapshots of the most recent days, oldest first.\n" +
+		"Snapshots are only recorded when the wallet is run with the balancesnapshots option, and days on which the wallet was not running have no snapshot.",
+	"getbalancehistory-days": "Number of most recent UTC days, including the current day, to return snapshots for",
+
+	// BalanceSnapshotResult help.
+	"balancesnapshotresult-date":                 "The UTC date of the snapshot (YYYY-MM-DD)",
+	"balancesnapshotresult-time":                 "The Unix time at which the snapshot was recorded",
+	"balancesnapshotresult-height":               "The main chain tip height when the snapshot was recorded",
+	"balancesnapshotresult-total":                "Total balance of all accounts",
+	"balancesnapshotresult-totalatoms":           "Total balance of all accounts in atoms",
+	"balancesnapshotresult-lockedbytickets":      "Balance locked by tickets",
+	"balancesnapshotresult-lockedbyticketsatoms": "Balance locked by tickets in atoms",
+	"balancesnapshotresult-ticketcount":          "Number of unspent tickets",
+	"balancesnapshotresult-rewards":              "Vote rewards earned since the previous snapshot",
+	"balancesnapshotresult-rewardsatoms":         "Vote rewards earned since the previous snapshot in atoms",
 
 	// GetBalanceToMaintainCmd help.
 	"getbalancetomaintain--synopsis": "Get the current balance to maintain",
@@ -344,6 +496,7 @@ var helpDescsEnUS = map[string]string{
 	"getmultisigoutinfo-hash":      "Input hash to check.",
 
 	"getmultisigoutinforesult-amount":       "Amount of coins contained.",
+	"getmultisigoutinforesult-amountatoms":  "Amount contained in atoms.",
 	"getmultisigoutinforesult-spentbyindex": "Index of spending tx.",
 	"getmultisigoutinforesult-spentby":      "Hash of spending tx.",
 	"getmultisigoutinforesult-spent":        "If it has been spent.",
@@ -392,26 +545,60 @@ var helpDescsEnUS = map[string]string{
 	"getreceivedbyaddress-minconf":   "Minimum number of block confirmations required before an output's value is included in the total",
 	"getreceivedbyaddress--result0":  "The total received amount valued in decred",
 
+	// GetStakeEarningsCmd help.
+	"getstakeearnings--synopsis": "Returns the lifetime stake earnings of the wallet and the earnings of each period in which tickets were purchased or votes were cast.\n" +
+		"Rewards are attributed to the period of the vote and fees to the period of the ticket purchase.\n" +
+		"Only tickets the wallet has voting authority for are included, and ticket fees are only known for tickets funded entirely by the wallet.",
+	"getstakeearnings-period": "Period length to group earnings by (\"day\", \"week\", \"month\", or \"year\"); periods begin at midnight UTC and weeks begin on Monday",
+
+	// GetStakeEarningsResult help.
+	"getstakeearningsresult-tickets":         "Number of tickets purchased",
+	"getstakeearningsresult-votes":           "Number of votes cast",
+	"getstakeearningsresult-rewards":         "Vote rewards earned, as the stakebase sum of each vote",
+	"getstakeearningsresult-rewardsatoms":    "Vote rewards earned in atoms",
+	"getstakeearningsresult-ticketfees":      "Transaction fees paid to purchase tickets",
+	"getstakeearningsresult-ticketfeesatoms": "Transaction fees paid to purchase tickets in atoms",
+	"getstakeearningsresult-poolfees":        "Fees paid to VSPs",
+	"getstakeearningsresult-poolfeesatoms":   "Fees paid to VSPs in atoms",
+	"getstakeearningsresult-net":             "Vote rewards less ticket and pool fees",
+	"getstakeearningsresult-netatoms":        "Vote rewards less ticket and pool fees in atoms",
+	"getstakeearningsresult-periods":         "Stake earnings of each period, oldest first",
+
+	// StakeEarningsResult help.
+	"stakeearningsresult-start":           "The UTC date the period begins (YYYY-MM-DD)",
+	"stakeearningsresult-tickets":         "Number of tickets purchased",
+	"stakeearningsresult-votes":           "Number of votes cast",
+	"stakeearningsresult-rewards":         "Vote rewards earned, as the stakebase sum of each vote",
+	"stakeearningsresult-rewardsatoms":    "Vote rewards earned in atoms",
+	"stakeearningsresult-ticketfees":      "Transaction fees paid to purchase tickets",
+	"stakeearningsresult-ticketfeesatoms": "Transaction fees paid to purchase tickets in atoms",
+	"stakeearningsresult-poolfees":        "Fees paid to VSPs",
+	"stakeearningsresult-poolfeesatoms":   "Fees paid to VSPs in atoms",
+	"stakeearningsresult-net":             "Vote rewards less ticket and pool fees",
+	"stakeearningsresult-netatoms":        "Vote rewards less ticket and pool fees in atoms",
+
 	// GetStakeInfo help.
 	"getstakeinfo--synopsis": "Returns statistics about staking from the wallet.",
 
 	// GetStakeInfoResult help.
-	"getstakeinforesult-blockheight":      "Current block height for stake info.",
-	"getstakeinforesult-poolsize":         "Number of live tickets in the ticket pool.",
-	"getstakeinforesult-difficulty":       "Current stake difficulty.",
-	"getstakeinforesult-allmempooltix":    "Number of tickets currently in the mempool",
-	"getstakeinforesult-ownmempooltix":    "Number of tickets submitted by this wallet currently in mempool",
-	"getstakeinforesult-immature":         "Number of tickets from this wallet that are in the blockchain but which are not yet mature",
-	"getstakeinforesult-live":             "Number of mature, active tickets owned by this wallet",
-	"getstakeinforesult-proportionlive":   "(Live / PoolSize)",
-	"getstakeinforesult-voted":            "Number of votes cast by this wallet",
-	"getstakeinforesult-totalsubsidy":     "Total amount of coins earned by proof-of-stake voting",
-	"getstakeinforesult-missed":           "Number of missed tickets (failure to vote, not including expired)",
-	"getstakeinforesult-proportionmissed": "(Missed / (Missed + Voted))",
-	"getstakeinforesult-revoked":          "Number of missed tickets that were missed and then revoked",
-	"getstakeinforesult-expired":          "Number of tickets that have expired",
-	"getstakeinforesult-unspent":          "Number of unspent tickets",
-	"getstakeinforesult-unspentexpired":   "Number of unspent tickets which are past expiry",
+	"getstakeinforesult-blockheight":       "Current block height for stake info.",
+	"getstakeinforesult-poolsize":          "Number of live tickets in the ticket pool.",
+	"getstakeinforesult-difficulty":        "Current stake difficulty.",
+	"getstakeinforesult-difficultyatoms":   "Current stake difficulty in atoms.",
+	"getstakeinforesult-allmempooltix":     "Number of tickets currently in the mempool",
+	"getstakeinforesult-ownmempooltix":     "Number of tickets submitted by this wallet currently in mempool",
+	"getstakeinforesult-immature":          "Number of tickets from this wallet that are in the blockchain but which are not yet mature",
+	"getstakeinforesult-live":              "Number of mature, active tickets owned by this wallet",
+	"getstakeinforesult-proportionlive":    "(Live / PoolSize)",
+	"getstakeinforesult-voted":             "Number of votes cast by this wallet",
+	"getstakeinforesult-totalsubsidy":      "Total amount of coins earned by proof-of-stake voting",
+	"getstakeinforesult-totalsubsidyatoms": "Total amount earned by proof-of-stake voting in atoms",
+	"getstakeinforesult-missed":            "Number of missed tickets (failure to vote, not including expired)",
+	"getstakeinforesult-proportionmissed":  "(Missed / (Missed + Voted))",
+	"getstakeinforesult-revoked":           "Number of missed tickets that were missed and then revoked",
+	"getstakeinforesult-expired":           "Number of tickets that have expired",
+	"getstakeinforesult-unspent":           "Number of unspent tickets",
+	"getstakeinforesult-unspentexpired":    "Number of unspent tickets which are past expiry",
 
 	// GetTicketMaxPrice help.
 	"getticketmaxprice--synopsis": "Returns the max price the wallet will pay for a ticket.",
@@ -434,13 +621,18 @@ var helpDescsEnUS = map[string]string{
 	"gettransactiondetailsresult-address":           "The address an output was paid to, or the empty string if the output is nonstandard or this detail is regarding a transaction input",
 	"gettransactiondetailsresult-category":          `The kind of detail: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, or "recv" for all other received outputs`,
 	"gettransactiondetailsresult-amount":            "The amount of a received output",
+	"gettransactiondetailsresult-amountatoms":       "The amount of a received output in atoms",
 	"gettransactiondetailsresult-fee":               "The included fee for a sent transaction",
+	"gettransactiondetailsresult-feeatoms":          "The included fee for a sent transaction in atoms",
 	"gettransactiondetailsresult-vout":              "The transaction output index",
 	"gettransactiondetailsresult-involveswatchonly": "Unset",
+	"gettransactiondetailsresult-label":             "The label recorded for the output, if any",
 
 	// GetTransactionResult help.
 	"gettransactionresult-amount":          "The total amount this transaction credits to the wallet, valued in decred",
+	"gettransactionresult-amountatoms":     "The total amount this transaction credits to the wallet in atoms",
 	"gettransactionresult-fee":             "The total input value minus the total output value, or 0 if 'txid' is not a sent transaction",
+	"gettransactionresult-feeatoms":        "The fee in atoms",
 	"gettransactionresult-confirmations":   "The number of block confirmations of the transaction",
 	"gettransactionresult-blockhash":       "The hash of the block this transaction is mined in, or the empty string if unmined",
 	"gettransactionresult-blockindex":      "Unset",
@@ -452,6 +644,7 @@ var helpDescsEnUS = map[string]string{
 	"gettransactionresult-details":         "Additional details for each recorded wallet credit and debit",
 	"gettransactionresult-hex":             "The transaction encoded as a hexadecimal string",
 	"gettransactionresult-type":            "The type of transaction (regular, ticket, vote, or revocation)",
+	"gettransactionresult-tree":            "The block tree the transaction is included in (0 for regular, 1 for stake)",
 	"gettransactionresult-ticketstatus":    "Status of ticket (if transaction is a ticket)",
 
 	// GetUnconfirmedBalanceCmd help.
@@ -459,6 +652,18 @@ var helpDescsEnUS = map[string]string{
 	"getunconfirmedbalance-account":   "The account to query the unconfirmed balance for (default=\"default\")",
 	"getunconfirmedbalance--result0":  "Total amount of all unmined unspent outputs of the account valued in decred.",
 
+	// GetUTXOStatsCmd help.
+	"getutxostats--synopsis": "Reports the fragmentation of the spendable unspent outputs of each account, to help decide when to consolidate them.",
+	"getutxostats-minconf":   "Minimum number of block confirmations of counted outputs",
+
+	// GetUTXOStatsResult help.
+	"getutxostatsresult-accountname":      "The name of the account",
+	"getutxostatsresult-count":            "Number of spendable unspent outputs",
+	"getutxostatsresult-dustcount":        "Number of outputs below the dust limit at the wallet's relay fee",
+	"getutxostatsresult-total":            "Total value of the outputs",
+	"getutxostatsresult-median":           "Median output value",
+	"getutxostatsresult-consolidationfee": "Fee at the wallet's relay fee of a single transaction consolidating every output of the account",
+
 	// GetVoteChoices help.
 	"getvotechoices--synopsis":  "Retrieve the currently configured default vote choices for the latest supported stake agendas",
 	"getvotechoices-tickethash": "The hash of the ticket to return vote choices for. If the ticket has no choices set, the default vote choices are returned",
@@ -498,6 +703,18 @@ var helpDescsEnUS = map[string]string{
 	"importcfiltersv2-startheight": "The starting block height for this list of cfilters",
 	"importcfiltersv2-filters":     "The list of hex-encoded cfilters",
 
+	// ImportLegacyKeysCmd help.
+	"importlegacykeys--synopsis": "Imports each private key of a legacy keypool wallet dump to the 'imported' account.\n" +
+		"Each line of the dump begins with a WIF-encoded private key, optionally followed by the RFC3339 timestamp of the key's creation.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"importlegacykeys-dump":   "The text dump of the legacy wallet's keys",
+	"importlegacykeys-rescan": "Rescan the blockchain from the earliest birthday of the newly imported keys",
+
+	// ImportLegacyKeysResult help.
+	"importlegacykeysresult-imported":   "The P2PKH addresses of each newly imported key",
+	"importlegacykeysresult-existing":   "The number of keys which were already imported",
+	"importlegacykeysresult-rescanfrom": "The block height the rescan begins at, or -1 if no keys were imported",
+
 	// ImportPrivKeyCmd help.
 	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account.",
 	"importprivkey-privkey":   "The WIF-encoded private key",
@@ -518,6 +735,12 @@ var helpDescsEnUS = map[string]string{
 	"importscript-rescan":    "Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
 	"importscript-scanfrom":  "Block number for where to start rescan from",
 
+	// ImportTicketCmd help.
+	"importticket--synopsis": "Imports the voting rights of a ticket exported by another wallet with exportticket.\n" +
+		"The voting keys are imported to the imported account and the ticket is recorded for voting.\n" +
+		"The wallet must be unlocked for this request to succeed.",
+	"importticket-export": "JSON object returned by exportticket",
+
 	// ImportXpub help.
 	"importxpub--synopsis": "Import a HD extended public key as a new account.",
 	"importxpub-name":      "Name of new account",
@@ -533,9 +756,12 @@ var helpDescsEnUS = map[string]string{
 	"inforesult-difficulty":      "The current target difficulty",
 	"inforesult-testnet":         "Whether or not server is using testnet",
 	"inforesult-relayfee":        "The minimum relay fee for non-free transactions in DCR/KB",
+	"inforesult-relayfeeatoms":   "The minimum relay fee for non-free transactions in atoms/KB",
 	"inforesult-errors":          "Any current errors",
 	"inforesult-paytxfee":        "The fee per kB of the serialized tx size used each time more fee is required for an authored transaction",
+	"inforesult-paytxfeeatoms":   "The fee per kB used for authored transactions in atoms",
 	"inforesult-balance":         "The balance of all accounts calculated with one block confirmation",
+	"inforesult-balanceatoms":    "The balance of all accounts in atoms",
 	"inforesult-walletversion":   "The version of the address manager database",
 	"inforesult-unlocked_until":  "Unset",
 	"inforesult-keypoolsize":     "Unset",
@@ -557,10 +783,29 @@ var helpDescsEnUS = map[string]string{
 	"listalltransactions--synopsis": "Returns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.",
 	"listalltransactions-account":   "Unused (must be unset or \"*\")",
 
+	// ListContactsCmd help.
+	"listcontacts--synopsis": "Returns a JSON array of objects describing each recorded address book contact.",
+
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 	"listlockunspent-account":   "If set, only returns outpoints from this account that are marked as locked",
 
+	// ListPaymentTemplatesCmd help.
+	"listpaymenttemplates--synopsis": "Returns a JSON array of objects describing each recorded payment template.",
+
+	// PaymentTemplateResult help.
+	"paymenttemplateresult-name":       "Name of the payment template",
+	"paymenttemplateresult-account":    "Account paid from",
+	"paymenttemplateresult-outputs":    "Outputs paid by the template",
+	"paymenttemplateresult-minconf":    "Minimum number of confirmations of spent outputs",
+	"paymenttemplateresult-interval":   "Number of blocks between automatic payments, or 0 when only paid on demand",
+	"paymenttemplateresult-nextheight": "Block height of the next automatic payment",
+
+	// PaymentTemplateOutput help.
+	"paymenttemplateoutput-address":     "The address paid",
+	"paymenttemplateoutput-amount":      "The amount paid in DCR",
+	"paymenttemplateoutput-amountatoms": "The amount paid in atoms",
+
 	// ListReceivedByAccountCmd help.
 	"listreceivedbyaccount--synopsis":        "Returns a JSON array of objects listing all accounts and the total amount received by each account.",
 	"listreceivedbyaccount-minconf":          "Minimum number of block confirmations required before a transaction is considered",
@@ -570,6 +815,7 @@ var helpDescsEnUS = map[string]string{
 	// ListReceivedByAccountResult help.
 	"listreceivedbyaccountresult-account":       "The name of the account",
 	"listreceivedbyaccountresult-amount":        "Total amount received by payment addresses of the account valued in decred",
+	"listreceivedbyaccountresult-amountatoms":   "Total amount received by payment addresses of the account in atoms",
 	"listreceivedbyaccountresult-confirmations": "Number of block confirmations of the most recent transaction relevant to the account",
 
 	// ListReceivedByAddressCmd help.
@@ -582,6 +828,7 @@ var helpDescsEnUS = map[string]string{
 	"listreceivedbyaddressresult-account":           "DEPRECATED -- Unset",
 	"listreceivedbyaddressresult-address":           "The payment address",
 	"listreceivedbyaddressresult-amount":            "Total amount received by the payment address valued in decred",
+	"listreceivedbyaddressresult-amountatoms":       "Total amount received by the payment address in atoms",
 	"listreceivedbyaddressresult-confirmations":     "Number of block confirmations of the most recent transaction relevant to the address",
 	"listreceivedbyaddressresult-txids":             "Transaction hashes of all transactions involving this address",
 	"listreceivedbyaddressresult-involvesWatchonly": "Unset",
@@ -610,9 +857,11 @@ var helpDescsEnUS = map[string]string{
 	// ListTransactionsResult help.
 	"listtransactionsresult-account":           "DEPRECATED -- Unset",
 	"listtransactionsresult-address":           "Payment address for a transaction output",
-	"listtransactionsresult-category":          `The kind of transaction: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, or "recv" for all other received outputs.  Note: A single output may be included multiple times under different categories`,
+	"listtransactionsresult-category":          `The kind of transaction: "send" for sent transactions, "immature" for immature coinbase outputs, "generate" for mature coinbase outputs, "ticket" for ticket purchase outputs, "vote" and "revocation" for mature vote and revocation outputs, "immaturestake" for immature vote and revocation outputs, or "receive" for all other received outputs.  Note: A single output may be included multiple times under different categories`,
 	"listtransactionsresult-amount":            "The value of the transaction output valued in decred",
+	"listtransactionsresult-amountatoms":       "The value of the transaction output in atoms",
 	"listtransactionsresult-fee":               "The total input value minus the total output value for sent transactions",
+	"listtransactionsresult-feeatoms":          "The fee for sent transactions in atoms",
 	"listtransactionsresult-confirmations":     "The number of block confirmations of the transaction",
 	"listtransactionsresult-generated":         "Whether the transaction output is a coinbase output",
 	"listtransactionsresult-blockhash":         "The hash of the block this transaction is mined in, or the empty string if unmined",
@@ -627,13 +876,15 @@ var helpDescsEnUS = map[string]string{
 	"listtransactionsresult-comment":           "Unset",
 	"listtransactionsresult-otheraccount":      "Unset",
 	"listtransactionsresult-txtype":            "The type of tx (regular tx, stake tx)",
+	"listtransactionsresult-tree":              "The block tree the transaction is included in (0 for regular, 1 for stake)",
+	"listtransactionsresult-maturityheight":    "The block height at which the output becomes spendable, or at which a ticket becomes live; omitted for unmined transactions and outputs without a maturity period",
 
 	// ListUnspentCmd help.
 	"listunspent--synopsis": "Returns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.",
 	"listunspent-minconf":   "Minimum number of block confirmations required before a transaction output is considered",
 	"listunspent-maxconf":   "Maximum number of block confirmations required before a transaction output is excluded",
 	"listunspent-addresses": "If set, limits the returned details to unspent outputs received by any of these payment addresses",
-	"listunspent-account":   "If set, only return unspent outputs from this account",
+	"listunspent-account":   "If set, only return unspent outputs from this account, or from a parent account and all of its sub-accounts when set to \"parent/*\"",
 
 	// ListUnspentResult help.
 	"listunspentresult-txid":          "The transaction hash of the referenced output",
@@ -643,11 +894,41 @@ var helpDescsEnUS = map[string]string{
 	"listunspentresult-scriptPubKey":  "The output script encoded as a hexadecimal string",
 	"listunspentresult-redeemScript":  "The redeemScript if scriptPubKey is P2SH",
 	"listunspentresult-amount":        "The amount of the output valued in decred",
+	"listunspentresult-amountatoms":   "The amount of the output in atoms",
 	"listunspentresult-confirmations": "The number of block confirmations of the transaction",
 	"listunspentresult-spendable":     "Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)",
 	"listunspentresult-txtype":        "The type of the transaction",
 	"listunspentresult-tree":          "The tree the transaction comes from",
 
+	// ListVSPTicketsCmd help.
+	"listvsptickets--synopsis": "Returns a JSON array of objects describing the tickets associated with each VSP, with counts of tickets by status.\n" +
+		"Tickets purchased without a VSP are not included.",
+
+	// ListVSPTicketsResult help.
+	"listvspticketsresult-host":     "Host of the VSP",
+	"listvspticketsresult-immature": "Number of unmined and immature tickets",
+	"listvspticketsresult-live":     "Number of live tickets",
+	"listvspticketsresult-voted":    "Number of voted tickets",
+	"listvspticketsresult-missed":   "Number of missed tickets",
+	"listvspticketsresult-expired":  "Number of expired tickets",
+	"listvspticketsresult-unspent":  "Number of mature unspent tickets which are not known to be live or missed (SPV mode only)",
+	"listvspticketsresult-tickets":  "Hashes of all tickets associated with the VSP",
+
+	// ListWalletEventsCmd help.
+	"listwalletevents--synopsis": "Returns recorded wallet events, oldest first.\n" +
+		"Events record network backend connections and disconnections, rescans, created votes, purchased tickets, handled reorganizations, and chain tip divergence alerts. " +
+		"Only the most recent 10000 events are retained.",
+	"listwalletevents-count": "Maximum number of events to return",
+	"listwalletevents-from":  "Number of most recent events to skip",
+
+	// WalletEventResult help.
+	"walleteventresult-seq":    "The sequence number of the event",
+	"walleteventresult-time":   "The Unix time at which the event was recorded",
+	"walleteventresult-kind":   "The kind of event (connected, disconnected, rescanstarted, rescanfinished, votecreated, ticketpurchased, reorg, or tipdivergence)",
+	"walleteventresult-height": "The block height the event relates to: the main chain tip height, rescan start or end height, voted block height, or reorganization fork height",
+	"walleteventresult-hash":   "The hash of the rescan start block, vote, ticket, or new main chain tip block, if any",
+	"walleteventresult-detail": "Additional details of the event",
+
 	// LockAccountCmd help.
 	"lockaccount--synopsis": "Lock an individually-encrypted account",
 	"lockaccount-account":   "Account to lock",
@@ -669,9 +950,31 @@ var helpDescsEnUS = map[string]string{
 	"mixoutput--synopsis": "Mix a specific output.",
 	"mixoutput-outpoint":  `Outpoint (in form "txhash:index") to mix`,
 
+	// PlanSweepCmd help.
+	"plansweep--synopsis": "Plans the transactions to sweep all spendable outputs of one or more accounts to an address, using as few transactions as the maximum transaction size allows.\n" +
+		"The plan is deterministic and is returned for confirmation without being signed or published; use executesweepplan to execute it.",
+	"plansweep-accounts": "The accounts to sweep",
+	"plansweep-address":  "The destination address",
+	"plansweep-minconf":  "Minimum number of block confirmations of swept outputs",
+	"plansweep-feerate":  "Fee rate in DCR/kB (default is the wallet's relay fee)",
+
+	// PlanSweepResult help.
+	"plansweepresult-planid":       "ID of the plan, committing to every planned transaction",
+	"plansweepresult-transactions": "The planned transactions",
+	"plansweepresult-totalinput":   "Total value of the swept outputs",
+	"plansweepresult-totalfee":     "Total fee of the planned transactions",
+	"plansweepresult-uneconomic":   "Number of outputs which are not swept because their value does not pay the fee to spend them",
+
+	// SweepPlanTransaction help.
+	"sweepplantransaction-txhash":     "The transaction hash",
+	"sweepplantransaction-hex":        "The unsigned transaction",
+	"sweepplantransaction-inputs":     "Number of transaction inputs",
+	"sweepplantransaction-totalinput": "Total value of the transaction inputs",
+	"sweepplantransaction-fee":        "Transaction fee",
+	"sweepplantransaction-amount":     "Value paid to the destination address",
+
 	// PurchaseTicketCmd help.
 	"purchaseticket--synopsis":          "Purchase ticket using available funds.",
-	"purchaseticket--result0":           "Hash of the resulting ticket",
 	"purchaseticket-spendlimit":         "Limit on the amount to spend on ticket",
 	"purchaseticket-fromaccount":        "The account to use for purchase (default=\"default\")",
 	"purchaseticket-minconf":            "Minimum number of block confirmations required",
@@ -680,6 +983,27 @@ var helpDescsEnUS = map[string]string{
 	"purchaseticket-nosplittransaction": "Use ticket purchase change outputs instead of a split transaction",
 	"purchaseticket-comment":            "Unused",
 	"purchaseticket-dontsigntx":         "Return unsigned split and ticket transactions instead of signing and publishing",
+	"purchaseticket-expiryblocks":       "Number of blocks in which the purchase may be mined before it expires, limited to the end of the current ticket price window (cannot be used with expiry)",
+	"purchaseticket-verbose":            "Return the fees and achieved fee rates of the published split transaction and tickets instead of the ticket hashes (ignored with dontsigntx)",
+	"purchaseticket-feerate":            "Fee rate paid by the split transaction and tickets (default is the wallet's relay fee)",
+	"purchaseticket--condition0":        "verbose=false",
+	"purchaseticket--condition1":        "verbose=true",
+	"purchaseticket--result0":           "Hash of the resulting ticket",
+
+	// PurchaseTicketVerboseResult help.
+	"purchaseticketverboseresult-splittx": "The split transaction funding the tickets, if one was published",
+	"purchaseticketverboseresult-tickets": "The published tickets",
+
+	// FeeRate help.
+	"feerate-rate": "Fee rate in the selected unit, which must be a whole number of atoms/kB",
+	"feerate-unit": "Fee rate unit, either \"atoms/kB\" or \"atoms/B\"",
+
+	// AuthoredTxResult help.
+	"authoredtxresult-txhash":              "The transaction hash",
+	"authoredtxresult-size":                "Serialized size of the transaction in bytes",
+	"authoredtxresult-fee":                 "Fee paid by the transaction",
+	"authoredtxresult-feerate":             "Achieved fee rate in atoms/kB",
+	"authoredtxresult-feerateatomsperbyte": "Achieved fee rate in atoms/B",
 
 	// ProcessUnmanagedTicket help.
 	"processunmanagedticket--synopsis":  "Processes tickets for vsp client based on ticket hash.",
@@ -702,24 +1026,76 @@ var helpDescsEnUS = map[string]string{
 	"redeemmultisigouts-toaddress":      "Address to look for (if not internal addresses).",
 	"redeemmultisigouts-fromscraddress": "Input script hash address.",
 
+	// ReloadConfigCmd help.
+	"reloadconfig--synopsis": "Reloads the configuration file and command line options without restarting.\n" +
+		"Changes to the debuglevel and txfee options, and to the ticketbuyer balancetomaintainabsolute, limit, skipfinalblocks, onlyfinalblocks, maxprice, minreturn, minconf, and nochangeinputs options, are applied to the running wallet.\n" +
+		"Changes to any other option require a restart.\n" +
+		"On Unix systems, the configuration is also reloaded when the process receives SIGHUP.",
+
+	// ReloadConfigResult help.
+	"reloadconfigresult-applied":         "Changed options which were applied",
+	"reloadconfigresult-restartrequired": "Changed options which only take effect after a restart",
+	"reloadconfigresult-failed":          "Changed options which could not be applied",
+
+	// ReloadConfigFailure help.
+	"reloadconfigfailure-option": "The option name",
+	"reloadconfigfailure-error":  "The reason the option could not be applied",
+
 	// RenameAccountCmd help.
 	"renameaccount--synopsis":  "Renames an account.",
 	"renameaccount-oldaccount": "The old account name to rename",
 	"renameaccount-newaccount": "The new name for the account",
 
 	// RescanWallet help.
-	"rescanwallet--synopsis":   "Rescan the block chain for wallet data, blocking until the rescan completes or exits with an error",
+	"rescanwallet--synopsis":   "Rescan the block chain for wallet data, blocking until the rescan completes or exits with an error. Websocket clients are sent rescanprogress notifications as the rescan proceeds and a rescanfinished notification when it completes",
 	"rescanwallet-beginheight": "The height of the first block to begin the rescan from",
 
+	// ResendTransactionCmd help.
+	"resendtransaction--synopsis": "Immediately republishes an unmined wallet transaction to the network rather than waiting for the periodic resend of all unmined transactions.\n" +
+		"The transaction is first checked for mempool acceptance: it must not be expired, double spend another transaction, or pay high fees.",
+	"resendtransaction-txhash": "Hash of the unmined transaction to resend",
+
+	// ResendTransactionResult help.
+	"resendtransactionresult-txhash":  "Hash of the resent transaction",
+	"resendtransactionresult-relayed": "Whether the transaction was accepted for relay by the network backend",
+	"resendtransactionresult-error":   "The reason the transaction was rejected for relay, if it was not relayed",
+
+	// SelfCheckCmd help.
+	"selfcheck--synopsis": "Re-derives the wallet's unspent outputs and mined balance by scanning every main chain block, and reports differences from the wallet's transaction store.\n" +
+		"The scan is performed in memory and the wallet is not modified.",
+
+	// SelfCheckResult help.
+	"selfcheckresult-scannedthrough":       "Height of the main chain tip block the check scanned through",
+	"selfcheckresult-expectedbalance":      "Mined balance re-derived from the blockchain, excluding ticket purchases",
+	"selfcheckresult-expectedbalanceatoms": "Re-derived mined balance in atoms",
+	"selfcheckresult-actualbalance":        "Mined balance recorded by the transaction store",
+	"selfcheckresult-actualbalanceatoms":   "Recorded mined balance in atoms",
+	"selfcheckresult-expectedunspent":      "Number of unspent outputs re-derived from the blockchain",
+	"selfcheckresult-actualunspent":        "Number of mined unspent outputs recorded by the transaction store",
+	"selfcheckresult-discrepancies":        "Differences between the re-derived state and the transaction store",
+
+	// SelfCheckDiscrepancy help.
+	"selfcheckdiscrepancy-bucket":        "Transaction store bucket the discrepancy was found in (unspent or balance)",
+	"selfcheckdiscrepancy-outpoint":      "The differing output, omitted for balance discrepancies",
+	"selfcheckdiscrepancy-expected":      "Amount re-derived from the blockchain",
+	"selfcheckdiscrepancy-expectedatoms": "Re-derived amount in atoms",
+	"selfcheckdiscrepancy-actual":        "Amount recorded by the transaction store",
+	"selfcheckdiscrepancy-actualatoms":   "Recorded amount in atoms",
+	"selfcheckdiscrepancy-reason":        "Description of the discrepancy",
+
 	// SendFromCmd help.
 	"sendfrom--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
 	"sendfrom-fromaccount": "Account to pick unspent outputs from",
-	"sendfrom-toaddress":   "Address to pay",
+	"sendfrom-toaddress":   "Address or address book contact name to pay",
 	"sendfrom-amount":      "Amount to send to the payment address valued in decred",
 	"sendfrom-minconf":     "Minimum number of block confirmations required before a transaction output is eligible to be spent",
 	"sendfrom-comment":     "Unused",
 	"sendfrom-commentto":   "Unused",
+	"sendfrom-verbose":     "Return the fee and achieved fee rate of the sent transaction instead of its hash",
+	"sendfrom-feerate":     "Fee rate paid by the transaction (default is the wallet's relay fee)",
+	"sendfrom--condition0": "verbose=false",
+	"sendfrom--condition1": "verbose=true",
 	"sendfrom--result0":    "The transaction hash of the sent transaction",
 
 	// SendFromTreasuryCmd help.
@@ -736,12 +1112,24 @@ var helpDescsEnUS = map[string]string{
 		"A change output is automatically included to send extra output value back to the original account.",
 	"sendmany-fromaccount":    "Account to pick unspent outputs from",
 	"sendmany-amounts":        "Pairs of payment addresses and the output amount to pay each",
-	"sendmany-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in decred to send to each address",
-	"sendmany-amounts--key":   "Address to pay",
+	"sendmany-amounts--desc":  "JSON object using payment addresses or address book contact names as keys and output amounts valued in decred to send to each address",
+	"sendmany-amounts--key":   "Address or address book contact name to pay",
 	"sendmany-amounts--value": "Amount to send to the payment address valued in decred",
 	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
 	"sendmany-comment":        "Unused",
+	"sendmany-labels":         "Labels recorded for the outputs paying to some addresses",
+	"sendmany-labels--desc":   "JSON object using payment addresses as keys and output labels as values",
+	"sendmany-labels--key":    "Address to label",
+	"sendmany-labels--value":  "Label of the output paying to the address",
+	"sendmany-split":          "Divide the outputs across multiple transactions when they can not be paid by a single transaction without exceeding the maximum transaction size",
+	"sendmany-verbose":        "Return the fees and achieved fee rates of the sent transactions instead of their hashes",
+	"sendmany-feerate":        "Fee rate paid by the transactions (default is the wallet's relay fee)",
+	"sendmany--condition0":    "split=false verbose=false",
+	"sendmany--condition1":    "split=true verbose=false",
+	"sendmany--condition2":    "split=false verbose=true",
+	"sendmany--condition3":    "split=true verbose=true",
 	"sendmany--result0":       "The transaction hash of the sent transaction",
+	"sendmany--result1":       "The transaction hashes of all sent transactions",
 
 	// SendRawTransactionCmd help.
 	"sendrawtransaction--synopsis":     "Submits the serialized, hex-encoded transaction to the local peer and relays it to the network.",
@@ -753,11 +1141,15 @@ var helpDescsEnUS = map[string]string{
 	"sendtoaddress--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"Unlike sendfrom, outputs are always chosen from the default account.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendtoaddress-address":   "Address to pay",
-	"sendtoaddress-amount":    "Amount to send to the payment address valued in decred",
-	"sendtoaddress-comment":   "Unused",
-	"sendtoaddress-commentto": "Unused",
-	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
+	"sendtoaddress-address":     "Address or address book contact name to pay",
+	"sendtoaddress-amount":      "Amount to send to the payment address valued in decred",
+	"sendtoaddress-comment":     "Unused",
+	"sendtoaddress-commentto":   "Unused",
+	"sendtoaddress-verbose":     "Return the fee and achieved fee rate of the sent transaction instead of its hash",
+	"sendtoaddress-feerate":     "Fee rate paid by the transaction (default is the wallet's relay fee)",
+	"sendtoaddress--condition0": "verbose=false",
+	"sendtoaddress--condition1": "verbose=true",
+	"sendtoaddress--result0":    "The transaction hash of the sent transaction",
 
 	// SendToMultisigCmd help.
 	"sendtomultisig--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a multisig address.\n" +
@@ -782,6 +1174,14 @@ var helpDescsEnUS = map[string]string{
 	"setaccountpassphrase-passphrase": "New passphrase to use.\n" +
 		"If this is the empty string, the account passphrase is removed and the account becomes encrypted by the global wallet passhprase.",
 
+	// SetAccountSpendPolicyCmd help.
+	"setaccountspendpolicy--synopsis": "Set the spend policy of an account.\n" +
+		"Outputs of hot accounts may be spent by any request or automated wallet process.\n" +
+		"Outputs of warm accounts may only be spent by manual RPC requests.\n" +
+		"Outputs of cold accounts are watch-only and may not be spent or signed for.",
+	"setaccountspendpolicy-account": "Account to modify",
+	"setaccountspendpolicy-policy":  "The spend policy (hot, warm, or cold)",
+
 	// SetBalanceToMaintainCmd help.
 	"setbalancetomaintain--synopsis": "Modify the balance for wallet to maintain for automatic ticket purchasing",
 	"setbalancetomaintain-balance":   "The new balance for wallet to maintain for automatic ticket purchasing",
@@ -819,7 +1219,7 @@ var helpDescsEnUS = map[string]string{
 	"settspendpolicy-ticket":    "Ticket hash to set a per-ticket tspend approval policy",
 
 	// SetTxFeeCmd help.
-	"settxfee--synopsis": "Modify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.",
+	"settxfee--synopsis": "Modify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.  Fees below the minimum relay fee of the chain server are rejected.",
 	"settxfee-amount":    "The new fee per kB of the serialized tx size valued in decred",
 	"settxfee--result0":  "The boolean 'true'",
 
@@ -829,6 +1229,19 @@ var helpDescsEnUS = map[string]string{
 	"setvotechoice-choiceid":   "The ID for the choice to choose",
 	"setvotechoice-tickethash": "The hash of the ticket to set choices for",
 
+	// SetVotingAccountCmd help.
+	"setvotingaccount--synopsis": "Adds an account to or removes an account from the voting domain.\n" +
+		"Voting account keys are encrypted by the voting domain key and remain unlocked when the wallet is locked.\n" +
+		"Adding an account requires both the voting domain and the account keys to be unlocked.",
+	"setvotingaccount-account": "Account to modify",
+	"setvotingaccount-voting":  "Add the account to the voting domain, or remove it when false",
+
+	// SetVotingPassphraseCmd help.
+	"setvotingpassphrase--synopsis": "Sets the voting passphrase protecting the voting domain key, creating the key if no voting passphrase is set.\n" +
+		"Changing an existing voting passphrase requires the voting domain to be unlocked.\n" +
+		"The voting domain is unlocked after the passphrase is set.",
+	"setvotingpassphrase-passphrase": "New voting passphrase",
+
 	// SignMessageCmd help.
 	"signmessage--synopsis": "Signs a message using the private key of a payment address.",
 	"signmessage-address":   "Payment address of private key used to sign the message with",
@@ -866,6 +1279,34 @@ var helpDescsEnUS = map[string]string{
 	"signedtransaction-sent":            "Tells if the transaction was sent.",
 	"signedtransaction-signingresult":   "Success or failure of signing.",
 
+	// SimnetAdvanceCmd help.
+	"simnetadvance--synopsis": "Mines blocks with the CPU miner of the consensus server, advancing the chain height and block time, and waits for the wallet to connect them.\n" +
+		"Only available on simnet with dcrd RPC synchronization, and requires dcrd to be configured with a mining address.",
+	"simnetadvance-numblocks": "Number of blocks to mine",
+	"simnetadvance--result0":  "Hashes of the mined blocks",
+
+	// SimnetFundAccountCmd help.
+	"simnetfundaccount--synopsis": "Sends funds from another account to a new address of an account and mines a block to confirm the payment.\n" +
+		"Only available on simnet with dcrd RPC synchronization, and requires dcrd to be configured with a mining address.",
+	"simnetfundaccount-account":     "Account to fund",
+	"simnetfundaccount-amount":      "Amount to send",
+	"simnetfundaccount-fromaccount": "Account to pick unspent outputs from",
+
+	// SimnetFundAccountResult help.
+	"simnetfundaccountresult-txhash":    "Hash of the funding transaction",
+	"simnetfundaccountresult-blockhash": "Hash of the block mined to confirm the funding transaction",
+
+	// SimnetReorgCmd help.
+	"simnetreorg--synopsis": "Reorganizes the most recent blocks of the main chain by invalidating the block depth blocks below the next block with the consensus server and mining a longer replacement chain.\n" +
+		"The invalidated blocks are not reconsidered.\n" +
+		"Only available on simnet with dcrd RPC synchronization, and requires dcrd to be configured with a mining address.",
+	"simnetreorg-depth": "Number of main chain blocks to reorganize",
+
+	// SimnetReorgResult help.
+	"simnetreorgresult-oldtip": "Hash of the main chain tip before the reorganization",
+	"simnetreorgresult-newtip": "Hash of the main chain tip after the reorganization",
+	"simnetreorgresult-height": "Height of the main chain tip after the reorganization",
+
 	// SpendOutputsCmd help.
 	"spendoutputs--synopsis": "Create, sign, and publish a transaction spending the specified wallet outputs, and paying an array of address/amount pairs.\n" +
 		"Outputs must belong to the specified account, and change (if needed) is returned to an internal address of the same account.",
@@ -884,25 +1325,33 @@ var helpDescsEnUS = map[string]string{
 	"sweepaccount-feeperkb":              "The minimum relay fee policy (optional).",
 
 	// SweepAccountResult help.
-	"sweepaccountresult-unsignedtransaction":       "The hex encoded string of the unsigned transaction.",
-	"sweepaccountresult-totalpreviousoutputamount": "The total transaction input amount.",
-	"sweepaccountresult-totaloutputamount":         "The total transaction output amount.",
-	"sweepaccountresult-estimatedsignedsize":       "The estimated size of the transaction when signed.",
+	"sweepaccountresult-unsignedtransaction":            "The hex encoded string of the unsigned transaction.",
+	"sweepaccountresult-totalpreviousoutputamount":      "The total transaction input amount.",
+	"sweepaccountresult-totalpreviousoutputamountatoms": "The total transaction input amount in atoms.",
+	"sweepaccountresult-totaloutputamount":              "The total transaction output amount.",
+	"sweepaccountresult-totaloutputamountatoms":         "The total transaction output amount in atoms.",
+	"sweepaccountresult-estimatedsignedsize":            "The estimated size of the transaction when signed.",
 
 	// TicketInfoCmd help.
-	"ticketinfo--synopsis":           "Returns details of each wallet ticket transaction",
-	"ticketinfo-startheight":         "Specify the starting block height to scan from",
-	"ticketinfo--result0":            "Array of objects describing each ticket",
-	"ticketinforesult-hash":          "Transaction hash of the ticket",
-	"ticketinforesult-cost":          "Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase",
-	"ticketinforesult-votingaddress": "Address of 0th output, which describes the requirements to spend the ticket",
-	"ticketinforesult-status":        "Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)",
-	"ticketinforesult-blockhash":     "Hash of block ticket is mined in",
-	"ticketinforesult-blockheight":   "Height of block ticket is mined in",
-	"ticketinforesult-vote":          "Transaction hash of vote which spends the ticket",
-	"ticketinforesult-revocation":    "Transaction hash of revocation which spends the ticket",
-	"ticketinforesult-choices":       "Vote preferences set for the ticket",
-	"ticketinforesult-vsphost":       "VSP Host associated with the ticket (if any)",
+	"ticketinfo--synopsis":                 "Returns details of each wallet ticket transaction",
+	"ticketinfo-startheight":               "Specify the starting block height to scan from",
+	"ticketinfo--result0":                  "Array of objects describing each ticket",
+	"ticketinforesult-hash":                "Transaction hash of the ticket",
+	"ticketinforesult-cost":                "Amount paid to purchase the ticket; this may be greater than the ticket price at time of purchase",
+	"ticketinforesult-costatoms":           "Amount paid to purchase the ticket in atoms",
+	"ticketinforesult-votingaddress":       "Address of 0th output, which describes the requirements to spend the ticket",
+	"ticketinforesult-status":              "Description of ticket status (unknown, unmined, immature, mature, live, voted, missed, expired, unspent, revoked)",
+	"ticketinforesult-blockhash":           "Hash of block ticket is mined in",
+	"ticketinforesult-blockheight":         "Height of block ticket is mined in",
+	"ticketinforesult-vote":                "Transaction hash of vote which spends the ticket",
+	"ticketinforesult-revocation":          "Transaction hash of revocation which spends the ticket",
+	"ticketinforesult-choices":             "Vote preferences set for the ticket",
+	"ticketinforesult-vsphost":             "VSP Host associated with the ticket (if any)",
+	"ticketinforesult-vspfeehash":          "Transaction hash of the fee paid to the VSP (if any)",
+	"ticketinforesult-vspfee":              "Amount paid to the VSP",
+	"ticketinforesult-vspfeeatoms":         "Amount paid to the VSP in atoms",
+	"ticketinforesult-vspfeestatus":        "Status of the VSP fee payment (started, paid, errored, confirmed)",
+	"ticketinforesult-vspfeeconfirmations": "Number of block confirmations of the VSP fee transaction",
 
 	// TransactionInput help.
 	"transactioninput-amount": "The previous output amount",
@@ -941,6 +1390,11 @@ var helpDescsEnUS = map[string]string{
 	"unlockaccount-account":    "Account to unlock",
 	"unlockaccount-passphrase": "Account passphrase",
 
+	// UpdateContactCmd help.
+	"updatecontact--synopsis": "Changes the payment address of a recorded address book contact.",
+	"updatecontact-name":      "Name of the contact",
+	"updatecontact-address":   "New payment address of the contact, which must be valid for the wallet's network",
+
 	// ValidateAddressCmd help.
 	"validateaddress--synopsis": "Verify that an address is valid.\n" +
 		"Extra details are returned if the address is controlled by this wallet.\n" +
@@ -973,6 +1427,18 @@ var helpDescsEnUS = map[string]string{
 	"validatepredcp0005cf--synopsis": "Validate whether all stored cfilters from before DCP0005 activation are correct according to the expected hardcoded hash",
 	"validatepredcp0005cf--result0":  "Whether the cfilters are valid",
 
+	// VerifyExternalAddressCmd help.
+	"verifyexternaladdress--synopsis": "Asks the configured external signing device to derive and display a wallet address, returning whether the device confirmed the address.\n" +
+		"The address is first rederived from the account extended public key to detect tampered receive addresses.",
+	"verifyexternaladdress-address": "The wallet address to verify",
+
+	// VerifyExternalAddressResult help.
+	"verifyexternaladdressresult-address":   "The verified address",
+	"verifyexternaladdressresult-account":   "The account number of the address",
+	"verifyexternaladdressresult-branch":    "The BIP0044 branch of the address",
+	"verifyexternaladdressresult-index":     "The BIP0044 child index of the address",
+	"verifyexternaladdressresult-confirmed": "Whether the external signer confirmed the address",
+
 	// VerifyMessageCmd help.
 	"verifymessage--synopsis": "Verify a message was signed with the associated private key of some address.",
 	"verifymessage-address":   "Address used to sign message",
@@ -993,20 +1459,41 @@ var helpDescsEnUS = map[string]string{
 	"votechoice-choicedescription": "A description of the current choice for this agenda",
 
 	// WalletInfoCmd help.
-	"walletinfo--synopsis":              "Returns global information about the wallet",
-	"walletinforesult-daemonconnected":  "Whether or not the wallet is currently connected to the daemon RPC",
-	"walletinforesult-spv":              "Whether or not wallet is syncing in SPV mode",
-	"walletinforesult-unlocked":         "Whether or not the wallet is unlocked",
-	"walletinforesult-cointype":         "Active coin type. Not available for watching-only wallets.",
-	"walletinforesult-txfee":            "Transaction fee per kB of the serialized tx size in coins",
-	"walletinforesult-votebits":         "Vote bits setting",
-	"walletinforesult-votebitsextended": "Extended vote bits setting",
-	"walletinforesult-voteversion":      "Version of votes that will be generated",
-	"walletinforesult-voting":           "Whether or not the wallet is currently voting tickets",
-	"walletinforesult-vsp":              "VSP URL used when purchasing tickets",
-	"walletinforesult-manualtickets":    "Whether or not the wallet is only accepting tickets manually",
-	"walletinforesult-birthhash":        "The wallet birth hash.",
-	"walletinforesult-birthheight":      "The wallet birth height.",
+	"walletinfo--synopsis":                 "Returns global information about the wallet",
+	"walletinforesult-daemonconnected":     "Whether or not the wallet is currently connected to the daemon RPC",
+	"walletinforesult-spv":                 "Whether or not wallet is syncing in SPV mode",
+	"walletinforesult-unlocked":            "Whether or not the wallet is unlocked",
+	"walletinforesult-cointype":            "Active coin type. Not available for watching-only wallets.",
+	"walletinforesult-txfee":               "Transaction fee per kB of the serialized tx size in coins",
+	"walletinforesult-txfeeatoms":          "Transaction fee per kB of the serialized tx size in atoms",
+	"walletinforesult-votebits":            "Vote bits setting",
+	"walletinforesult-votebitsextended":    "Extended vote bits setting",
+	"walletinforesult-voteversion":         "Version of votes that will be generated",
+	"walletinforesult-networkstakeversion": "Stake version of the main chain tip block",
+	"walletinforesult-voteversionoutdated": "Whether the network has upgraded to a newer stake version than the wallet supports, causing its votes to abstain on newer agendas",
+	"walletinforesult-voting":              "Whether or not the wallet is currently voting tickets",
+	"walletinforesult-vsp":                 "VSP URL used when purchasing tickets",
+	"walletinforesult-manualtickets":       "Whether or not the wallet is only accepting tickets manually",
+	"walletinforesult-birthhash":           "The wallet birth hash.",
+	"walletinforesult-birthheight":         "The wallet birth height.",
+	"walletinforesult-tipchecks":           "The most recent cross-checks of the main chain tip against each configured tip source, omitted when no sources are configured",
+	"walletinforesult-subsystems":          "The status of each long-running subsystem run by the wallet process",
+
+	// TipCheckResult help.
+	"tipcheckresult-source":       "The tip source",
+	"tipcheckresult-time":         "The Unix time of the check",
+	"tipcheckresult-height":       "The best block height reported by the source",
+	"tipcheckresult-walletheight": "The main chain tip height of the wallet",
+	"tipcheckresult-diverged":     "Whether the heights differ by more than the allowed number of blocks",
+	"tipcheckresult-error":        "The error querying the source, if any",
+
+	// SubsystemResult help.
+	"subsystemresult-name":          "The name of the subsystem",
+	"subsystemresult-state":         "The state of the subsystem (running, restarting, stopped, or failed)",
+	"subsystemresult-started":       "The Unix time the current or most recent run of the subsystem started",
+	"subsystemresult-restarts":      "The number of times the subsystem was restarted after a failure",
+	"subsystemresult-lasterror":     "The most recent failure of the subsystem, if any",
+	"subsystemresult-lasterrortime": "The Unix time of the most recent failure, if any",
 
 	// WalletIsLockedCmd help.
 	"walletislocked--synopsis": "Returns whether or not the wallet is locked.",
@@ -1024,6 +1511,7 @@ var helpDescsEnUS = map[string]string{
 	"walletpassphrase--synopsis":  "Unlock the wallet.",
 	"walletpassphrase-passphrase": "The wallet passphrase",
 	"walletpassphrase-timeout":    "The number of seconds to wait before the wallet automatically locks. 0 leaves the wallet unlocked indefinitely.",
+	"walletpassphrase-session":    "Bind the unlock to the websocket connection of this request. Other clients see the wallet as locked and cannot use private keys, and the wallet is locked when the connection closes.",
 
 	// WalletPubPassPhraseChangeCmd help
 	"walletpubpassphrasechange--synopsis":     "Change the wallet's public passphrase.",
@@ -29,24 +29,37 @@ var Methods = []struct {
 }{
 	{"abandontransaction", nil},
 	{"accountaddressindex", []any{(*int)(nil)}},
+	{"accountspendpolicy", returnsString},
 	{"accountsyncaddressindex", nil},
 	{"accountunlocked", []any{(*types.AccountUnlockedResult)(nil)}},
+	{"addcontact", nil},
 	{"addmultisigaddress", returnsString},
 	{"addtransaction", nil},
 	{"auditreuse", []any{(*map[string][]string)(nil)}},
 	{"consolidate", returnsString},
 	{"createmultisig", []any{(*types.CreateMultiSigResult)(nil)}},
 	{"createnewaccount", nil},
+	{"createpaymenttemplate", nil},
 	{"createrawtransaction", returnsString},
 	{"createsignature", []any{(*types.CreateSignatureResult)(nil)}},
+	{"deletecontact", nil},
+	{"deletepaymenttemplate", nil},
 	{"disapprovepercent", []any{(*uint32)(nil)}},
 	{"discoverusage", nil},
+	{"droptransactionhistory", []any{(*types.DropTransactionHistoryResult)(nil)}},
 	{"dumpprivkey", returnsString},
+	{"dumpwallet", []any{(*types.DumpWalletResult)(nil)}},
+	{"executesweepplan", returnsStringArray},
+	{"executetemplate", returnsString},
+	{"exportticket", []any{(*types.ExportTicketResult)(nil)}},
 	{"fundrawtransaction", []any{(*types.FundRawTransactionResult)(nil)}},
 	{"getaccount", returnsString},
 	{"getaccountaddress", returnsString},
 	{"getaddressesbyaccount", returnsStringArray},
+	{"getattestation", []any{(*types.GetAttestationResult)(nil)}},
+	{"getauditlog", []any{(*[]types.AuditLogEntryResult)(nil)}},
 	{"getbalance", []any{(*types.GetBalanceResult)(nil)}},
+	{"getbalancehistory", []any{(*[]types.BalanceSnapshotResult)(nil)}},
 	{"getbestblock", []any{(*dcrdtypes.GetBestBlockResult)(nil)}},
 	{"getbestblockhash", returnsString},
 	{"getblockcount", returnsNumber},
@@ -63,55 +76,73 @@ var Methods = []struct {
 	{"getrawchangeaddress", returnsString},
 	{"getreceivedbyaccount", returnsNumber},
 	{"getreceivedbyaddress", returnsNumber},
+	{"getstakeearnings", []any{(*types.GetStakeEarningsResult)(nil)}},
 	{"getstakeinfo", []any{(*types.GetStakeInfoResult)(nil)}},
 	{"gettickets", []any{(*types.GetTicketsResult)(nil)}},
 	{"gettransaction", []any{(*types.GetTransactionResult)(nil)}},
 	{"gettxout", []any{(*dcrdtypes.GetTxOutResult)(nil)}},
 	{"getunconfirmedbalance", returnsNumber},
+	{"getutxostats", []any{(*[]types.GetUTXOStatsResult)(nil)}},
 	{"getvotechoices", []any{(*types.GetVoteChoicesResult)(nil)}},
 	{"getwalletfee", returnsNumber},
 	{"getcfilterv2", []any{(*types.GetCFilterV2Result)(nil)}},
 	{"help", append(returnsString, returnsString[0])},
 	{"importcfiltersv2", nil},
+	{"importlegacykeys", []any{(*types.ImportLegacyKeysResult)(nil)}},
 	{"importprivkey", nil},
 	{"importpubkey", nil},
 	{"importscript", nil},
+	{"importticket", nil},
 	{"importxpub", nil},
 	{"listaccounts", []any{(*map[string]float64)(nil)}},
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
+	{"listcontacts", []any{(*[]types.ContactResult)(nil)}},
 	{"listlockunspent", []any{(*[]dcrdtypes.TransactionInput)(nil)}},
+	{"listpaymenttemplates", []any{(*[]types.PaymentTemplateResult)(nil)}},
 	{"listreceivedbyaccount", []any{(*[]types.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []any{(*[]types.ListReceivedByAddressResult)(nil)}},
 	{"listsinceblock", []any{(*types.ListSinceBlockResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
 	{"listunspent", []any{(*types.ListUnspentResult)(nil)}},
+	{"listvsptickets", []any{(*[]types.ListVSPTicketsResult)(nil)}},
+	{"listwalletevents", []any{(*[]types.WalletEventResult)(nil)}},
 	{"lockaccount", nil},
 	{"lockunspent", returnsBool},
 	{"mixaccount", nil},
 	{"mixoutput", nil},
 	{"processunmanagedticket", nil},
-	{"purchaseticket", returnsString},
+	{"plansweep", []any{(*types.PlanSweepResult)(nil)}},
+	{"purchaseticket", []any{(*string)(nil), (*types.PurchaseTicketVerboseResult)(nil)}},
 	{"redeemmultisigout", []any{(*types.RedeemMultiSigOutResult)(nil)}},
 	{"redeemmultisigouts", []any{(*types.RedeemMultiSigOutResult)(nil)}},
+	{"reloadconfig", []any{(*types.ReloadConfigResult)(nil)}},
 	{"renameaccount", nil},
 	{"rescanwallet", nil},
-	{"sendfrom", returnsString},
+	{"resendtransaction", []any{(*types.ResendTransactionResult)(nil)}},
+	{"selfcheck", []any{(*types.SelfCheckResult)(nil)}},
+	{"sendfrom", []any{(*string)(nil), (*types.AuthoredTxResult)(nil)}},
 	{"sendfromtreasury", returnsString},
-	{"sendmany", returnsString},
+	{"sendmany", []any{(*string)(nil), (*[]string)(nil), (*types.AuthoredTxResult)(nil), (*[]types.AuthoredTxResult)(nil)}},
 	{"sendrawtransaction", returnsString},
-	{"sendtoaddress", returnsString},
+	{"sendtoaddress", []any{(*string)(nil), (*types.AuthoredTxResult)(nil)}},
 	{"sendtomultisig", returnsString},
 	{"sendtotreasury", returnsString},
 	{"setaccountpassphrase", nil},
+	{"setaccountspendpolicy", nil},
 	{"setdisapprovepercent", nil},
 	{"settreasurypolicy", nil},
 	{"settspendpolicy", nil},
 	{"settxfee", returnsBool},
 	{"setvotechoice", nil},
+	{"setvotingaccount", nil},
+	{"setvotingpassphrase", nil},
 	{"signmessage", returnsString},
 	{"signrawtransaction", []any{(*types.SignRawTransactionResult)(nil)}},
 	{"signrawtransactions", []any{(*types.SignRawTransactionsResult)(nil)}},
+	{"simnetadvance", returnsStringArray},
+	{"simnetfundaccount", []any{(*types.SimnetFundAccountResult)(nil)}},
+	{"simnetreorg", []any{(*types.SimnetReorgResult)(nil)}},
 	{"spendoutputs", returnsString},
 	{"sweepaccount", []any{(*types.SweepAccountResult)(nil)}},
 	{"syncstatus", []any{(*types.SyncStatusResult)(nil)}},
@@ -119,8 +150,10 @@ var Methods = []struct {
 	{"treasurypolicy", []any{(*[]types.TreasuryPolicyResult)(nil), (*types.TreasuryPolicyResult)(nil)}},
 	{"tspendpolicy", []any{(*[]types.TSpendPolicyResult)(nil), (*types.TSpendPolicyResult)(nil)}},
 	{"unlockaccount", nil},
+	{"updatecontact", nil},
 	{"validateaddress", []any{(*types.ValidateAddressWalletResult)(nil)}},
 	{"validatepredcp0005cf", returnsBool},
+	{"verifyexternaladdress", []any{(*types.VerifyExternalAddressResult)(nil)}},
 	{"verifymessage", returnsBool},
 	{"version", []any{(*map[string]dcrdtypes.VersionResult)(nil)}},
 	{"walletinfo", []any{(*types.WalletInfoResult)(nil)}},
@@ -0,0 +1,231 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package supervisor runs the long-running subsystems of the wallet process,
+// recovering them from panics and restarting them with backoff after
+// failures.
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Restart describes when an ended subsystem is restarted.
+type Restart uint8
+
+// Restart policies.
+const (
+	// RestartOnFailure restarts a subsystem after it panics or returns a
+	// non-nil error.
+	RestartOnFailure Restart = iota
+
+	// RestartOnPanic restarts a subsystem only after it panics.  A returned
+	// error stops the subsystem.
+	RestartOnPanic
+
+	// RestartNever never restarts a subsystem.  Panics are still recovered
+	// and logged.
+	RestartNever
+)
+
+// Default backoff bounds.
+const (
+	DefaultMinBackoff = time.Second
+	DefaultMaxBackoff = 5 * time.Minute
+)
+
+// Policy describes how a subsystem is restarted.
+type Policy struct {
+	Restart Restart
+
+	// MinBackoff and MaxBackoff bound the delay before each restart.  The
+	// delay doubles after each consecutive failure, and is reset once the
+	// subsystem runs for longer than MaxBackoff without failing.  Zero
+	// values use DefaultMinBackoff and DefaultMaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// State describes whether a subsystem is running.
+type State uint8
+
+// Subsystem states.
+const (
+	// Running subsystems are currently executing.
+	Running State = iota
+
+	// Restarting subsystems failed and are waiting out their backoff
+	// before running again.
+	Restarting
+
+	// Stopped subsystems returned without error or were canceled.
+	Stopped
+
+	// Failed subsystems ended with a failure and, by their policy, will
+	// not be restarted.
+	Failed
+)
+
+// String returns the lowercase name of the state.
+func (s State) String() string {
+	switch s {
+	case Running:
+		return "running"
+	case Restarting:
+		return "restarting"
+	case Stopped:
+		return "stopped"
+	case Failed:
+		return "failed"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// PanicError records a panic recovered from a subsystem.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error implements the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Status describes a supervised subsystem.
+type Status struct {
+	Name          string
+	State         State
+	Started       time.Time // Start of the current or most recent run
+	Restarts      uint32
+	LastError     error // Most recent failure, or nil
+	LastErrorTime time.Time
+}
+
+// Supervisor runs subsystems and tracks their status.  The zero value is not
+// valid; use New.
+type Supervisor struct {
+	mu         sync.Mutex
+	subsystems []*Status
+}
+
+// New returns a new Supervisor.
+func New() *Supervisor {
+	return &Supervisor{}
+}
+
+// Status returns the status of every subsystem started by the supervisor, in
+// the order they were started.
+func (s *Supervisor) Status() []Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	status := make([]Status, len(s.subsystems))
+	for i, sub := range s.subsystems {
+		status[i] = *sub
+	}
+	return status
+}
+
+func (s *Supervisor) update(sub *Status, f func(*Status)) {
+	s.mu.Lock()
+	f(sub)
+	s.mu.Unlock()
+}
+
+// Go runs fn as the named subsystem in a new goroutine.  Panics are recovered
+// and logged with their stack, and fn is restarted after panics and errors as
+// described by the policy until ctx is canceled.  The returned channel is
+// closed after the subsystem has stopped and will not be restarted.
+func (s *Supervisor) Go(ctx context.Context, name string, policy Policy,
+	fn func(ctx context.Context) error) <-chan struct{} {
+
+	sub := &Status{Name: name, State: Running, Started: time.Now()}
+	s.mu.Lock()
+	s.subsystems = append(s.subsystems, sub)
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.run(ctx, sub, policy, fn)
+	}()
+	return done
+}
+
+func (s *Supervisor) run(ctx context.Context, sub *Status, policy Policy,
+	fn func(ctx context.Context) error) {
+
+	minBackoff, maxBackoff := policy.MinBackoff, policy.MaxBackoff
+	if minBackoff <= 0 {
+		minBackoff = DefaultMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultMaxBackoff
+	}
+	if maxBackoff < minBackoff {
+		maxBackoff = minBackoff
+	}
+	backoff := minBackoff
+
+	for {
+		start := time.Now()
+		s.update(sub, func(st *Status) {
+			st.State = Running
+			st.Started = start
+		})
+
+		err := call(ctx, sub.Name, fn)
+		if err == nil || ctx.Err() != nil {
+			s.update(sub, func(st *Status) { st.State = Stopped })
+			return
+		}
+		_, panicked := err.(*PanicError)
+		s.update(sub, func(st *Status) {
+			st.LastError = err
+			st.LastErrorTime = time.Now()
+		})
+
+		restart := policy.Restart == RestartOnFailure ||
+			(policy.Restart == RestartOnPanic && panicked)
+		if !restart {
+			log.Errorf("%s ended: %v", sub.Name, err)
+			s.update(sub, func(st *Status) { st.State = Failed })
+			return
+		}
+
+		if time.Since(start) > maxBackoff {
+			backoff = minBackoff
+		}
+		log.Errorf("%s ended: %v (restarting in %v)", sub.Name, err, backoff)
+		s.update(sub, func(st *Status) { st.State = Restarting })
+		select {
+		case <-ctx.Done():
+			s.update(sub, func(st *Status) { st.State = Stopped })
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		s.update(sub, func(st *Status) { st.Restarts++ })
+	}
+}
+
+// call runs fn, converting a panic into a *PanicError.
+func call(ctx context.Context, name string, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			stack := debug.Stack()
+			log.Criticalf("%s panicked: %v\n%s", name, r, stack)
+			err = &PanicError{Value: r, Stack: stack}
+		}
+	}()
+	return fn(ctx)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSupervisor(t *testing.T) {
+	t.Parallel()
+
+	errFail := errors.New("fail")
+	fast := func(r Restart) Policy {
+		return Policy{Restart: r, MinBackoff: time.Millisecond, MaxBackoff: 4 * time.Millisecond}
+	}
+	// failing returns a subsystem which fails the first n runs, panicking
+	// when panics is set, and then returns without error.
+	failing := func(n int, panics bool) func(context.Context) error {
+		runs := 0
+		return func(context.Context) error {
+			runs++
+			if runs > n {
+				return nil
+			}
+			if panics {
+				panic("boom")
+			}
+			return errFail
+		}
+	}
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		fn       func(context.Context) error
+		state    State
+		restarts uint32
+		panicked bool
+	}{
+		{"ok", fast(RestartOnFailure), failing(0, false), Stopped, 0, false},
+		{"error restarted", fast(RestartOnFailure), failing(3, false), Stopped, 3, false},
+		{"panic restarted", fast(RestartOnFailure), failing(2, true), Stopped, 2, true},
+		{"error not restarted on panic policy", fast(RestartOnPanic), failing(1, false), Failed, 0, false},
+		{"panic restarted on panic policy", fast(RestartOnPanic), failing(1, true), Stopped, 1, true},
+		{"never restarted", fast(RestartNever), failing(1, true), Failed, 0, true},
+	}
+	for _, test := range tests {
+		s := New()
+		<-s.Go(context.Background(), test.name, test.policy, test.fn)
+		st := s.Status()[0]
+		if st.Name != test.name {
+			t.Errorf("%s: name %q", test.name, st.Name)
+		}
+		if st.State != test.state {
+			t.Errorf("%s: state %v, want %v", test.name, st.State, test.state)
+		}
+		if st.Restarts != test.restarts {
+			t.Errorf("%s: %d restarts, want %d", test.name, st.Restarts, test.restarts)
+		}
+		var perr *PanicError
+		if errors.As(st.LastError, &perr) != test.panicked {
+			t.Errorf("%s: last error %v, panicked %v", test.name, st.LastError, test.panicked)
+		}
+		if perr != nil && len(perr.Stack) == 0 {
+			t.Errorf("%s: panic recorded without stack", test.name)
+		}
+	}
+}
+
+func TestSupervisorCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New()
+	done := s.Go(ctx, "blocked", Policy{}, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if st := s.Status()[0]; st.State != Running {
+		t.Fatalf("state %v, want %v", st.State, Running)
+	}
+	cancel()
+	<-done
+	st := s.Status()[0]
+	if st.State != Stopped || st.LastError != nil {
+		t.Errorf("canceled subsystem has state %v and error %v", st.State, st.LastError)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/rpc/jsonrpc"
 	"decred.org/dcrwallet/v5/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/v5/internal/supervisor"
 	"decred.org/dcrwallet/v5/p2p"
 	"decred.org/dcrwallet/v5/spv"
 	"decred.org/dcrwallet/v5/ticketbuyer"
@@ -39,6 +40,7 @@ func init() {
 	connmgr.UseLogger(loggers.CmgrLog)
 	// XXX mixclient.UseLogger(loggers.MixcLog)
 	mixpool.UseLogger(loggers.MixpLog)
+	supervisor.UseLogger(loggers.SupvLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -55,6 +57,7 @@ var subsystemLoggers = map[string]slog.Logger{
 	"MIXC": loggers.MixcLog,
 	"MIXP": loggers.MixpLog,
 	"VSPC": loggers.VspcLog,
+	"SUPV": loggers.SupvLog,
 }
 
 // setLogLevel sets the logging level for provided subsystem.  Invalid
@@ -99,3 +99,15 @@ func (m *Map[K, V]) Contains(key K) bool {
 	m.mu.Unlock()
 	return ok
 }
+
+// Delete removes the item with key from the LRU map, if present.
+func (m *Map[K, V]) Delete(key K) {
+	defer m.mu.Unlock()
+	m.mu.Lock()
+
+	elem, ok := m.m[key]
+	if ok {
+		m.list.Remove(elem)
+		delete(m.m, key)
+	}
+}
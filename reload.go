@@ -0,0 +1,247 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"decred.org/dcrwallet/v5/errors"
+	ldr "decred.org/dcrwallet/v5/internal/loader"
+	"decred.org/dcrwallet/v5/internal/rpc/jsonrpc"
+	"decred.org/dcrwallet/v5/ticketbuyer"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// optionValues returns the value of every option of the parser, keyed by the
+// option's long name including the namespace of its group.
+func optionValues(parser *flags.Parser) map[string]string {
+	values := make(map[string]string)
+	var walk func(g *flags.Group)
+	walk = func(g *flags.Group) {
+		for _, o := range g.Options() {
+			name := o.LongNameWithNamespace()
+			if name == "" {
+				continue
+			}
+			values[name] = formatOptionValue(o.Value())
+		}
+		for _, g := range g.Groups() {
+			walk(g)
+		}
+	}
+	walk(parser.Group)
+	return values
+}
+
+// formatOptionValue formats an option value for comparison, dereferencing
+// pointers so that equal values always format equally.
+func formatOptionValue(v any) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return ""
+	}
+	switch v := v.(type) {
+	case flags.Marshaler:
+		s, err := v.MarshalFlag()
+		if err != nil {
+			return err.Error()
+		}
+		return s
+	case fmt.Stringer:
+		return v.String()
+	}
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return ""
+		}
+		rv = rv.Elem()
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+// parseConfigOptions parses the configuration file and command line options
+// in the same order as loadConfig, without validating or applying them.
+func parseConfigOptions(configFilePath string) (*config, map[string]string, error) {
+	c := defaultConfig()
+	parser := flags.NewParser(&c, flags.Default&^flags.PrintErrors)
+	err := flags.NewIniParser(parser).ParseFile(configFilePath)
+	if err != nil {
+		var e *os.PathError
+		if !errors.As(err, &e) {
+			return nil, nil, err
+		}
+	}
+	if _, err := parser.Parse(); err != nil {
+		return nil, nil, err
+	}
+	return &c, optionValues(parser), nil
+}
+
+// reloadableOptions maps the long names of the options which may be changed
+// while running to the functions applying them.
+var reloadableOptions = map[string]func(r *configReloader, c *config) error{
+	"debuglevel": func(r *configReloader, c *config) error {
+		return parseAndSetDebugLevels(c.DebugLevel)
+	},
+	"txfee": (*configReloader).applyRelayFee,
+
+	"ticketbuyer.balancetomaintainabsolute": (*configReloader).applyTicketBuyer,
+	"ticketbuyer.limit":                     (*configReloader).applyTicketBuyer,
+	"ticketbuyer.skipfinalblocks":           (*configReloader).applyTicketBuyer,
+	"ticketbuyer.onlyfinalblocks":           (*configReloader).applyTicketBuyer,
+	"ticketbuyer.maxprice":                  (*configReloader).applyTicketBuyer,
+	"ticketbuyer.minreturn":                 (*configReloader).applyTicketBuyer,
+	"ticketbuyer.minconf":                   (*configReloader).applyTicketBuyer,
+	"ticketbuyer.nochangeinputs":            (*configReloader).applyTicketBuyer,
+}
+
+// configReloader reloads the configuration while the process is running.
+type configReloader struct {
+	loader *ldr.Loader
+
+	mu      sync.Mutex
+	options map[string]string // Values of the options in effect
+	tb      *ticketbuyer.TB
+}
+
+func newConfigReloader(loader *ldr.Loader) *configReloader {
+	options := make(map[string]string, len(cfg.options))
+	for name, value := range cfg.options {
+		options[name] = value
+	}
+	return &configReloader{loader: loader, options: options}
+}
+
+// setTicketBuyer sets the running ticket buyer which reloaded ticket buyer
+// options are applied to.
+func (r *configReloader) setTicketBuyer(tb *ticketbuyer.TB) {
+	r.mu.Lock()
+	r.tb = tb
+	r.mu.Unlock()
+}
+
+// reload parses the configuration file and command line options and applies
+// each changed option which may be changed while running.  Changed options
+// which require a restart are reported by every reload until the process is
+// restarted.
+func (r *configReloader) reload() (*jsonrpc.ConfigReload, error) {
+	c, options, err := parseConfigOptions(cfg.configFilePath)
+	if err != nil {
+		log.Errorf("Failed to reload configuration: %v", err)
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var changed []string
+	for name, value := range options {
+		if r.options[name] != value {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+
+	res := new(jsonrpc.ConfigReload)
+	for _, name := range changed {
+		apply, ok := reloadableOptions[name]
+		if !ok {
+			res.RestartRequired = append(res.RestartRequired, name)
+			continue
+		}
+		if err := apply(r, c); err != nil {
+			res.Failed = append(res.Failed, jsonrpc.ConfigReloadError{
+				Option: name,
+				Err:    err,
+			})
+			continue
+		}
+		r.options[name] = options[name]
+		res.Applied = append(res.Applied, name)
+	}
+
+	if len(changed) == 0 {
+		log.Infof("Reloaded configuration without changes")
+	}
+	if len(res.Applied) != 0 {
+		log.Infof("Applied changed configuration options: %s",
+			strings.Join(res.Applied, ", "))
+	}
+	if len(res.RestartRequired) != 0 {
+		log.Warnf("Changed configuration options require a restart: %s",
+			strings.Join(res.RestartRequired, ", "))
+	}
+	for _, f := range res.Failed {
+		log.Errorf("Failed to apply changed configuration option %s: %v",
+			f.Option, f.Err)
+	}
+	return res, nil
+}
+
+func (r *configReloader) applyRelayFee(c *config) error {
+	w, ok := r.loader.LoadedWallet()
+	if !ok {
+		return errors.New("wallet is not loaded")
+	}
+	return w.SetRelayFee(c.RelayFee.Amount)
+}
+
+func (r *configReloader) applyTicketBuyer(c *config) error {
+	if r.tb == nil {
+		return errors.New("ticket buyer is not running")
+	}
+	if err := c.TBOpts.validate(activeNet); err != nil {
+		return err
+	}
+	r.tb.AccessConfig(func(tbCfg *ticketbuyer.Config) {
+		tbCfg.Maintain = c.TBOpts.BalanceToMaintainAbsolute.Amount
+		tbCfg.Limit = int(c.TBOpts.Limit)
+		tbCfg.SkipFinalBlocks = int32(c.TBOpts.SkipFinalBlocks)
+		tbCfg.OnlyFinalBlocks = int32(c.TBOpts.OnlyFinalBlocks)
+		tbCfg.MinConf = int32(c.TBOpts.MinConf)
+		tbCfg.ExcludeChange = c.TBOpts.NoChangeInputs
+
+		// Replace the limit policy, keeping any other policies.
+		policies := make([]ticketbuyer.Policy, 0, len(tbCfg.Policies)+1)
+		if p := c.TBOpts.limitPolicy(); p != nil {
+			policies = append(policies, p)
+		}
+		for _, p := range tbCfg.Policies {
+			if _, ok := p.(*ticketbuyer.LimitPolicy); !ok {
+				policies = append(policies, p)
+			}
+		}
+		tbCfg.Policies = policies
+	})
+	return nil
+}
+
+// reloadListener reloads the configuration each time one of the reload
+// signals is received, until ctx is canceled.  This function is intended to be
+// spawned in a new goroutine.
+func reloadListener(ctx context.Context, r *configReloader) {
+	if len(reloadSignals) == 0 {
+		return
+	}
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, reloadSignals...)
+	defer signal.Stop(c)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sig := <-c:
+			log.Infof("Received signal (%s).  Reloading configuration...", sig)
+			r.reload()
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"decred.org/dcrwallet/v5/internal/cfgutil"
+	flags "github.com/jessevdk/go-flags"
+)
+
+func TestOptionValues(t *testing.T) {
+	t.Parallel()
+
+	type group struct {
+		Limit uint                `long:"limit"`
+		Price *cfgutil.AmountFlag `long:"price"`
+	}
+	type options struct {
+		Level string                  `long:"level"`
+		Dir   *cfgutil.ExplicitString `long:"dir"`
+		Pipe  *uint                   `long:"pipe"`
+		Peers []string                `long:"peer"`
+		Group group                   `group:"Group" namespace:"group"`
+	}
+	parse := func(args ...string) map[string]string {
+		opts := options{
+			Dir:   cfgutil.NewExplicitString("/data"),
+			Group: group{Price: cfgutil.NewAmountFlag(0)},
+		}
+		parser := flags.NewParser(&opts, flags.None)
+		if _, err := parser.ParseArgs(args); err != nil {
+			t.Fatal(err)
+		}
+		return optionValues(parser)
+	}
+
+	defaults := parse()
+	want := map[string]string{
+		"level":       "",
+		"dir":         "/data",
+		"pipe":        "",
+		"peer":        "[]",
+		"group.limit": "0",
+		"group.price": "0 DCR",
+	}
+	for name, value := range want {
+		if defaults[name] != value {
+			t.Errorf("default %s is %q, want %q", name, defaults[name], value)
+		}
+	}
+	if len(defaults) != len(want) {
+		t.Errorf("parsed %d options, want %d", len(defaults), len(want))
+	}
+
+	// Equal values parsed into distinct pointers format equally.
+	a := parse("--pipe=3", "--group.price=1.5", "--peer=a", "--peer=b")
+	b := parse("--pipe=3", "--group.price=1.5", "--peer=a", "--peer=b")
+	for name := range a {
+		if a[name] != b[name] {
+			t.Errorf("%s formatted as %q and %q", name, a[name], b[name])
+		}
+	}
+	if a["pipe"] != "3" || a["group.price"] == defaults["group.price"] ||
+		a["peer"] == defaults["peer"] {
+		t.Errorf("changed options not detected: %v", a)
+	}
+}
@@ -124,6 +124,22 @@ func (r *RPC) ExistsLiveTickets(ctx context.Context, tickets []*chainhash.Hash)
 	return bits, nil
 }
 
+// ExistsMempoolTxs returns a bitset identifying whether each transaction is
+// currently in the mempool.
+func (r *RPC) ExistsMempoolTxs(ctx context.Context, txs []*chainhash.Hash) (bitset.Bytes, error) {
+	const op errors.Op = "dcrd.ExistsMempoolTxs"
+	txArray, err := json.Marshal(hashSliceToStrings(txs))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var bits bitset.Bytes
+	err = exists(ctx, r, "existsmempooltxs", &bits, txArray)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return bits, nil
+}
+
 // MempoolCount returns the count of a particular kind of transaction in mempool.
 // Kind may be one of:
 //
@@ -473,6 +489,25 @@ func (r *RPC) StakeDifficulty(ctx context.Context) (dcrutil.Amount, error) {
 	return sdiff, nil
 }
 
+// RelayFee returns the minimum fee per kB of serialized transaction required
+// by the node's mempool policy to relay a transaction.
+func (r *RPC) RelayFee(ctx context.Context) (dcrutil.Amount, error) {
+	const op errors.Op = "dcrd.RelayFee"
+
+	var res struct {
+		RelayFee float64 `json:"relayfee"`
+	}
+	err := r.Call(ctx, "getnetworkinfo", &res)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	relayFee, err := dcrutil.NewAmount(res.RelayFee)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return relayFee, nil
+}
+
 // GetBlockchainInfo returns information about the underlying dcrd node.
 func (r *RPC) GetBlockchainInfo(ctx context.Context) (*dcrdtypes.GetBlockChainInfoResult, error) {
 	const op errors.Op = "dcrd.GetBlockchainInfo"
@@ -518,6 +553,40 @@ func (r *RPC) GetConfirmationHeight(ctx context.Context, txHash *chainhash.Hash)
 	return grt.BlockHeight, nil
 }
 
+// Generate mines blocks using the node's CPU miner and returns the hashes of
+// the generated blocks.  This is only supported by simnet and regnet nodes
+// configured with a mining address.
+func (r *RPC) Generate(ctx context.Context, numBlocks uint32) ([]*chainhash.Hash, error) {
+	const op errors.Op = "dcrd.Generate"
+
+	var res []string
+	err := r.Call(ctx, "generate", &res, numBlocks)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hashes := make([]*chainhash.Hash, len(res))
+	for i, s := range res {
+		hashes[i], err = chainhash.NewHashFromStr(s)
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+	}
+	return hashes, nil
+}
+
+// InvalidateBlock permanently marks a block as invalid, as if it violated a
+// consensus rule, causing the node to reorganize to the best chain that does
+// not include the block.
+func (r *RPC) InvalidateBlock(ctx context.Context, blockHash *chainhash.Hash) error {
+	const op errors.Op = "dcrd.InvalidateBlock"
+
+	err := r.Call(ctx, "invalidateblock", nil, blockHash.String())
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
 // String returns a string representation of the caller (if it exists).
 func (r *RPC) String() string {
 	if s, ok := r.Caller.(fmt.Stringer); ok {
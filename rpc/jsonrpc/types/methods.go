@@ -57,6 +57,12 @@ func NewAccountSyncAddressIndexCmd(acct string, branch int,
 	}
 }
 
+// AddContactCmd defines the addcontact JSON-RPC command.
+type AddContactCmd struct {
+	Name    string
+	Address string
+}
+
 // AddMultisigAddressCmd defines the addmutisigaddress JSON-RPC command.
 type AddMultisigAddressCmd struct {
 	NRequired int
@@ -147,6 +153,15 @@ func NewCreateNewAccountCmd(account string) *CreateNewAccountCmd {
 	}
 }
 
+// CreatePaymentTemplateCmd defines the createpaymenttemplate JSON-RPC command.
+type CreatePaymentTemplateCmd struct {
+	Name     string
+	Account  string
+	Amounts  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
+	Interval *int32             `jsonrpcdefault:"0"`
+	MinConf  *int               `jsonrpcdefault:"1"`
+}
+
 // CreateVotingAccountCmd is a type for handling custom marshaling and
 // unmarshalling of createvotingaccount JSON-RPC command.
 type CreateVotingAccountCmd struct {
@@ -160,6 +175,30 @@ func NewCreateVotingAccountCmd(name, pubKey string, childIndex *uint32) *CreateV
 	return &CreateVotingAccountCmd{name, pubKey, childIndex}
 }
 
+// DeletePaymentTemplateCmd defines the deletepaymenttemplate JSON-RPC command.
+type DeletePaymentTemplateCmd struct {
+	Name string
+}
+
+// DeleteContactCmd defines the deletecontact JSON-RPC command.
+type DeleteContactCmd struct {
+	Name string
+}
+
+// DropTransactionHistoryCmd defines the droptransactionhistory JSON-RPC
+// command.
+type DropTransactionHistoryCmd struct {
+	KeepUnmined *bool `jsonrpcdefault:"true"`
+}
+
+// NewDropTransactionHistoryCmd returns a new instance which can be used to
+// issue a droptransactionhistory JSON-RPC command.
+func NewDropTransactionHistoryCmd(keepUnmined *bool) *DropTransactionHistoryCmd {
+	return &DropTransactionHistoryCmd{
+		KeepUnmined: keepUnmined,
+	}
+}
+
 // DumpPrivKeyCmd defines the dumpprivkey JSON-RPC command.
 type DumpPrivKeyCmd struct {
 	Address string
@@ -173,6 +212,44 @@ func NewDumpPrivKeyCmd(address string) *DumpPrivKeyCmd {
 	}
 }
 
+// DumpWalletCmd defines the dumpwallet JSON-RPC command.
+type DumpWalletCmd struct {
+	Filename string
+	Confirm  bool
+}
+
+// ExecuteSweepPlanCmd defines the executesweepplan JSON-RPC command.
+type ExecuteSweepPlanCmd struct {
+	PlanID   string
+	Accounts []string
+	Address  string
+	MinConf  *int `jsonrpcdefault:"1"`
+	FeeRate  *float64
+}
+
+// ExecuteTemplateCmd defines the executetemplate JSON-RPC command.
+type ExecuteTemplateCmd struct {
+	Name string
+}
+
+// ExportTicketCmd defines the exportticket JSON-RPC command.
+type ExportTicketCmd struct {
+	TicketHash string
+}
+
+// Units of a FeeRate.
+const (
+	FeeRateAtomsPerKB   = "atoms/kB"
+	FeeRateAtomsPerByte = "atoms/B"
+)
+
+// FeeRate is a transaction fee rate with an explicit unit, either atoms per
+// kilobyte (FeeRateAtomsPerKB) or atoms per byte (FeeRateAtomsPerByte).
+type FeeRate struct {
+	Rate float64 `json:"rate"`
+	Unit string  `json:"unit"`
+}
+
 // FundRawTransactionOptions represents the optional inputs to fund
 // a raw transaction.
 type FundRawTransactionOptions struct {
@@ -238,12 +315,26 @@ func NewGetAddressesByAccountCmd(account string) *GetAddressesByAccountCmd {
 	}
 }
 
+// GetAttestationCmd defines the getattestation JSON-RPC command.
+type GetAttestationCmd struct{}
+
+// GetAuditLogCmd defines the getauditlog JSON-RPC command.
+type GetAuditLogCmd struct {
+	Count *int `jsonrpcdefault:"100"`
+	From  *int `jsonrpcdefault:"0"`
+}
+
 // GetBalanceCmd defines the getbalance JSON-RPC command.
 type GetBalanceCmd struct {
 	Account *string
 	MinConf *int `jsonrpcdefault:"1"`
 }
 
+// GetBalanceHistoryCmd defines the getbalancehistory JSON-RPC command.
+type GetBalanceHistoryCmd struct {
+	Days *int `jsonrpcdefault:"30"`
+}
+
 // NewGetBalanceCmd returns a new instance which can be used to issue a
 // getbalance JSON-RPC command.
 //
@@ -360,6 +451,16 @@ func NewGetStakeInfoCmd() *GetStakeInfoCmd {
 	return &GetStakeInfoCmd{}
 }
 
+// GetStakeEarningsCmd defines the getstakeearnings JSON-RPC command.
+type GetStakeEarningsCmd struct {
+	Period *string `jsonrpcdefault:"\"month\""`
+}
+
+// NewGetStakeEarningsCmd creates a new GetStakeEarningsCmd.
+func NewGetStakeEarningsCmd(period *string) *GetStakeEarningsCmd {
+	return &GetStakeEarningsCmd{Period: period}
+}
+
 // GetTicketsCmd is a type handling custom marshaling and
 // unmarshaling of gettickets JSON wallet extension
 // commands.
@@ -407,6 +508,11 @@ func NewGetUnconfirmedBalanceCmd(account *string) *GetUnconfirmedBalanceCmd {
 	}
 }
 
+// GetUTXOStatsCmd defines the getutxostats JSON-RPC command.
+type GetUTXOStatsCmd struct {
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
 // GetVoteChoicesCmd returns a new instance which can be used to issue a
 // getvotechoices JSON-RPC command.
 type GetVoteChoicesCmd struct {
@@ -452,6 +558,12 @@ func NewImportPrivKeyCmd(privKey string, label *string, rescan *bool, scanFrom *
 	}
 }
 
+// ImportLegacyKeysCmd defines the importlegacykeys JSON-RPC command.
+type ImportLegacyKeysCmd struct {
+	Dump   string
+	Rescan *bool `jsonrpcdefault:"true"`
+}
+
 // ImportPrivKeyCmd defines the importprivkey JSON-RPC command.
 type ImportPubKeyCmd struct {
 	PubKey   string
@@ -487,6 +599,11 @@ func NewImportScriptCmd(hex string, rescan *bool, scanFrom *int) *ImportScriptCm
 	return &ImportScriptCmd{hex, rescan, scanFrom}
 }
 
+// ImportTicketCmd defines the importticket JSON-RPC command.
+type ImportTicketCmd struct {
+	Export string
+}
+
 // ImportXpubCmd is a type for handling custom marshaling and unmarshaling of
 // importxpub JSON-RPC commands.
 type ImportXpubCmd struct {
@@ -510,6 +627,9 @@ func NewListAccountsCmd(minConf *int) *ListAccountsCmd {
 	}
 }
 
+// ListContactsCmd defines the listcontacts JSON-RPC command.
+type ListContactsCmd struct{}
+
 // ListLockUnspentCmd defines the listlockunspent JSON-RPC command.
 type ListLockUnspentCmd struct {
 	Account *string
@@ -521,6 +641,9 @@ func NewListLockUnspentCmd() *ListLockUnspentCmd {
 	return &ListLockUnspentCmd{}
 }
 
+// ListPaymentTemplatesCmd defines the listpaymenttemplates JSON-RPC command.
+type ListPaymentTemplatesCmd struct{}
+
 // ListReceivedByAccountCmd defines the listreceivedbyaccount JSON-RPC command.
 type ListReceivedByAccountCmd struct {
 	MinConf          *int  `jsonrpcdefault:"1"`
@@ -659,6 +782,15 @@ func NewListUnspentCmd(minConf, maxConf *int, addresses *[]string) *ListUnspentC
 	}
 }
 
+// ListVSPTicketsCmd defines the listvsptickets JSON-RPC command.
+type ListVSPTicketsCmd struct{}
+
+// ListWalletEventsCmd defines the listwalletevents JSON-RPC command.
+type ListWalletEventsCmd struct {
+	Count *int `jsonrpcdefault:"100"`
+	From  *int `jsonrpcdefault:"0"`
+}
+
 // LockUnspentCmd defines the lockunspent JSON-RPC command.
 type LockUnspentCmd struct {
 	Unlock       bool
@@ -674,16 +806,27 @@ func NewLockUnspentCmd(unlock bool, transactions []dcrdtypes.TransactionInput) *
 	}
 }
 
+// PlanSweepCmd defines the plansweep JSON-RPC command.
+type PlanSweepCmd struct {
+	Accounts []string
+	Address  string
+	MinConf  *int `jsonrpcdefault:"1"`
+	FeeRate  *float64
+}
+
 // PurchaseTicketCmd is a type handling custom marshaling and
 // unmarshaling of purchaseticket JSON RPC commands.
 type PurchaseTicketCmd struct {
-	FromAccount string
-	SpendLimit  float64 // In Coins
-	MinConf     *int    `jsonrpcdefault:"1"`
-	NumTickets  *int    `jsonrpcdefault:"1"`
-	Expiry      *int
-	Comment     *string
-	DontSignTx  *bool
+	FromAccount  string
+	SpendLimit   float64 // In Coins
+	MinConf      *int    `jsonrpcdefault:"1"`
+	NumTickets   *int    `jsonrpcdefault:"1"`
+	Expiry       *int
+	Comment      *string
+	DontSignTx   *bool
+	ExpiryBlocks *int
+	Verbose      *bool    `jsonrpcdefault:"false"`
+	FeeRate      *FeeRate `jsonrpcusage:"{\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"}"`
 }
 
 // NewPurchaseTicketCmd creates a new PurchaseTicketCmd.
@@ -744,6 +887,15 @@ func NewRedeemMultiSigOutsCmd(from string, to *string,
 	}
 }
 
+// ReloadConfigCmd defines the reloadconfig JSON-RPC command.
+type ReloadConfigCmd struct{}
+
+// NewReloadConfigCmd returns a new instance which can be used to issue a
+// reloadconfig JSON-RPC command.
+func NewReloadConfigCmd() *ReloadConfigCmd {
+	return &ReloadConfigCmd{}
+}
+
 // RenameAccountCmd defines the renameaccount JSON-RPC command.
 type RenameAccountCmd struct {
 	OldAccount string
@@ -764,6 +916,20 @@ type RescanWalletCmd struct {
 	BeginHeight *int `jsonrpcdefault:"0"`
 }
 
+// ResendTransactionCmd describes the resendtransaction JSON-RPC request and
+// parameters.
+type ResendTransactionCmd struct {
+	TxHash string
+}
+
+// NewResendTransactionCmd returns a new instance which can be used to issue a
+// resendtransaction JSON-RPC command.
+func NewResendTransactionCmd(txHash string) *ResendTransactionCmd {
+	return &ResendTransactionCmd{
+		TxHash: txHash,
+	}
+}
+
 // RevokeTicketsCmd describes the revoketickets JSON-RPC request and parameters.
 type RevokeTicketsCmd struct {
 }
@@ -773,6 +939,9 @@ func NewRevokeTicketsCmd() *RevokeTicketsCmd {
 	return &RevokeTicketsCmd{}
 }
 
+// SelfCheckCmd defines the selfcheck JSON-RPC command.
+type SelfCheckCmd struct{}
+
 // SendFromCmd defines the sendfrom JSON-RPC command.
 type SendFromCmd struct {
 	FromAccount string
@@ -781,6 +950,8 @@ type SendFromCmd struct {
 	MinConf     *int    `jsonrpcdefault:"1"`
 	Comment     *string
 	CommentTo   *string
+	Verbose     *bool    `jsonrpcdefault:"false"`
+	FeeRate     *FeeRate `jsonrpcusage:"{\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"}"`
 }
 
 // NewSendFromCmd returns a new instance which can be used to issue a sendfrom
@@ -805,6 +976,10 @@ type SendManyCmd struct {
 	Amounts     map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
 	MinConf     *int               `jsonrpcdefault:"1"`
 	Comment     *string
+	Labels      *map[string]string `jsonrpcusage:"{\"address\":\"label\",...}"`
+	Split       *bool              `jsonrpcdefault:"false"`
+	Verbose     *bool              `jsonrpcdefault:"false"`
+	FeeRate     *FeeRate           `jsonrpcusage:"{\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"}"`
 }
 
 // NewSendManyCmd returns a new instance which can be used to issue a sendmany
@@ -827,6 +1002,8 @@ type SendToAddressCmd struct {
 	Amount    float64
 	Comment   *string
 	CommentTo *string
+	Verbose   *bool    `jsonrpcdefault:"false"`
+	FeeRate   *FeeRate `jsonrpcusage:"{\"rate\":n,\"unit\":\"atoms/kB|atoms/B\"}"`
 }
 
 // NewSendToAddressCmd returns a new instance which can be used to issue a
@@ -1044,6 +1221,23 @@ func NewSignRawTransactionsCmd(hexEncodedTxs []string,
 	}
 }
 
+// SimnetAdvanceCmd defines the simnetadvance JSON-RPC command.
+type SimnetAdvanceCmd struct {
+	NumBlocks uint32
+}
+
+// SimnetFundAccountCmd defines the simnetfundaccount JSON-RPC command.
+type SimnetFundAccountCmd struct {
+	Account     string
+	Amount      float64
+	FromAccount *string `jsonrpcdefault:"\"default\""`
+}
+
+// SimnetReorgCmd defines the simnetreorg JSON-RPC command.
+type SimnetReorgCmd struct {
+	Depth uint32
+}
+
 // SweepAccountCmd defines the sweep account JSON-RPC command.
 type SweepAccountCmd struct {
 	SourceAccount         string
@@ -1065,6 +1259,11 @@ func NewSweepAccountCmd(sourceAccount string, destinationAddress string, require
 // SyncStatusCmd defines the syncstatus JSON-RPC command.
 type SyncStatusCmd struct{}
 
+// VerifyExternalAddressCmd defines the verifyexternaladdress JSON-RPC command.
+type VerifyExternalAddressCmd struct {
+	Address string
+}
+
 // WalletInfoCmd defines the walletinfo JSON-RPC command.
 type WalletInfoCmd struct {
 }
@@ -1097,6 +1296,7 @@ func NewWalletLockCmd() *WalletLockCmd {
 type WalletPassphraseCmd struct {
 	Passphrase string
 	Timeout    int64
+	Session    *bool `jsonrpcdefault:"false"`
 }
 
 // NewWalletPassphraseCmd returns a new instance which can be used to issue a
@@ -1165,6 +1365,40 @@ type SetAccountPassphraseCmd struct {
 	Passphrase string
 }
 
+// SetVotingAccountCmd defines the setvotingaccount JSON-RPC command.
+type SetVotingAccountCmd struct {
+	Account string
+	Voting  *bool `jsonrpcdefault:"true"`
+}
+
+// NewSetVotingAccountCmd returns a new instance which can be used to issue a
+// setvotingaccount JSON-RPC command.
+func NewSetVotingAccountCmd(account string, voting *bool) *SetVotingAccountCmd {
+	return &SetVotingAccountCmd{
+		Account: account,
+		Voting:  voting,
+	}
+}
+
+// SetVotingPassphraseCmd defines the setvotingpassphrase JSON-RPC command.
+type SetVotingPassphraseCmd struct {
+	Passphrase string
+}
+
+// NewSetVotingPassphraseCmd returns a new instance which can be used to issue
+// a setvotingpassphrase JSON-RPC command.
+func NewSetVotingPassphraseCmd(passphrase string) *SetVotingPassphraseCmd {
+	return &SetVotingPassphraseCmd{
+		Passphrase: passphrase,
+	}
+}
+
+// UpdateContactCmd defines the updatecontact JSON-RPC command.
+type UpdateContactCmd struct {
+	Name    string
+	Address string
+}
+
 // UnlockAccountCmd defines the unlockaccount JSON-RPC command arguments.
 type UnlockAccountCmd struct {
 	Account    string
@@ -1181,6 +1415,19 @@ type AccountUnlockedCmd struct {
 	Account string
 }
 
+// AccountSpendPolicyCmd defines the accountspendpolicy JSON-RPC command
+// arguments.
+type AccountSpendPolicyCmd struct {
+	Account string
+}
+
+// SetAccountSpendPolicyCmd defines the setaccountspendpolicy JSON-RPC command
+// arguments.
+type SetAccountSpendPolicyCmd struct {
+	Account string
+	Policy  string
+}
+
 // ProcessUnmanagedTicket defines the processunmanagedticket JSON-RPC command arguments.
 type ProcessUnmanagedTicketCmd struct {
 	TicketHash string
@@ -1213,24 +1460,37 @@ func init() {
 	register := []registeredMethod{
 		{"abandontransaction", (*AbandonTransactionCmd)(nil)},
 		{"accountaddressindex", (*AccountAddressIndexCmd)(nil)},
+		{"accountspendpolicy", (*AccountSpendPolicyCmd)(nil)},
 		{"accountsyncaddressindex", (*AccountSyncAddressIndexCmd)(nil)},
 		{"accountunlocked", (*AccountUnlockedCmd)(nil)},
+		{"addcontact", (*AddContactCmd)(nil)},
 		{"addmultisigaddress", (*AddMultisigAddressCmd)(nil)},
 		{"addtransaction", (*AddTransactionCmd)(nil)},
 		{"auditreuse", (*AuditReuseCmd)(nil)},
 		{"consolidate", (*ConsolidateCmd)(nil)},
 		{"createmultisig", (*CreateMultisigCmd)(nil)},
 		{"createnewaccount", (*CreateNewAccountCmd)(nil)},
+		{"createpaymenttemplate", (*CreatePaymentTemplateCmd)(nil)},
 		{"createsignature", (*CreateSignatureCmd)(nil)},
 		{"createvotingaccount", (*CreateVotingAccountCmd)(nil)},
+		{"deletecontact", (*DeleteContactCmd)(nil)},
+		{"deletepaymenttemplate", (*DeletePaymentTemplateCmd)(nil)},
 		{"disapprovepercent", (*DisapprovePercentCmd)(nil)},
 		{"discoverusage", (*DiscoverUsageCmd)(nil)},
+		{"droptransactionhistory", (*DropTransactionHistoryCmd)(nil)},
 		{"dumpprivkey", (*DumpPrivKeyCmd)(nil)},
+		{"dumpwallet", (*DumpWalletCmd)(nil)},
+		{"executesweepplan", (*ExecuteSweepPlanCmd)(nil)},
+		{"executetemplate", (*ExecuteTemplateCmd)(nil)},
+		{"exportticket", (*ExportTicketCmd)(nil)},
 		{"fundrawtransaction", (*FundRawTransactionCmd)(nil)},
 		{"getaccount", (*GetAccountCmd)(nil)},
 		{"getaccountaddress", (*GetAccountAddressCmd)(nil)},
 		{"getaddressesbyaccount", (*GetAddressesByAccountCmd)(nil)},
+		{"getattestation", (*GetAttestationCmd)(nil)},
+		{"getauditlog", (*GetAuditLogCmd)(nil)},
 		{"getbalance", (*GetBalanceCmd)(nil)},
+		{"getbalancehistory", (*GetBalanceHistoryCmd)(nil)},
 		{"getcoinjoinsbyacct", (*GetCoinjoinsByAcctCmd)(nil)},
 		{"getmasterpubkey", (*GetMasterPubkeyCmd)(nil)},
 		{"getmultisigoutinfo", (*GetMultisigOutInfoCmd)(nil)},
@@ -1238,37 +1498,49 @@ func init() {
 		{"getrawchangeaddress", (*GetRawChangeAddressCmd)(nil)},
 		{"getreceivedbyaccount", (*GetReceivedByAccountCmd)(nil)},
 		{"getreceivedbyaddress", (*GetReceivedByAddressCmd)(nil)},
+		{"getstakeearnings", (*GetStakeEarningsCmd)(nil)},
 		{"getstakeinfo", (*GetStakeInfoCmd)(nil)},
 		{"gettickets", (*GetTicketsCmd)(nil)},
 		{"gettransaction", (*GetTransactionCmd)(nil)},
 		{"getunconfirmedbalance", (*GetUnconfirmedBalanceCmd)(nil)},
+		{"getutxostats", (*GetUTXOStatsCmd)(nil)},
 		{"getvotechoices", (*GetVoteChoicesCmd)(nil)},
 		{"getwalletfee", (*GetWalletFeeCmd)(nil)},
 		{"importcfiltersv2", (*ImportCFiltersV2Cmd)(nil)},
+		{"importlegacykeys", (*ImportLegacyKeysCmd)(nil)},
 		{"importprivkey", (*ImportPrivKeyCmd)(nil)},
 		{"importpubkey", (*ImportPubKeyCmd)(nil)},
 		{"importscript", (*ImportScriptCmd)(nil)},
+		{"importticket", (*ImportTicketCmd)(nil)},
 		{"importxpub", (*ImportXpubCmd)(nil)},
 		{"listaccounts", (*ListAccountsCmd)(nil)},
 		{"listaddresstransactions", (*ListAddressTransactionsCmd)(nil)},
 		{"listalltransactions", (*ListAllTransactionsCmd)(nil)},
+		{"listcontacts", (*ListContactsCmd)(nil)},
 		{"listlockunspent", (*ListLockUnspentCmd)(nil)},
+		{"listpaymenttemplates", (*ListPaymentTemplatesCmd)(nil)},
 		{"listreceivedbyaccount", (*ListReceivedByAccountCmd)(nil)},
 		{"listreceivedbyaddress", (*ListReceivedByAddressCmd)(nil)},
 		{"listsinceblock", (*ListSinceBlockCmd)(nil)},
 		{"listtransactions", (*ListTransactionsCmd)(nil)},
 		{"listunspent", (*ListUnspentCmd)(nil)},
+		{"listvsptickets", (*ListVSPTicketsCmd)(nil)},
+		{"listwalletevents", (*ListWalletEventsCmd)(nil)},
 		{"lockaccount", (*LockAccountCmd)(nil)},
 		{"lockunspent", (*LockUnspentCmd)(nil)},
 		{"mixaccount", (*MixAccountCmd)(nil)},
 		{"mixoutput", (*MixOutputCmd)(nil)},
+		{"plansweep", (*PlanSweepCmd)(nil)},
 		{"purchaseticket", (*PurchaseTicketCmd)(nil)},
 		{"processunmanagedticket", (*ProcessUnmanagedTicketCmd)(nil)},
 		{"redeemmultisigout", (*RedeemMultiSigOutCmd)(nil)},
 		{"redeemmultisigouts", (*RedeemMultiSigOutsCmd)(nil)},
+		{"reloadconfig", (*ReloadConfigCmd)(nil)},
 		{"renameaccount", (*RenameAccountCmd)(nil)},
 		{"rescanwallet", (*RescanWalletCmd)(nil)},
+		{"resendtransaction", (*ResendTransactionCmd)(nil)},
 		{"revoketickets", (*RevokeTicketsCmd)(nil)},
+		{"selfcheck", (*SelfCheckCmd)(nil)},
 		{"sendfrom", (*SendFromCmd)(nil)},
 		{"sendfromtreasury", (*SendFromTreasuryCmd)(nil)},
 		{"sendmany", (*SendManyCmd)(nil)},
@@ -1276,14 +1548,20 @@ func init() {
 		{"sendtomultisig", (*SendToMultiSigCmd)(nil)},
 		{"sendtotreasury", (*SendToTreasuryCmd)(nil)},
 		{"setaccountpassphrase", (*SetAccountPassphraseCmd)(nil)},
+		{"setaccountspendpolicy", (*SetAccountSpendPolicyCmd)(nil)},
 		{"setdisapprovepercent", (*SetDisapprovePercentCmd)(nil)},
 		{"settreasurypolicy", (*SetTreasuryPolicyCmd)(nil)},
 		{"settspendpolicy", (*SetTSpendPolicyCmd)(nil)},
 		{"settxfee", (*SetTxFeeCmd)(nil)},
 		{"setvotechoice", (*SetVoteChoiceCmd)(nil)},
+		{"setvotingaccount", (*SetVotingAccountCmd)(nil)},
+		{"setvotingpassphrase", (*SetVotingPassphraseCmd)(nil)},
 		{"signmessage", (*SignMessageCmd)(nil)},
 		{"signrawtransaction", (*SignRawTransactionCmd)(nil)},
 		{"signrawtransactions", (*SignRawTransactionsCmd)(nil)},
+		{"simnetadvance", (*SimnetAdvanceCmd)(nil)},
+		{"simnetfundaccount", (*SimnetFundAccountCmd)(nil)},
+		{"simnetreorg", (*SimnetReorgCmd)(nil)},
 		{"spendoutputs", (*SpendOutputsCmd)(nil)},
 		{"sweepaccount", (*SweepAccountCmd)(nil)},
 		{"syncstatus", (*SyncStatusCmd)(nil)},
@@ -1291,7 +1569,9 @@ func init() {
 		{"treasurypolicy", (*TreasuryPolicyCmd)(nil)},
 		{"tspendpolicy", (*TSpendPolicyCmd)(nil)},
 		{"unlockaccount", (*UnlockAccountCmd)(nil)},
+		{"updatecontact", (*UpdateContactCmd)(nil)},
 		{"validatepredcp0005cf", (*ValidatePreDCP0005CFCmd)(nil)},
+		{"verifyexternaladdress", (*VerifyExternalAddressCmd)(nil)},
 		{"walletinfo", (*WalletInfoCmd)(nil)},
 		{"walletislocked", (*WalletIsLockedCmd)(nil)},
 		{"walletlock", (*WalletLockCmd)(nil)},
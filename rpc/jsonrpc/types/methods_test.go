@@ -793,6 +793,19 @@ func TestWalletSvrCmds(t *testing.T) {
 				NewAccount: "newacct",
 			},
 		},
+		{
+			name: "resendtransaction",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("resendtransaction"), "123")
+			},
+			staticCmd: func() any {
+				return NewResendTransactionCmd("123")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"resendtransaction","params":["123"],"id":1}`,
+			unmarshalled: &ResendTransactionCmd{
+				TxHash: "123",
+			},
+		},
 		{
 			name: "sendfrom",
 			newCmd: func() (any, error) {
@@ -809,6 +822,7 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf:     dcrjson.Int(1),
 				Comment:     nil,
 				CommentTo:   nil,
+				Verbose:     dcrjson.Bool(false),
 			},
 		},
 		{
@@ -827,6 +841,7 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf:     dcrjson.Int(6),
 				Comment:     nil,
 				CommentTo:   nil,
+				Verbose:     dcrjson.Bool(false),
 			},
 		},
 		{
@@ -846,6 +861,7 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf:     dcrjson.Int(6),
 				Comment:     dcrjson.String("comment"),
 				CommentTo:   nil,
+				Verbose:     dcrjson.Bool(false),
 			},
 		},
 		{
@@ -865,6 +881,7 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf:     dcrjson.Int(6),
 				Comment:     dcrjson.String("comment"),
 				CommentTo:   dcrjson.String("commentto"),
+				Verbose:     dcrjson.Bool(false),
 			},
 		},
 		{
@@ -882,6 +899,8 @@ func TestWalletSvrCmds(t *testing.T) {
 				Amounts:     map[string]float64{"1Address": 0.5},
 				MinConf:     dcrjson.Int(1),
 				Comment:     nil,
+				Split:       dcrjson.Bool(false),
+				Verbose:     dcrjson.Bool(false),
 			},
 		},
 		{
@@ -899,6 +918,8 @@ func TestWalletSvrCmds(t *testing.T) {
 				Amounts:     map[string]float64{"1Address": 0.5},
 				MinConf:     dcrjson.Int(6),
 				Comment:     nil,
+				Split:       dcrjson.Bool(false),
+				Verbose:     dcrjson.Bool(false),
 			},
 		},
 		{
@@ -916,6 +937,31 @@ func TestWalletSvrCmds(t *testing.T) {
 				Amounts:     map[string]float64{"1Address": 0.5},
 				MinConf:     dcrjson.Int(6),
 				Comment:     dcrjson.String("comment"),
+				Split:       dcrjson.Bool(false),
+				Verbose:     dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "sendmany labels split",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendmany"), "from", `{"1Address":0.5}`, 6, "", `{"1Address":"payout"}`, true)
+			},
+			staticCmd: func() any {
+				amounts := map[string]float64{"1Address": 0.5}
+				cmd := NewSendManyCmd("from", amounts, dcrjson.Int(6), dcrjson.String(""))
+				cmd.Labels = &map[string]string{"1Address": "payout"}
+				cmd.Split = dcrjson.Bool(true)
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":0.5},6,"",{"1Address":"payout"},true],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount: "from",
+				Amounts:     map[string]float64{"1Address": 0.5},
+				MinConf:     dcrjson.Int(6),
+				Comment:     dcrjson.String(""),
+				Labels:      &map[string]string{"1Address": "payout"},
+				Split:       dcrjson.Bool(true),
+				Verbose:     dcrjson.Bool(false),
 			},
 		},
 		{
@@ -932,6 +978,7 @@ func TestWalletSvrCmds(t *testing.T) {
 				Amount:    0.5,
 				Comment:   nil,
 				CommentTo: nil,
+				Verbose:   dcrjson.Bool(false),
 			},
 		},
 		{
@@ -949,6 +996,30 @@ func TestWalletSvrCmds(t *testing.T) {
 				Amount:    0.5,
 				Comment:   dcrjson.String("comment"),
 				CommentTo: dcrjson.String("commentto"),
+				Verbose:   dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "sendtoaddress feerate",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("sendtoaddress"), "1Address", 0.5, "comment", "commentto",
+					true, `{"rate":10,"unit":"atoms/B"}`)
+			},
+			staticCmd: func() any {
+				cmd := NewSendToAddressCmd("1Address", 0.5, dcrjson.String("comment"),
+					dcrjson.String("commentto"))
+				cmd.Verbose = dcrjson.Bool(true)
+				cmd.FeeRate = &FeeRate{Rate: 10, Unit: FeeRateAtomsPerByte}
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendtoaddress","params":["1Address",0.5,"comment","commentto",true,{"rate":10,"unit":"atoms/B"}],"id":1}`,
+			unmarshalled: &SendToAddressCmd{
+				Address:   "1Address",
+				Amount:    0.5,
+				Comment:   dcrjson.String("comment"),
+				CommentTo: dcrjson.String("commentto"),
+				Verbose:   dcrjson.Bool(true),
+				FeeRate:   &FeeRate{Rate: 10, Unit: FeeRateAtomsPerByte},
 			},
 		},
 		{
@@ -1206,6 +1277,24 @@ func TestWalletSvrCmds(t *testing.T) {
 			unmarshalled: &WalletPassphraseCmd{
 				Passphrase: "pass",
 				Timeout:    60,
+				Session:    dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "walletpassphrase session",
+			newCmd: func() (any, error) {
+				return dcrjson.NewCmd(Method("walletpassphrase"), "pass", 60, true)
+			},
+			staticCmd: func() any {
+				cmd := NewWalletPassphraseCmd("pass", 60)
+				cmd.Session = dcrjson.Bool(true)
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletpassphrase","params":["pass",60,true],"id":1}`,
+			unmarshalled: &WalletPassphraseCmd{
+				Passphrase: "pass",
+				Timeout:    60,
+				Session:    dcrjson.Bool(true),
 			},
 		},
 		{
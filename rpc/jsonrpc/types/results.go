@@ -5,35 +5,135 @@
 
 package types
 
+// AuditLogEntryResult models a recorded request of the getauditlog command.
+type AuditLogEntryResult struct {
+	Time        int64    `json:"time"`
+	Method      string   `json:"method"`
+	Params      []string `json:"params"`
+	Client      string   `json:"client"`
+	Certificate string   `json:"certificate,omitempty"`
+	TxIDs       []string `json:"txids,omitempty"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// AuthoredTxResult describes the fee paid by a transaction authored and
+// published by the wallet.  The fee rate is reported both in atoms per
+// kilobyte and atoms per byte.
+type AuthoredTxResult struct {
+	TxHash              string  `json:"txhash"`
+	Size                int     `json:"size"`
+	Fee                 float64 `json:"fee"`
+	FeeRate             int64   `json:"feerate"`
+	FeeRateAtomsPerByte float64 `json:"feerateatomsperbyte"`
+}
+
+// BalanceSnapshotResult models a daily balance snapshot returned by the
+// getbalancehistory command.
+type BalanceSnapshotResult struct {
+	Date                 string  `json:"date"`
+	Time                 int64   `json:"time"`
+	Height               int32   `json:"height"`
+	Total                float64 `json:"total"`
+	TotalAtoms           int64   `json:"totalatoms"`
+	LockedByTickets      float64 `json:"lockedbytickets"`
+	LockedByTicketsAtoms int64   `json:"lockedbyticketsatoms"`
+	TicketCount          uint32  `json:"ticketcount"`
+	Rewards              float64 `json:"rewards"`
+	RewardsAtoms         int64   `json:"rewardsatoms"`
+}
+
+// ContactResult models an address book contact returned by the listcontacts
+// command.
+type ContactResult struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// DropTransactionHistoryResult models the data returned by the
+// droptransactionhistory command.
+type DropTransactionHistoryResult struct {
+	RescanFrom     int32 `json:"rescanfrom"`
+	UnminedKept    int   `json:"unminedkept"`
+	UnminedDropped int   `json:"unmineddropped"`
+}
+
+// DumpWalletResult models the data returned by the dumpwallet command.
+type DumpWalletResult struct {
+	Filename string `json:"filename"`
+}
+
+// ExportTicketResult models the data from the exportticket command.  It is
+// also the format of the export accepted by the importticket command.
+type ExportTicketResult struct {
+	Network      string   `json:"network"`
+	Ticket       string   `json:"ticket"`
+	BlockHash    string   `json:"blockhash,omitempty"`
+	VotingKeys   []string `json:"votingkeys"`
+	RedeemScript string   `json:"redeemscript,omitempty"`
+}
+
 // FundRawTransactionResult models the data from the fundrawtransaction command.
 type FundRawTransactionResult struct {
-	Hex string  `json:"hex"`
-	Fee float64 `json:"fee"`
+	Hex      string  `json:"hex"`
+	Fee      float64 `json:"fee"`
+	FeeAtoms int64   `json:"feeatoms"`
 }
 
 // GetAccountBalanceResult models the account data from the getbalance command.
 type GetAccountBalanceResult struct {
-	AccountName             string  `json:"accountname"`
-	ImmatureCoinbaseRewards float64 `json:"immaturecoinbaserewards"`
-	ImmatureStakeGeneration float64 `json:"immaturestakegeneration"`
-	LockedByTickets         float64 `json:"lockedbytickets"`
-	Spendable               float64 `json:"spendable"`
-	Total                   float64 `json:"total"`
-	Unconfirmed             float64 `json:"unconfirmed"`
-	VotingAuthority         float64 `json:"votingauthority"`
+	AccountName                  string  `json:"accountname"`
+	ImmatureCoinbaseRewards      float64 `json:"immaturecoinbaserewards"`
+	ImmatureCoinbaseRewardsAtoms int64   `json:"immaturecoinbaserewardsatoms"`
+	ImmatureStakeGeneration      float64 `json:"immaturestakegeneration"`
+	ImmatureStakeGenerationAtoms int64   `json:"immaturestakegenerationatoms"`
+	LockedByTickets              float64 `json:"lockedbytickets"`
+	LockedByTicketsAtoms         int64   `json:"lockedbyticketsatoms"`
+	Spendable                    float64 `json:"spendable"`
+	SpendableAtoms               int64   `json:"spendableatoms"`
+	Total                        float64 `json:"total"`
+	TotalAtoms                   int64   `json:"totalatoms"`
+	Unconfirmed                  float64 `json:"unconfirmed"`
+	UnconfirmedAtoms             int64   `json:"unconfirmedatoms"`
+	VotingAuthority              float64 `json:"votingauthority"`
+	VotingAuthorityAtoms         int64   `json:"votingauthorityatoms"`
+	Effective                    float64 `json:"effective"`
+	EffectiveAtoms               int64   `json:"effectiveatoms"`
+}
+
+// GetAttestationResult models the data from the getattestation command.
+type GetAttestationResult struct {
+	Sequence     uint64 `json:"sequence"`
+	Time         int64  `json:"time"`
+	TipHash      string `json:"tiphash"`
+	TipHeight    int32  `json:"tipheight"`
+	BalancesHash string `json:"balanceshash"`
+	TicketCount  uint32 `json:"ticketcount"`
+	PrevHash     string `json:"prevhash"`
+	Message      string `json:"message"`
+	PubKey       string `json:"pubkey"`
+	Signature    string `json:"signature"`
 }
 
 // GetBalanceResult models the data from the getbalance command.
 type GetBalanceResult struct {
-	Balances                     []GetAccountBalanceResult `json:"balances"`
-	BlockHash                    string                    `json:"blockhash"`
-	TotalImmatureCoinbaseRewards float64                   `json:"totalimmaturecoinbaserewards,omitempty"`
-	TotalImmatureStakeGeneration float64                   `json:"totalimmaturestakegeneration,omitempty"`
-	TotalLockedByTickets         float64                   `json:"totallockedbytickets,omitempty"`
-	TotalSpendable               float64                   `json:"totalspendable,omitempty"`
-	CumulativeTotal              float64                   `json:"cumulativetotal,omitempty"`
-	TotalUnconfirmed             float64                   `json:"totalunconfirmed,omitempty"`
-	TotalVotingAuthority         float64                   `json:"totalvotingauthority,omitempty"`
+	Balances                          []GetAccountBalanceResult `json:"balances"`
+	BlockHash                         string                    `json:"blockhash"`
+	TotalImmatureCoinbaseRewards      float64                   `json:"totalimmaturecoinbaserewards,omitempty"`
+	TotalImmatureCoinbaseRewardsAtoms int64                     `json:"totalimmaturecoinbaserewardsatoms,omitempty"`
+	TotalImmatureStakeGeneration      float64                   `json:"totalimmaturestakegeneration,omitempty"`
+	TotalImmatureStakeGenerationAtoms int64                     `json:"totalimmaturestakegenerationatoms,omitempty"`
+	TotalLockedByTickets              float64                   `json:"totallockedbytickets,omitempty"`
+	TotalLockedByTicketsAtoms         int64                     `json:"totallockedbyticketsatoms,omitempty"`
+	TotalSpendable                    float64                   `json:"totalspendable,omitempty"`
+	TotalSpendableAtoms               int64                     `json:"totalspendableatoms,omitempty"`
+	CumulativeTotal                   float64                   `json:"cumulativetotal,omitempty"`
+	CumulativeTotalAtoms              int64                     `json:"cumulativetotalatoms,omitempty"`
+	TotalUnconfirmed                  float64                   `json:"totalunconfirmed,omitempty"`
+	TotalUnconfirmedAtoms             int64                     `json:"totalunconfirmedatoms,omitempty"`
+	TotalVotingAuthority              float64                   `json:"totalvotingauthority,omitempty"`
+	TotalVotingAuthorityAtoms         int64                     `json:"totalvotingauthorityatoms,omitempty"`
+	TotalEffective                    float64                   `json:"totaleffective,omitempty"`
+	TotalEffectiveAtoms               int64                     `json:"totaleffectiveatoms,omitempty"`
 }
 
 // GetMultisigOutInfoResult models the data returned from the getmultisigoutinfo
@@ -51,6 +151,7 @@ type GetMultisigOutInfoResult struct {
 	SpentBy      string   `json:"spentby"`
 	SpentByIndex uint32   `json:"spentbyindex"`
 	Amount       float64  `json:"amount"`
+	AmountAtoms  int64    `json:"amountatoms"`
 }
 
 // CreateMultiSigResult models the data returned from the createmultisig
@@ -79,12 +180,46 @@ type GetPeerInfoResult struct {
 	BanScore       int32  `json:"banscore"`
 }
 
+// StakeEarningsResult models the stake earnings of a single period returned
+// by the getstakeearnings command.
+type StakeEarningsResult struct {
+	Start           string  `json:"start"`
+	Tickets         uint32  `json:"tickets"`
+	Votes           uint32  `json:"votes"`
+	Rewards         float64 `json:"rewards"`
+	RewardsAtoms    int64   `json:"rewardsatoms"`
+	TicketFees      float64 `json:"ticketfees"`
+	TicketFeesAtoms int64   `json:"ticketfeesatoms"`
+	PoolFees        float64 `json:"poolfees"`
+	PoolFeesAtoms   int64   `json:"poolfeesatoms"`
+	Net             float64 `json:"net"`
+	NetAtoms        int64   `json:"netatoms"`
+}
+
+// GetStakeEarningsResult models the data returned from the getstakeearnings
+// command.
+type GetStakeEarningsResult struct {
+	Tickets         uint32                `json:"tickets"`
+	Votes           uint32                `json:"votes"`
+	Rewards         float64               `json:"rewards"`
+	RewardsAtoms    int64                 `json:"rewardsatoms"`
+	TicketFees      float64               `json:"ticketfees"`
+	TicketFeesAtoms int64                 `json:"ticketfeesatoms"`
+	PoolFees        float64               `json:"poolfees"`
+	PoolFeesAtoms   int64                 `json:"poolfeesatoms"`
+	Net             float64               `json:"net"`
+	NetAtoms        int64                 `json:"netatoms"`
+	Periods         []StakeEarningsResult `json:"periods"`
+}
+
 // GetStakeInfoResult models the data returned from the getstakeinfo
 // command.
 type GetStakeInfoResult struct {
-	BlockHeight  int64   `json:"blockheight"`
-	Difficulty   float64 `json:"difficulty"`
-	TotalSubsidy float64 `json:"totalsubsidy"`
+	BlockHeight       int64   `json:"blockheight"`
+	Difficulty        float64 `json:"difficulty"`
+	DifficultyAtoms   int64   `json:"difficultyatoms"`
+	TotalSubsidy      float64 `json:"totalsubsidy"`
+	TotalSubsidyAtoms int64   `json:"totalsubsidyatoms"`
 
 	OwnMempoolTix  uint32 `json:"ownmempooltix"`
 	Immature       uint32 `json:"immature"`
@@ -118,16 +253,21 @@ type GetTransactionDetailsResult struct {
 	Account           string   `json:"account"`
 	Address           string   `json:"address,omitempty"`
 	Amount            float64  `json:"amount"`
+	AmountAtoms       int64    `json:"amountatoms"`
 	Category          string   `json:"category"`
 	InvolvesWatchOnly bool     `json:"involveswatchonly,omitempty"`
 	Fee               *float64 `json:"fee,omitempty"`
+	FeeAtoms          *int64   `json:"feeatoms,omitempty"`
 	Vout              uint32   `json:"vout"`
+	Label             string   `json:"label,omitempty"`
 }
 
 // GetTransactionResult models the data from the gettransaction command.
 type GetTransactionResult struct {
 	Amount          float64                       `json:"amount"`
+	AmountAtoms     int64                         `json:"amountatoms"`
 	Fee             float64                       `json:"fee,omitempty"`
+	FeeAtoms        int64                         `json:"feeatoms,omitempty"`
 	Confirmations   int64                         `json:"confirmations"`
 	BlockHash       string                        `json:"blockhash"`
 	BlockIndex      int64                         `json:"blockindex"`
@@ -139,6 +279,7 @@ type GetTransactionResult struct {
 	Details         []GetTransactionDetailsResult `json:"details"`
 	Hex             string                        `json:"hex"`
 	Type            string                        `json:"type"`
+	Tree            int8                          `json:"tree"`
 	TicketStatus    string                        `json:"ticketstatus,omitempty"`
 }
 
@@ -157,12 +298,41 @@ type VoteChoice struct {
 	ChoiceDescription string `json:"choicedescription,omitempty"`
 }
 
+// GetUTXOStatsResult models the data returned by the getutxostats command for
+// each account.
+type GetUTXOStatsResult struct {
+	AccountName      string  `json:"accountname"`
+	Count            int     `json:"count"`
+	DustCount        int     `json:"dustcount"`
+	Total            float64 `json:"total"`
+	Median           float64 `json:"median"`
+	ConsolidationFee float64 `json:"consolidationfee"`
+}
+
 // GetVoteChoicesResult models the data returned by the getvotechoices command.
 type GetVoteChoicesResult struct {
 	Version uint32       `json:"version"`
 	Choices []VoteChoice `json:"choices"`
 }
 
+// ImportLegacyKeysResult models the data returned by the importlegacykeys
+// command.
+type ImportLegacyKeysResult struct {
+	Imported   []string `json:"imported"`
+	Existing   int      `json:"existing"`
+	RescanFrom int32    `json:"rescanfrom"`
+}
+
+// VerifyExternalAddressResult models the data returned by the
+// verifyexternaladdress command.
+type VerifyExternalAddressResult struct {
+	Address   string `json:"address"`
+	Account   uint32 `json:"account"`
+	Branch    uint32 `json:"branch"`
+	Index     uint32 `json:"index"`
+	Confirmed bool   `json:"confirmed"`
+}
+
 // SyncStatusResult models the data returned by the syncstatus command.
 type SyncStatusResult struct {
 	Synced               bool    `json:"synced"`
@@ -177,6 +347,7 @@ type InfoResult struct {
 	ProtocolVersion int32   `json:"protocolversion"`
 	WalletVersion   int32   `json:"walletversion"`
 	Balance         float64 `json:"balance"`
+	BalanceAtoms    int64   `json:"balanceatoms"`
 	Blocks          int32   `json:"blocks"`
 	TimeOffset      int64   `json:"timeoffset"`
 	Connections     int32   `json:"connections"`
@@ -187,7 +358,9 @@ type InfoResult struct {
 	KeypoolSize     int32   `json:"keypoolsize"`
 	UnlockedUntil   int64   `json:"unlocked_until"`
 	PaytxFee        float64 `json:"paytxfee"`
+	PaytxFeeAtoms   int64   `json:"paytxfeeatoms"`
 	RelayFee        float64 `json:"relayfee"`
+	RelayFeeAtoms   int64   `json:"relayfeeatoms"`
 	Errors          string  `json:"errors"`
 }
 
@@ -217,22 +390,26 @@ type ListTransactionsResult struct {
 	Account           string                  `json:"account"`
 	Address           string                  `json:"address,omitempty"`
 	Amount            float64                 `json:"amount"`
+	AmountAtoms       int64                   `json:"amountatoms"`
 	BlockHash         string                  `json:"blockhash,omitempty"`
 	BlockIndex        *int64                  `json:"blockindex,omitempty"`
 	BlockTime         int64                   `json:"blocktime,omitempty"`
 	Category          string                  `json:"category"`
 	Confirmations     int64                   `json:"confirmations"`
 	Fee               *float64                `json:"fee,omitempty"`
+	FeeAtoms          *int64                  `json:"feeatoms,omitempty"`
 	Generated         bool                    `json:"generated,omitempty"`
 	InvolvesWatchOnly bool                    `json:"involveswatchonly,omitempty"`
 	Time              int64                   `json:"time"`
 	TimeReceived      int64                   `json:"timereceived"`
 	TxID              string                  `json:"txid"`
 	TxType            *ListTransactionsTxType `json:"txtype,omitempty"`
+	Tree              int8                    `json:"tree"`
 	Vout              uint32                  `json:"vout"`
 	WalletConflicts   []string                `json:"walletconflicts"`
 	Comment           string                  `json:"comment,omitempty"`
 	OtherAccount      string                  `json:"otheraccount,omitempty"`
+	MaturityHeight    int64                   `json:"maturityheight,omitempty"`
 }
 
 // ListReceivedByAccountResult models the data from the listreceivedbyaccount
@@ -240,6 +417,7 @@ type ListTransactionsResult struct {
 type ListReceivedByAccountResult struct {
 	Account       string  `json:"account"`
 	Amount        float64 `json:"amount"`
+	AmountAtoms   int64   `json:"amountatoms"`
 	Confirmations uint64  `json:"confirmations"`
 }
 
@@ -249,6 +427,7 @@ type ListReceivedByAddressResult struct {
 	Account           string   `json:"account"`
 	Address           string   `json:"address"`
 	Amount            float64  `json:"amount"`
+	AmountAtoms       int64    `json:"amountatoms"`
 	Confirmations     uint64   `json:"confirmations"`
 	TxIDs             []string `json:"txids,omitempty"`
 	InvolvesWatchonly bool     `json:"involvesWatchonly,omitempty"`
@@ -272,10 +451,49 @@ type ListUnspentResult struct {
 	ScriptPubKey  string  `json:"scriptPubKey"`
 	RedeemScript  string  `json:"redeemScript,omitempty"`
 	Amount        float64 `json:"amount"`
+	AmountAtoms   int64   `json:"amountatoms"`
 	Confirmations int64   `json:"confirmations"`
 	Spendable     bool    `json:"spendable"`
 }
 
+// ListVSPTicketsResult models the tickets associated with a single VSP
+// returned by the listvsptickets command.
+type ListVSPTicketsResult struct {
+	Host     string   `json:"host"`
+	Immature int      `json:"immature"`
+	Live     int      `json:"live"`
+	Voted    int      `json:"voted"`
+	Missed   int      `json:"missed"`
+	Expired  int      `json:"expired"`
+	Unspent  int      `json:"unspent"`
+	Tickets  []string `json:"tickets"`
+}
+
+// PaymentTemplateOutput describes an output paid by a payment template.
+type PaymentTemplateOutput struct {
+	Address     string  `json:"address"`
+	Amount      float64 `json:"amount"`
+	AmountAtoms int64   `json:"amountatoms"`
+}
+
+// PaymentTemplateResult models the data returned by the listpaymenttemplates
+// command.
+type PaymentTemplateResult struct {
+	Name       string                  `json:"name"`
+	Account    string                  `json:"account"`
+	Outputs    []PaymentTemplateOutput `json:"outputs"`
+	MinConf    int32                   `json:"minconf"`
+	Interval   int32                   `json:"interval"`
+	NextHeight int32                   `json:"nextheight,omitempty"`
+}
+
+// PurchaseTicketVerboseResult models the data returned by the purchaseticket
+// command when verbose results are requested.
+type PurchaseTicketVerboseResult struct {
+	SplitTx *AuthoredTxResult  `json:"splittx,omitempty"`
+	Tickets []AuthoredTxResult `json:"tickets"`
+}
+
 // RedeemMultiSigOutResult models the data returned from the redeemmultisigout
 // command.
 type RedeemMultiSigOutResult struct {
@@ -290,6 +508,52 @@ type RedeemMultiSigOutsResult struct {
 	Results []RedeemMultiSigOutResult `json:"results"`
 }
 
+// ReloadConfigResult models the data returned by the reloadconfig command.
+type ReloadConfigResult struct {
+	Applied         []string              `json:"applied"`
+	RestartRequired []string              `json:"restartrequired"`
+	Failed          []ReloadConfigFailure `json:"failed"`
+}
+
+// ReloadConfigFailure describes a changed configuration option which could
+// not be applied by the reloadconfig command.
+type ReloadConfigFailure struct {
+	Option string `json:"option"`
+	Error  string `json:"error"`
+}
+
+// ResendTransactionResult models the data returned from the resendtransaction
+// command.
+type ResendTransactionResult struct {
+	TxHash  string `json:"txhash"`
+	Relayed bool   `json:"relayed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// SelfCheckDiscrepancy describes a single difference between the wallet's
+// transaction store and the state re-derived by the selfcheck command.
+type SelfCheckDiscrepancy struct {
+	Bucket        string  `json:"bucket"`
+	OutPoint      string  `json:"outpoint,omitempty"`
+	Expected      float64 `json:"expected"`
+	ExpectedAtoms int64   `json:"expectedatoms"`
+	Actual        float64 `json:"actual"`
+	ActualAtoms   int64   `json:"actualatoms"`
+	Reason        string  `json:"reason"`
+}
+
+// SelfCheckResult models the data returned from the selfcheck command.
+type SelfCheckResult struct {
+	ScannedThrough       int32                  `json:"scannedthrough"`
+	ExpectedBalance      float64                `json:"expectedbalance"`
+	ExpectedBalanceAtoms int64                  `json:"expectedbalanceatoms"`
+	ActualBalance        float64                `json:"actualbalance"`
+	ActualBalanceAtoms   int64                  `json:"actualbalanceatoms"`
+	ExpectedUnspent      int                    `json:"expectedunspent"`
+	ActualUnspent        int                    `json:"actualunspent"`
+	Discrepancies        []SelfCheckDiscrepancy `json:"discrepancies"`
+}
+
 // SendToMultiSigResult models the data returned from the sendtomultisig
 // command.
 type SendToMultiSigResult struct {
@@ -330,27 +594,67 @@ type SignRawTransactionsResult struct {
 	Results []SignedTransaction `json:"results"`
 }
 
+// SimnetFundAccountResult models the data from the simnetfundaccount command.
+type SimnetFundAccountResult struct {
+	TxHash    string `json:"txhash"`
+	BlockHash string `json:"blockhash"`
+}
+
+// SimnetReorgResult models the data from the simnetreorg command.
+type SimnetReorgResult struct {
+	OldTip string `json:"oldtip"`
+	NewTip string `json:"newtip"`
+	Height int32  `json:"height"`
+}
+
+// PlanSweepResult models the data returned from the plansweep command.
+type PlanSweepResult struct {
+	PlanID       string                 `json:"planid"`
+	Transactions []SweepPlanTransaction `json:"transactions"`
+	TotalInput   float64                `json:"totalinput"`
+	TotalFee     float64                `json:"totalfee"`
+	Uneconomic   int                    `json:"uneconomic"`
+}
+
+// SweepPlanTransaction describes a single transaction of a sweep plan.
+type SweepPlanTransaction struct {
+	TxHash     string  `json:"txhash"`
+	Hex        string  `json:"hex"`
+	Inputs     int     `json:"inputs"`
+	TotalInput float64 `json:"totalinput"`
+	Fee        float64 `json:"fee"`
+	Amount     float64 `json:"amount"`
+}
+
 // SweepAccountResult models the data returned from the sweepaccount
 // command.
 type SweepAccountResult struct {
-	UnsignedTransaction       string  `json:"unsignedtransaction"`
-	TotalPreviousOutputAmount float64 `json:"totalpreviousoutputamount"`
-	TotalOutputAmount         float64 `json:"totaloutputamount"`
-	EstimatedSignedSize       uint32  `json:"estimatedsignedsize"`
+	UnsignedTransaction            string  `json:"unsignedtransaction"`
+	TotalPreviousOutputAmount      float64 `json:"totalpreviousoutputamount"`
+	TotalPreviousOutputAmountAtoms int64   `json:"totalpreviousoutputamountatoms"`
+	TotalOutputAmount              float64 `json:"totaloutputamount"`
+	TotalOutputAmountAtoms         int64   `json:"totaloutputamountatoms"`
+	EstimatedSignedSize            uint32  `json:"estimatedsignedsize"`
 }
 
 // TicketInfoResult models the data returned from the ticketinfo command.
 type TicketInfoResult struct {
-	Hash          string       `json:"hash"`
-	Cost          float64      `json:"cost"`
-	VotingAddress string       `json:"votingaddress"`
-	Status        string       `json:"status"`
-	BlockHash     string       `json:"blockhash,omitempty"`
-	BlockHeight   int32        `json:"blockheight"`
-	Vote          string       `json:"vote,omitempty"`
-	Revocation    string       `json:"revocation,omitempty"`
-	Choices       []VoteChoice `json:"choices,omitempty"`
-	VSPHost       string       `json:"vsphost,omitempty"`
+	Hash                string       `json:"hash"`
+	Cost                float64      `json:"cost"`
+	CostAtoms           int64        `json:"costatoms"`
+	VotingAddress       string       `json:"votingaddress"`
+	Status              string       `json:"status"`
+	BlockHash           string       `json:"blockhash,omitempty"`
+	BlockHeight         int32        `json:"blockheight"`
+	Vote                string       `json:"vote,omitempty"`
+	Revocation          string       `json:"revocation,omitempty"`
+	Choices             []VoteChoice `json:"choices,omitempty"`
+	VSPHost             string       `json:"vsphost,omitempty"`
+	VSPFeeHash          string       `json:"vspfeehash,omitempty"`
+	VSPFee              float64      `json:"vspfee,omitempty"`
+	VSPFeeAtoms         int64        `json:"vspfeeatoms,omitempty"`
+	VSPFeeStatus        string       `json:"vspfeestatus,omitempty"`
+	VSPFeeConfirmations int32        `json:"vspfeeconfirmations,omitempty"`
 }
 
 // TreasuryPolicyResult models objects returned by the treasurypolicy command.
@@ -391,21 +695,61 @@ type ValidateAddressResult struct {
 // ValidateAddressWalletResult aliases ValidateAddressResult.
 type ValidateAddressWalletResult = ValidateAddressResult
 
+// WalletEventResult models a recorded event returned by the listwalletevents
+// command.
+type WalletEventResult struct {
+	Seq    uint64 `json:"seq"`
+	Time   int64  `json:"time"`
+	Kind   string `json:"kind"`
+	Height int32  `json:"height"`
+	Hash   string `json:"hash,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
 // WalletInfoResult models the data returned from the walletinfo command.
 type WalletInfoResult struct {
-	DaemonConnected  bool    `json:"daemonconnected"`
-	SPV              bool    `json:"spv"`
-	Unlocked         bool    `json:"unlocked"`
-	CoinType         uint32  `json:"cointype,omitempty"`
-	TxFee            float64 `json:"txfee"`
-	VoteBits         uint16  `json:"votebits"`
-	VoteBitsExtended string  `json:"votebitsextended"`
-	VoteVersion      uint32  `json:"voteversion"`
-	Voting           bool    `json:"voting"`
-	VSP              string  `json:"vsp"`
-	ManualTickets    bool    `json:"manualtickets"`
-	BirthHash        string  `json:"birthhash"`
-	BirthHeight      uint32  `json:"birthheight"`
+	DaemonConnected     bool    `json:"daemonconnected"`
+	SPV                 bool    `json:"spv"`
+	Unlocked            bool    `json:"unlocked"`
+	CoinType            uint32  `json:"cointype,omitempty"`
+	TxFee               float64 `json:"txfee"`
+	TxFeeAtoms          int64   `json:"txfeeatoms"`
+	VoteBits            uint16  `json:"votebits"`
+	VoteBitsExtended    string  `json:"votebitsextended"`
+	VoteVersion         uint32  `json:"voteversion"`
+	NetworkStakeVersion uint32  `json:"networkstakeversion"`
+	VoteVersionOutdated bool    `json:"voteversionoutdated"`
+	Voting              bool    `json:"voting"`
+	VSP                 string  `json:"vsp"`
+	ManualTickets       bool    `json:"manualtickets"`
+	BirthHash           string  `json:"birthhash"`
+	BirthHeight         uint32  `json:"birthheight"`
+
+	TipChecks  []TipCheckResult  `json:"tipchecks,omitempty"`
+	Subsystems []SubsystemResult `json:"subsystems,omitempty"`
+}
+
+// TipCheckResult models the most recent cross-check of the wallet's main
+// chain tip against an additional tip source, returned by the walletinfo
+// command.
+type TipCheckResult struct {
+	Source       string `json:"source"`
+	Time         int64  `json:"time"`
+	Height       int32  `json:"height"`
+	WalletHeight int32  `json:"walletheight"`
+	Diverged     bool   `json:"diverged"`
+	Error        string `json:"error,omitempty"`
+}
+
+// SubsystemResult models the status of a supervised long-running subsystem,
+// returned by the walletinfo command.
+type SubsystemResult struct {
+	Name          string `json:"name"`
+	State         string `json:"state"`
+	Started       int64  `json:"started"`
+	Restarts      uint32 `json:"restarts"`
+	LastError     string `json:"lasterror,omitempty"`
+	LastErrorTime int64  `json:"lasterrortime,omitempty"`
 }
 
 // AccountUnlockedResult models the data returned by the accountunlocked
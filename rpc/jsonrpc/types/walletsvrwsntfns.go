@@ -0,0 +1,65 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// NOTE: This file is intended to house the RPC websocket notifications that are
+// supported by a wallet server.
+
+package types
+
+import (
+	"github.com/decred/dcrd/dcrjson/v4"
+)
+
+const (
+	// RescanProgressNtfnMethod is the method used for notifications from
+	// the wallet server that a rescan has completed through a block.
+	RescanProgressNtfnMethod Method = "rescanprogress"
+
+	// RescanFinishedNtfnMethod is the method used for notifications from
+	// the wallet server that a rescan has finished.
+	RescanFinishedNtfnMethod Method = "rescanfinished"
+)
+
+// RescanProgressNtfn is a type handling custom marshaling and unmarshaling of
+// rescanprogress JSON websocket notifications.
+type RescanProgressNtfn struct {
+	Height      int32
+	Percent     float64
+	RelevantTxs int
+}
+
+// NewRescanProgressNtfn returns a new instance which can be used to issue a
+// rescanprogress JSON-RPC notification.
+func NewRescanProgressNtfn(height int32, percent float64, relevantTxs int) *RescanProgressNtfn {
+	return &RescanProgressNtfn{
+		Height:      height,
+		Percent:     percent,
+		RelevantTxs: relevantTxs,
+	}
+}
+
+// RescanFinishedNtfn is a type handling custom marshaling and unmarshaling of
+// rescanfinished JSON websocket notifications.
+type RescanFinishedNtfn struct {
+	Height      int32
+	RelevantTxs int
+}
+
+// NewRescanFinishedNtfn returns a new instance which can be used to issue a
+// rescanfinished JSON-RPC notification.
+func NewRescanFinishedNtfn(height int32, relevantTxs int) *RescanFinishedNtfn {
+	return &RescanFinishedNtfn{
+		Height:      height,
+		RelevantTxs: relevantTxs,
+	}
+}
+
+func init() {
+	// The commands in this file are only usable by websockets and are
+	// notifications.
+	flags := dcrjson.UFWebsocketOnly | dcrjson.UFNotification
+
+	dcrjson.MustRegister(RescanProgressNtfnMethod, (*RescanProgressNtfn)(nil), flags)
+	dcrjson.MustRegister(RescanFinishedNtfnMethod, (*RescanFinishedNtfn)(nil), flags)
+}
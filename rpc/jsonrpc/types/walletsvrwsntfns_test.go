@@ -0,0 +1,125 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrd/dcrjson/v4"
+)
+
+// TestWalletSvrWsNtfns tests all of the wallet server websocket-specific
+// notifications marshal and unmarshal into valid results.
+func TestWalletSvrWsNtfns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		newNtfn      func() (any, error)
+		staticNtfn   func() any
+		marshalled   string
+		unmarshalled any
+	}{
+		{
+			name: "rescanprogress",
+			newNtfn: func() (any, error) {
+				return dcrjson.NewCmd(RescanProgressNtfnMethod, 100, 50.5, 3)
+			},
+			staticNtfn: func() any {
+				return NewRescanProgressNtfn(100, 50.5, 3)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"rescanprogress","params":[100,50.5,3],"id":null}`,
+			unmarshalled: &RescanProgressNtfn{
+				Height:      100,
+				Percent:     50.5,
+				RelevantTxs: 3,
+			},
+		},
+		{
+			name: "rescanfinished",
+			newNtfn: func() (any, error) {
+				return dcrjson.NewCmd(RescanFinishedNtfnMethod, 200, 7)
+			},
+			staticNtfn: func() any {
+				return NewRescanFinishedNtfn(200, 7)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"rescanfinished","params":[200,7],"id":null}`,
+			unmarshalled: &RescanFinishedNtfn{
+				Height:      200,
+				RelevantTxs: 7,
+			},
+		},
+	}
+
+	t.Logf("Running %d tests", len(tests))
+	for i, test := range tests {
+		// Marshal the notification as created by the new static
+		// creation function.  The ID is nil for notifications.
+		marshalled, err := dcrjson.MarshalCmd("1.0", nil, test.staticNtfn())
+		if err != nil {
+			t.Errorf("MarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !bytes.Equal(marshalled, []byte(test.marshalled)) {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.marshalled)
+			continue
+		}
+
+		// Ensure the notification is created without error via the
+		// generic new notification creation function.
+		cmd, err := test.newNtfn()
+		if err != nil {
+			t.Errorf("Test #%d (%s) unexpected NewCmd error: %v ",
+				i, test.name, err)
+		}
+
+		// Marshal the notification as created by the generic new
+		// notification creation function.
+		marshalled, err = dcrjson.MarshalCmd("1.0", nil, cmd)
+		if err != nil {
+			t.Errorf("MarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !bytes.Equal(marshalled, []byte(test.marshalled)) {
+			t.Errorf("Test #%d (%s) unexpected marshalled data - "+
+				"got %s, want %s", i, test.name, marshalled,
+				test.marshalled)
+			continue
+		}
+
+		var request dcrjson.Request
+		if err := json.Unmarshal(marshalled, &request); err != nil {
+			t.Errorf("Test #%d (%s) unexpected error while "+
+				"unmarshalling JSON-RPC request: %v", i,
+				test.name, err)
+			continue
+		}
+
+		cmd, err = dcrjson.ParseParams(Method(request.Method), request.Params)
+		if err != nil {
+			t.Errorf("UnmarshalCmd #%d (%s) unexpected error: %v", i,
+				test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(cmd, test.unmarshalled) {
+			t.Errorf("Test #%d (%s) unexpected unmarshalled command "+
+				"- got %s, want %s", i, test.name,
+				fmt.Sprintf("(%T) %+[1]v", cmd),
+				fmt.Sprintf("(%T) %+[1]v\n", test.unmarshalled))
+			continue
+		}
+	}
+}
@@ -146,6 +146,7 @@ const (
 	WalletService_TransactionNotifications_FullMethodName    = "/walletrpc.WalletService/TransactionNotifications"
 	WalletService_AccountNotifications_FullMethodName        = "/walletrpc.WalletService/AccountNotifications"
 	WalletService_ConfirmationNotifications_FullMethodName   = "/walletrpc.WalletService/ConfirmationNotifications"
+	WalletService_BalanceNotifications_FullMethodName        = "/walletrpc.WalletService/BalanceNotifications"
 	WalletService_ChangePassphrase_FullMethodName            = "/walletrpc.WalletService/ChangePassphrase"
 	WalletService_RenameAccount_FullMethodName               = "/walletrpc.WalletService/RenameAccount"
 	WalletService_Rescan_FullMethodName                      = "/walletrpc.WalletService/Rescan"
@@ -221,6 +222,7 @@ type WalletServiceClient interface {
 	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionNotificationsResponse], error)
 	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccountNotificationsResponse], error)
 	ConfirmationNotifications(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse], error)
+	BalanceNotifications(ctx context.Context, in *BalanceNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BalanceNotificationsResponse], error)
 	// Control
 	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
 	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
@@ -572,6 +574,25 @@ func (c *walletServiceClient) ConfirmationNotifications(ctx context.Context, opt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type WalletService_ConfirmationNotificationsClient = grpc.BidiStreamingClient[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]
 
+func (c *walletServiceClient) BalanceNotifications(ctx context.Context, in *BalanceNotificationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BalanceNotificationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[6], WalletService_BalanceNotifications_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BalanceNotificationsRequest, BalanceNotificationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_BalanceNotificationsClient = grpc.ServerStreamingClient[BalanceNotificationsResponse]
+
 func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ChangePassphraseResponse)
@@ -594,7 +615,7 @@ func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccou
 
 func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RescanResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[6], WalletService_Rescan_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[7], WalletService_Rescan_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -683,7 +704,7 @@ func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTrans
 
 func (c *walletServiceClient) UnspentOutputs(ctx context.Context, in *UnspentOutputsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UnspentOutputResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[7], WalletService_UnspentOutputs_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[8], WalletService_UnspentOutputs_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1041,6 +1062,7 @@ type WalletServiceServer interface {
 	TransactionNotifications(*TransactionNotificationsRequest, grpc.ServerStreamingServer[TransactionNotificationsResponse]) error
 	AccountNotifications(*AccountNotificationsRequest, grpc.ServerStreamingServer[AccountNotificationsResponse]) error
 	ConfirmationNotifications(grpc.BidiStreamingServer[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]) error
+	BalanceNotifications(*BalanceNotificationsRequest, grpc.ServerStreamingServer[BalanceNotificationsResponse]) error
 	// Control
 	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
 	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
@@ -1169,6 +1191,9 @@ func (UnimplementedWalletServiceServer) AccountNotifications(*AccountNotificatio
 func (UnimplementedWalletServiceServer) ConfirmationNotifications(grpc.BidiStreamingServer[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ConfirmationNotifications not implemented")
 }
+func (UnimplementedWalletServiceServer) BalanceNotifications(*BalanceNotificationsRequest, grpc.ServerStreamingServer[BalanceNotificationsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BalanceNotifications not implemented")
+}
 func (UnimplementedWalletServiceServer) ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassphrase not implemented")
 }
@@ -1720,6 +1745,17 @@ func _WalletService_ConfirmationNotifications_Handler(srv interface{}, stream gr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type WalletService_ConfirmationNotificationsServer = grpc.BidiStreamingServer[ConfirmationNotificationsRequest, ConfirmationNotificationsResponse]
 
+func _WalletService_BalanceNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BalanceNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).BalanceNotifications(m, &grpc.GenericServerStream[BalanceNotificationsRequest, BalanceNotificationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletService_BalanceNotificationsServer = grpc.ServerStreamingServer[BalanceNotificationsResponse]
+
 func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChangePassphraseRequest)
 	if err := dec(in); err != nil {
@@ -2738,6 +2774,11 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "BalanceNotifications",
+			Handler:       _WalletService_BalanceNotifications_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Rescan",
 			Handler:       _WalletService_Rescan_Handler,
@@ -23,10 +23,13 @@ import (
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/cfgutil"
+	"decred.org/dcrwallet/v5/internal/extsigner"
 	"decred.org/dcrwallet/v5/internal/loader"
 	"decred.org/dcrwallet/v5/internal/loggers"
 	"decred.org/dcrwallet/v5/internal/rpc/jsonrpc"
 	"decred.org/dcrwallet/v5/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/v5/internal/supervisor"
+	"decred.org/dcrwallet/v5/wallet"
 	"github.com/decred/dcrd/crypto/rand"
 
 	"google.golang.org/grpc"
@@ -229,7 +232,9 @@ func generateClientKeyPair(caPriv any, ca *x509.Certificate) (cert, key []byte,
 	return cert, key, nil
 }
 
-func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server, error) {
+func startRPCServers(walletLoader *loader.Loader, sup *supervisor.Supervisor,
+	reloader *configReloader) (*grpc.Server, *jsonrpc.Server, error) {
+
 	var jsonrpcAddrNotifier jsonrpcListenerEventServer
 	var grpcAddrNotifier grpcListenerEventServer
 	if cfg.RPCListenerEvents {
@@ -352,6 +357,22 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 		if cfg.JSONRPCAuthType == "basic" {
 			user, pass = cfg.Username, cfg.Password
 		}
+		var signer wallet.ExternalSigner
+		if cfg.ExternalSigner != "" {
+			s, err := extsigner.New(cfg.ExternalSigner)
+			if err != nil {
+				return nil, nil, err
+			}
+			signer = s
+		}
+		var auditLog *jsonrpc.AuditLog
+		if cfg.RPCAuditLog != "" {
+			l, err := jsonrpc.OpenAuditLog(cfg.RPCAuditLog)
+			if err != nil {
+				return nil, nil, err
+			}
+			auditLog = l
+		}
 		opts := jsonrpc.Options{
 			Username:            user,
 			Password:            pass,
@@ -366,6 +387,11 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 			VSPMaxFee:           cfg.VSPOpts.MaxFee.Amount,
 			TicketSplitAccount:  cfg.TicketSplitAccount,
 			Dial:                cfg.dial,
+			ExternalSigner:      signer,
+			AuditLog:            auditLog,
+			OmitFloatAmounts:    cfg.JSONRPCOmitFloats,
+			ReloadConfig:        reloader.reload,
+			Supervisor:          sup,
 		}
 		jsonrpcServer = jsonrpc.NewServer(&opts, activeNet.Params, walletLoader, listeners)
 		for _, lis := range listeners {
@@ -426,7 +452,8 @@ func interceptUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, ha
 	if err != nil {
 		return nil, err
 	}
-	resp, err = handler(ctx, req)
+	// Client requests may spend the outputs of warm accounts.
+	resp, err = handler(wallet.WithManualSpend(ctx), req)
 	if err != nil && ok {
 		loggers.GrpcLog.Errorf("Unary method %s invoked by %s errored: %v",
 			info.FullMethod, p.Addr.String(), err)
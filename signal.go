@@ -20,9 +20,13 @@ var shutdownRequestChannel = make(chan struct{})
 var shutdownSignaled = make(chan struct{})
 
 // signals defines the signals that are handled to do a clean shutdown.
-// Conditional compilation is used to also include SIGTERM and SIGHUP on Unix.
+// Conditional compilation is used to also include SIGTERM on Unix.
 var signals = []os.Signal{os.Interrupt}
 
+// reloadSignals defines the signals that are handled to reload the
+// configuration.  Conditional compilation is used to include SIGHUP on Unix.
+var reloadSignals []os.Signal
+
 // withShutdownCancel creates a copy of a context that is cancelled whenever
 // shutdown is invoked through an interrupt signal or from an JSON-RPC stop
 // request.
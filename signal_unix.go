@@ -16,6 +16,6 @@ func init() {
 	signals = []os.Signal{
 		os.Interrupt,
 		syscall.SIGTERM,
-		syscall.SIGHUP,
 	}
+	reloadSignals = []os.Signal{syscall.SIGHUP}
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"sort"
 	"sync"
 	"time"
 
@@ -489,8 +490,6 @@ func (s *Syncer) PublishMixMessages(ctx context.Context, msgs ...mixing.Message)
 
 // Rescan implements the Rescan method of the wallet.NetworkBackend interface.
 func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, save func(*chainhash.Hash, []*wire.MsgTx) error) error {
-	const op errors.Op = "spv.Rescan"
-
 	cfilters := make([]*gcs.FilterV2, 0, len(blockHashes))
 	cfilterKeys := make([][gcs.KeySize]byte, 0, len(blockHashes))
 	for i := 0; i < len(blockHashes); i++ {
@@ -502,14 +501,11 @@ func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, save
 		cfilterKeys = append(cfilterKeys, k)
 	}
 
-	blockMatches := make([]*wire.MsgBlock, len(blockHashes)) // Block assigned to slice once fetched
-
 	// Read current filter data.
 	s.filterMu.Lock()
 	filterData := s.filterData
 	s.filterMu.Unlock()
 
-	var fmatches []*chainhash.Hash
 	var fmatchidx []int
 	var fmatchMu sync.Mutex
 
@@ -521,12 +517,10 @@ func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, save
 	for i := 0; i < ncpu; i++ {
 		go func() {
 			for i := range c {
-				blockHash := &blockHashes[i]
 				key := cfilterKeys[i]
 				f := cfilters[i]
 				if f.MatchAny(key, filterData) {
 					fmatchMu.Lock()
-					fmatches = append(fmatches, blockHash)
 					fmatchidx = append(fmatchidx, i)
 					fmatchMu.Unlock()
 				}
@@ -535,80 +529,167 @@ func (s *Syncer) Rescan(ctx context.Context, blockHashes []chainhash.Hash, save
 		}()
 	}
 	for i := 0; i < len(blockHashes); i++ {
-		if blockMatches[i] != nil {
-			// Already fetched this block
-			continue
-		}
 		c <- i
 	}
 	close(c)
 	wg.Wait()
 
-	if len(fmatches) != 0 {
-	PickPeer:
-		for {
+	if len(fmatchidx) == 0 {
+		return nil
+	}
+
+	// Blocks must be rescanned in order, as transactions in later blocks
+	// may spend outputs that are only added to the rescan filter while
+	// processing earlier blocks.
+	sort.Ints(fmatchidx)
+
+	// Split the matching blocks into batches which are fetched from peers
+	// concurrently and processed in order.
+	nbatches := (len(fmatchidx) + rescanFetchBatch - 1) / rescanFetchBatch
+	batch := func(b int) []int {
+		end := min((b+1)*rescanFetchBatch, len(fmatchidx))
+		return fmatchidx[b*rescanFetchBatch : end]
+	}
+	fetch := func(ctx context.Context, b int) ([]*wire.MsgBlock, error) {
+		idx := batch(b)
+		hashes := make([]*chainhash.Hash, len(idx))
+		for j, i := range idx {
+			hashes[j] = &blockHashes[i]
+		}
+		return s.fetchRescanBlocks(ctx, hashes)
+	}
+	process := func(ctx context.Context, b int, blocks []*wire.MsgBlock) error {
+		idx := batch(b)
+		for j, block := range blocks {
 			if err := ctx.Err(); err != nil {
 				return err
 			}
-			rp, err := s.waitForRemote(ctx, pickAny, true)
-			if err != nil {
-				return err
-			}
 
-			blocks, err := rp.Blocks(ctx, fmatches)
-			if err != nil {
-				continue PickPeer
-			}
-
-			for j, b := range blocks {
-				// Validate fetched blocks before rescanning transactions.  PoW
-				// and PoS difficulties have already been validated since the
-				// header is saved by the wallet, and modifications to these in
-				// the downloaded block would result in a different block hash
-				// and failure to fetch the block.
-				//
-				// Block filters were also validated
-				// against the header (assuming dcp0005
-				// was activated).
-				err = validate.MerkleRoots(b)
+			matchedTxs := s.rescanBlock(block)
+			if len(matchedTxs) != 0 {
+				err := save(&blockHashes[idx[j]], matchedTxs)
 				if err != nil {
-					err = validate.DCP0005MerkleRoot(b)
+					return err
 				}
-				if err != nil {
-					err := errors.E(op, err)
-					rp.Disconnect(err)
-					rp = nil
-					continue PickPeer
-				}
-
-				i := fmatchidx[j]
-				blockMatches[i] = b
 			}
-			break
 		}
+		return nil
 	}
+	return fetchOrdered(ctx, nbatches, s.rescanFetchers, fetch, process)
+}
 
-	for i := 0; i < len(blockMatches); i++ {
-		b := blockMatches[i]
-		if b == nil {
-			// No filter match, skip block
-			continue
+// fetchOrdered calls fetch for each batch number in [0, nbatches), with up to
+// limit batches fetched concurrently, and calls process with the fetched
+// blocks of each batch in batch order.  Up to limit batches may be in flight
+// or waiting to be processed at any time, which bounds the memory used by
+// downloaded but not yet processed blocks.  The first error returned by fetch
+// or process, or the context error, is returned after canceling the context
+// passed to any remaining fetches and waiting for them to return.
+func fetchOrdered(ctx context.Context, nbatches, limit int,
+	fetch func(ctx context.Context, batch int) ([]*wire.MsgBlock, error),
+	process func(ctx context.Context, batch int, blocks []*wire.MsgBlock) error) error {
+
+	type fetchResult struct {
+		blocks []*wire.MsgBlock
+		err    error
+	}
+	results := make([]chan fetchResult, nbatches)
+	for i := range results {
+		results[i] = make(chan fetchResult, 1)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	sem := make(chan struct{}, max(limit, 1))
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for b := range results {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				blocks, err := fetch(ctx, b)
+				results[b] <- fetchResult{blocks, err}
+			}()
+		}
+	}()
+
+	for b := range results {
+		var res fetchResult
+		select {
+		case res = <-results[b]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if res.err != nil {
+			return res.err
+		}
+		if err := process(ctx, b, res.blocks); err != nil {
+			return err
 		}
 
+		// Allow another batch to be fetched.
+		<-sem
+	}
+
+	return nil
+}
+
+// fetchRescanBlocks fetches and validates the blocks to be rescanned from any
+// remote peer, retrying with other peers until the blocks are fetched or the
+// context is cancelled.
+func (s *Syncer) fetchRescanBlocks(ctx context.Context, hashes []*chainhash.Hash) ([]*wire.MsgBlock, error) {
+	const op errors.Op = "spv.Rescan"
+
+PickPeer:
+	for {
 		if err := ctx.Err(); err != nil {
-			return err
+			return nil, err
+		}
+		rp, err := s.waitForRemote(ctx, pickAny, true)
+		if err != nil {
+			return nil, err
 		}
 
-		matchedTxs := s.rescanBlock(b)
-		if len(matchedTxs) != 0 {
-			err := save(&blockHashes[i], matchedTxs)
+		blocks, err := rp.Blocks(ctx, hashes)
+		if err != nil {
+			continue PickPeer
+		}
+
+		for _, b := range blocks {
+			// Validate fetched blocks before rescanning transactions.  PoW
+			// and PoS difficulties have already been validated since the
+			// header is saved by the wallet, and modifications to these in
+			// the downloaded block would result in a different block hash
+			// and failure to fetch the block.
+			//
+			// Block filters were also validated
+			// against the header (assuming dcp0005
+			// was activated).
+			err = validate.MerkleRoots(b)
 			if err != nil {
-				return err
+				err = validate.DCP0005MerkleRoot(b)
+			}
+			if err != nil {
+				err := errors.E(op, err)
+				rp.Disconnect(err)
+				continue PickPeer
 			}
 		}
-	}
 
-	return nil
+		return blocks, nil
+	}
 }
 
 // StakeDifficulty implements the StakeDifficulty method of the
@@ -0,0 +1,123 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spv
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+)
+
+func TestFetchOrdered(t *testing.T) {
+	t.Parallel()
+
+	const nbatches, limit = 50, 4
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	fetch := func(ctx context.Context, b int) ([]*wire.MsgBlock, error) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		// Finish fetches out of order.
+		time.Sleep(time.Duration(rand.Intn(2000)) * time.Microsecond)
+		block := &wire.MsgBlock{Header: wire.BlockHeader{Height: uint32(b)}}
+		return []*wire.MsgBlock{block}, nil
+	}
+	var processed []int
+	process := func(ctx context.Context, b int, blocks []*wire.MsgBlock) error {
+		if int(blocks[0].Header.Height) != b {
+			t.Errorf("batch %d processed blocks of batch %d", b,
+				blocks[0].Header.Height)
+		}
+		processed = append(processed, b)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return nil
+	}
+
+	err := fetchOrdered(context.Background(), nbatches, limit, fetch, process)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(processed) != nbatches {
+		t.Fatalf("processed %d batches, want %d", len(processed), nbatches)
+	}
+	for i, b := range processed {
+		if b != i {
+			t.Fatalf("batch %d processed at position %d", b, i)
+		}
+	}
+	if maxInFlight > limit {
+		t.Errorf("%d batches in flight, limit is %d", maxInFlight, limit)
+	}
+}
+
+func TestFetchOrderedCancel(t *testing.T) {
+	t.Parallel()
+
+	errProcess := errors.New("process failed")
+	tests := []struct {
+		name    string
+		fetch   func(ctx context.Context, b int) ([]*wire.MsgBlock, error)
+		process func(ctx context.Context, b int, blocks []*wire.MsgBlock) error
+		cancel  bool
+		want    error
+	}{{
+		name: "process error",
+		fetch: func(ctx context.Context, b int) ([]*wire.MsgBlock, error) {
+			return nil, nil
+		},
+		process: func(ctx context.Context, b int, blocks []*wire.MsgBlock) error {
+			if b == 2 {
+				return errProcess
+			}
+			return nil
+		},
+		want: errProcess,
+	}, {
+		name: "canceled while fetching",
+		fetch: func(ctx context.Context, b int) ([]*wire.MsgBlock, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		process: func(ctx context.Context, b int, blocks []*wire.MsgBlock) error {
+			return nil
+		},
+		cancel: true,
+		want:   context.Canceled,
+	}}
+	for _, test := range tests {
+		ctx, cancel := context.WithCancel(context.Background())
+		var started, returned atomic.Int32
+		fetch := func(ctx context.Context, b int) ([]*wire.MsgBlock, error) {
+			started.Add(1)
+			defer returned.Add(1)
+			return test.fetch(ctx, b)
+		}
+		if test.cancel {
+			time.AfterFunc(10*time.Millisecond, cancel)
+		}
+		err := fetchOrdered(ctx, 20, 3, fetch, test.process)
+		cancel()
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: error %v, want %v", test.name, err, test.want)
+		}
+
+		// No fetch may still be running after fetchOrdered returns.
+		if s, r := started.Load(), returned.Load(); s != r {
+			t.Errorf("%s: %d fetches started but %d returned", test.name, s, r)
+		}
+	}
+}
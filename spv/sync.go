@@ -43,6 +43,14 @@ const (
 	minVersionTarget = 3
 )
 
+// rescanFetchBatch is the maximum number of blocks requested from a single
+// peer by each concurrent fetch performed during a rescan.
+const rescanFetchBatch = 64
+
+// DefaultRescanFetchers is the default number of block batches that may be
+// fetched concurrently during a rescan.
+const DefaultRescanFetchers = 4
+
 // Syncer implements wallet synchronization services by over the Decred wire
 // protocol using Simplified Payment Verification (SPV) with compact filters.
 type Syncer struct {
@@ -58,6 +66,10 @@ type Syncer struct {
 
 	persistentPeers []string
 
+	// rescanFetchers limits the number of concurrent block fetches (and
+	// fetched but not yet processed batches of blocks) during rescans.
+	rescanFetchers int
+
 	connectingRemotes map[string]struct{}
 	remotes           map[string]*p2p.RemotePeer
 	remoteAvailable   chan struct{}
@@ -142,6 +154,7 @@ func NewSyncer(w *wallet.Wallet, lp *p2p.LocalPeer) *Syncer {
 		lp:                lp,
 		mempoolAdds:       make(chan *chainhash.Hash),
 		initialSyncDone:   make(chan struct{}),
+		rescanFetchers:    DefaultRescanFetchers,
 	}
 }
 
@@ -151,6 +164,15 @@ func (s *Syncer) SetPersistentPeers(peers []string) {
 	s.persistentPeers = peers
 }
 
+// SetRescanFetchers sets the maximum number of block batches that may be
+// fetched from peers concurrently during a rescan.  Values less than one are
+// ignored.  This must be called before Run.
+func (s *Syncer) SetRescanFetchers(n int) {
+	if n > 0 {
+		s.rescanFetchers = n
+	}
+}
+
 // SetNotifications sets the possible various callbacks that are used
 // to notify interested parties to the syncing progress.
 func (s *Syncer) SetNotifications(ntfns *Notifications) {
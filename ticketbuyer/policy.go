@@ -0,0 +1,137 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"decred.org/dcrwallet/v5/errors"
+	blockchain "github.com/decred/dcrd/blockchain/standalone/v2"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// PurchaseInfo describes a pending ticket purchase.  It is passed to a Policy
+// to decide whether the purchase should proceed.  All amounts are in atoms.
+type PurchaseInfo struct {
+	// Height of the current main chain tip block.
+	Height int32 `json:"height"`
+
+	// StakeDifficulty is the price of each ticket.
+	StakeDifficulty dcrutil.Amount `json:"stakedifficulty"`
+
+	// VoteSubsidy is the stake subsidy paid to each vote at the next
+	// block height.
+	VoteSubsidy dcrutil.Amount `json:"votesubsidy"`
+
+	// Count is the number of tickets that will be purchased.
+	Count int `json:"count"`
+}
+
+// Policy decides whether the ticket buyer should purchase tickets.  Policies
+// allow the purchasing decision to be made by external price models without
+// modifying the ticket buyer.
+type Policy interface {
+	ShouldBuy(ctx context.Context, info *PurchaseInfo) (bool, error)
+}
+
+// LimitPolicy is a Policy which rejects purchases when the ticket price is
+// above a maximum price, or when the return of a single vote relative to the
+// ticket price is below a minimum.  Zero values disable each check.
+type LimitPolicy struct {
+	MaxPrice  dcrutil.Amount
+	MinReturn float64
+}
+
+// ShouldBuy implements the Policy interface.
+func (p *LimitPolicy) ShouldBuy(ctx context.Context, info *PurchaseInfo) (bool, error) {
+	if p.MaxPrice != 0 && info.StakeDifficulty > p.MaxPrice {
+		log.Debugf("Skipping purchase: ticket price %v exceeds maximum %v",
+			info.StakeDifficulty, p.MaxPrice)
+		return false, nil
+	}
+	if p.MinReturn != 0 && info.StakeDifficulty > 0 {
+		r := float64(info.VoteSubsidy) / float64(info.StakeDifficulty)
+		if r < p.MinReturn {
+			log.Debugf("Skipping purchase: vote return %.6f is below "+
+				"minimum %.6f", r, p.MinReturn)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// HTTPPolicy is a Policy which delegates the purchasing decision to an
+// external HTTP service.  The PurchaseInfo is POSTed as a JSON object to the
+// URL, and the service must respond with a JSON object with a boolean "buy"
+// field.
+type HTTPPolicy struct {
+	URL    string
+	Client *http.Client
+}
+
+// ShouldBuy implements the Policy interface.
+func (p *HTTPPolicy) ShouldBuy(ctx context.Context, info *PurchaseInfo) (bool, error) {
+	const op errors.Op = "ticketbuyer.HTTPPolicy.ShouldBuy"
+
+	body, err := json.Marshal(info)
+	if err != nil {
+		return false, errors.E(op, errors.Encoding, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.URL,
+		bytes.NewReader(body))
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, errors.E(op, errors.IO, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.E(op, errors.IO,
+			errors.Errorf("policy server responded with status %q", resp.Status))
+	}
+
+	var res struct {
+		Buy bool `json:"buy"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&res)
+	if err != nil {
+		return false, errors.E(op, errors.Encoding, err)
+	}
+	return res.Buy, nil
+}
+
+var subsidyCache *blockchain.SubsidyCache
+var initSubsidyCacheOnce sync.Once
+
+// voteSubsidy returns the subsidy paid to a single vote at a block height.
+func voteSubsidy(height int32, params *chaincfg.Params,
+	dcp0010Active, dcp0012Active bool) dcrutil.Amount {
+
+	initSubsidyCacheOnce.Do(func() {
+		subsidyCache = blockchain.NewSubsidyCache(params)
+	})
+
+	ssv := blockchain.SSVOriginal
+	switch {
+	case dcp0012Active:
+		ssv = blockchain.SSVDCP0012
+	case dcp0010Active:
+		ssv = blockchain.SSVDCP0010
+	}
+	return dcrutil.Amount(subsidyCache.CalcStakeVoteSubsidyV3(int64(height), ssv))
+}
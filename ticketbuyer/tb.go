@@ -9,6 +9,7 @@ import (
 	"runtime/trace"
 	"sync"
 
+	"decred.org/dcrwallet/v5/deployments"
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/wallet"
 	"github.com/decred/dcrd/dcrutil/v4"
@@ -33,6 +34,24 @@ type Config struct {
 	// Limit maximum number of purchased tickets per block
 	Limit int
 
+	// Skip purchases when fewer than SkipFinalBlocks blocks remain in which
+	// tickets may be mined at the current stake difficulty.  Zero disables
+	// this behavior.
+	SkipFinalBlocks int32
+
+	// Only purchase tickets when fewer than OnlyFinalBlocks blocks remain in
+	// which tickets may be mined at the current stake difficulty.  Zero
+	// disables this behavior.
+	OnlyFinalBlocks int32
+
+	// Minimum number of confirmations of outputs used to fund purchases.
+	// Values below the default minimum (1, or 2 when mixing) are ignored.
+	MinConf int32
+
+	// Only fund purchases with outputs paid to external addresses, never
+	// spending change or the outputs of previous split transactions.
+	ExcludeChange bool
+
 	// CSPP-related options
 	Mixing             bool
 	MixedAccount       uint32
@@ -43,6 +62,10 @@ type Config struct {
 
 	// VSP client
 	VSP *wallet.VSPClient
+
+	// Policies which must all approve of a purchase before any tickets
+	// are bought.
+	Policies []Policy
 }
 
 // TB is an automated ticket buyer, buying as many tickets as possible given an
@@ -163,9 +186,27 @@ func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
 				cfg.Limit = 1
 			}
 
+			// Tickets purchased now must be mined before the expiry
+			// height to be purchased at the current stake difficulty.
+			// Skip purchases when this window is outside the configured
+			// range of blocks.
+			remaining := expiry - height - 1
+			buyTickets := cfg.BuyTickets
+			switch {
+			case cfg.SkipFinalBlocks > 0 && remaining < cfg.SkipFinalBlocks:
+				log.Debugf("Skipping purchase: %d blocks remain before "+
+					"the stake difficulty changes", remaining)
+				buyTickets = false
+			case cfg.OnlyFinalBlocks > 0 && remaining >= cfg.OnlyFinalBlocks:
+				log.Debugf("Skipping purchase: waiting for the final %d "+
+					"blocks before the stake difficulty changes",
+					cfg.OnlyFinalBlocks)
+				buyTickets = false
+			}
+
 			cancelCtx, cancel := context.WithCancel(ctx)
 			cancels = append(cancels, cancel)
-			buyTickets := func() {
+			buy := func() {
 				err := tb.buy(cancelCtx, passphrase, tipHeader, expiry, &cfg)
 				if err != nil {
 					switch {
@@ -184,8 +225,8 @@ func (tb *TB) Run(ctx context.Context, passphrase []byte) error {
 					}
 				}
 			}
-			for i := 0; cfg.BuyTickets && i < multiple; i++ {
-				go buyTickets()
+			for i := 0; buyTickets && i < multiple; i++ {
+				go buy()
 			}
 			go func() {
 				err := tb.mixChange(ctx, &cfg)
@@ -244,26 +285,35 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	if mixing {
 		minconf = 2
 	}
+	if cfg.MinConf > minconf {
+		minconf = cfg.MinConf
+	}
 
 	sdiff, err := w.NextStakeDifficultyAfterHeader(ctx, tip)
 	if err != nil {
 		return err
 	}
 
-	// Determine how many tickets to buy
+	// Determine how many tickets to buy.  Funds reserved for the vote and
+	// revocation fees of current tickets are kept in addition to the
+	// balance to maintain, and each new ticket increases the reserve.
+	reserve, err := w.VoteFeeReserve(ctx)
+	if err != nil {
+		return err
+	}
 	var buy int
-	if maintain != 0 {
+	if maintain != 0 || reserve != 0 {
 		bal, err := w.AccountBalance(ctx, account, minconf)
 		if err != nil {
 			return err
 		}
 		spendable := bal.Spendable
-		if spendable < maintain {
+		if spendable < maintain+reserve {
 			log.Debugf("Skipping purchase: low available balance")
 			return nil
 		}
-		spendable -= maintain
-		buy = int(spendable / sdiff)
+		spendable -= maintain + reserve
+		buy = int(spendable / (sdiff + w.VoteFeeReservePerTicket()))
 		if buy == 0 {
 			log.Debugf("Skipping purchase: low available balance")
 			return nil
@@ -281,11 +331,50 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 		buy = limit
 	}
 
+	if len(cfg.Policies) != 0 {
+		// In SPV mode, DCP0010 and DCP0012 are assumed to have
+		// activated.  In RPC mode the actual activation can be
+		// determined.
+		height := int32(tip.Height)
+		dcp0010Active := true
+		dcp0012Active := true
+		if q, ok := n.(deployments.Querier); ok {
+			dcp0010Active, err = deployments.DCP0010Active(ctx,
+				height, w.ChainParams(), q)
+			if err != nil {
+				return err
+			}
+			dcp0012Active, err = deployments.DCP0012Active(ctx,
+				height, w.ChainParams(), q)
+			if err != nil {
+				return err
+			}
+		}
+		info := &PurchaseInfo{
+			Height:          height,
+			StakeDifficulty: sdiff,
+			VoteSubsidy: voteSubsidy(height+1, w.ChainParams(),
+				dcp0010Active, dcp0012Active),
+			Count: buy,
+		}
+		for _, p := range cfg.Policies {
+			ok, err := p.ShouldBuy(ctx, info)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				log.Debugf("Skipping purchase: rejected by purchase policy")
+				return nil
+			}
+		}
+	}
+
 	purchaseTicketReq := &wallet.PurchaseTicketsRequest{
 		Count:         buy,
 		SourceAccount: account,
 		MinConf:       minconf,
 		Expiry:        expiry,
+		ExcludeChange: cfg.ExcludeChange,
 
 		// CSPP
 		Mixing:             mixing,
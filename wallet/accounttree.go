@@ -0,0 +1,78 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"strings"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// Account names may be hierarchical, with path components separated by
+// udb.AccountNameSeparator (e.g. "business/payroll").  Every hierarchical name
+// identifies a distinct BIP0044 account, and parent accounts need not exist.
+// Account name filters select a subtree of accounts: the filter "*" matches
+// every account, and a filter "parent/*" matches the parent account (if it
+// exists) and all of its descendants.  Any other filter matches only the
+// account with the same name.
+
+// IsAccountNameFilter returns whether s is an account name filter matching
+// more than a single account name.
+func IsAccountNameFilter(s string) bool {
+	return s == "*" || strings.HasSuffix(s, udb.AccountNameSeparator+"*")
+}
+
+// AccountNameMatches returns whether the account name is selected by the
+// account name filter.
+func AccountNameMatches(filter, name string) bool {
+	if filter == "*" {
+		return true
+	}
+	parent, ok := strings.CutSuffix(filter, udb.AccountNameSeparator+"*")
+	if !ok {
+		return name == filter
+	}
+	return name == parent || strings.HasPrefix(name, parent+udb.AccountNameSeparator)
+}
+
+// AccountBalancesMatching returns the balances of all accounts selected by the
+// account name filter.  Summing these balances reports the aggregate balance
+// of a parent account and its descendants.  Returns a NotExist error if no
+// account is selected.
+func (w *Wallet) AccountBalancesMatching(ctx context.Context, filter string,
+	confirms int32) ([]Balances, error) {
+
+	const op errors.Op = "wallet.AccountBalancesMatching"
+	var balances []Balances
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
+			name, err := w.manager.AccountName(addrmgrNs, acct)
+			if err != nil {
+				return err
+			}
+			if !AccountNameMatches(filter, name) {
+				return nil
+			}
+			balance, err := w.txStore.AccountBalance(dbtx, confirms, acct)
+			if err != nil {
+				return err
+			}
+			balances = append(balances, balance)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(balances) == 0 {
+		return nil, errors.E(op, errors.NotExist,
+			errors.Errorf("no accounts match %q", filter))
+	}
+	return balances, nil
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+)
+
+func TestAccountNameMatches(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter, name string
+		match        bool
+	}{
+		{"*", "default", true},
+		{"*", "business/payroll", true},
+		{"business", "business", true},
+		{"business", "business/payroll", false},
+		{"business/*", "business", true},
+		{"business/*", "business/payroll", true},
+		{"business/*", "business/payroll/2024", true},
+		{"business/*", "businesses", false},
+		{"business/*", "businesses/payroll", false},
+		{"business/payroll/*", "business", false},
+		{"business/payroll/*", "business/payroll/2024", true},
+	}
+	for _, test := range tests {
+		if got := AccountNameMatches(test.filter, test.name); got != test.match {
+			t.Errorf("AccountNameMatches(%q, %q) = %v, want %v",
+				test.filter, test.name, got, test.match)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/internal/addrcheck"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// checkContact validates the name and address of an address book contact.
+// Addresses must be valid for the wallet's network.  Names may not themselves
+// be valid addresses, so that a destination is never ambiguous.
+func (w *Wallet) checkContact(name, address string) error {
+	if name == "" {
+		return errors.E(errors.Invalid, "contact name is empty")
+	}
+	if _, err := stdaddr.DecodeAddress(name, w.chainParams); err == nil {
+		return errors.E(errors.Invalid, "contact name may not be an address")
+	}
+	if _, err := addrcheck.Decode(address, w.chainParams); err != nil {
+		return errors.E(errors.Invalid, err)
+	}
+	return nil
+}
+
+// AddContact records a new address book contact naming an external payment
+// address.  Errors with Exist if a contact with the same name is already
+// recorded.
+func (w *Wallet) AddContact(ctx context.Context, name, address string) error {
+	const op errors.Op = "wallet.AddContact"
+	if err := w.checkContact(name, address); err != nil {
+		return errors.E(op, err)
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := udb.ContactByName(dbtx, name)
+		if err == nil {
+			return errors.E(errors.Exist, errors.Errorf(
+				"address book contact %q already exists", name))
+		}
+		if !errors.Is(err, errors.NotExist) {
+			return err
+		}
+		return udb.PutContact(dbtx, &udb.Contact{Name: name, Address: address})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// UpdateContact changes the address of a recorded address book contact.
+func (w *Wallet) UpdateContact(ctx context.Context, name, address string) error {
+	const op errors.Op = "wallet.UpdateContact"
+	if err := w.checkContact(name, address); err != nil {
+		return errors.E(op, err)
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := udb.ContactByName(dbtx, name)
+		if err != nil {
+			return err
+		}
+		return udb.PutContact(dbtx, &udb.Contact{Name: name, Address: address})
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ContactAddress returns the payment address of the named address book
+// contact.
+func (w *Wallet) ContactAddress(ctx context.Context, name string) (stdaddr.Address, error) {
+	const op errors.Op = "wallet.ContactAddress"
+	var c *udb.Contact
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		c, err = udb.ContactByName(dbtx, name)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	addr, err := stdaddr.DecodeAddress(c.Address, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return addr, nil
+}
+
+// Contacts returns all recorded address book contacts, sorted by name.
+func (w *Wallet) Contacts(ctx context.Context) ([]*udb.Contact, error) {
+	const op errors.Op = "wallet.Contacts"
+	var contacts []*udb.Contact
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		contacts, err = udb.Contacts(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return contacts, nil
+}
+
+// DeleteContact removes a recorded address book contact.
+func (w *Wallet) DeleteContact(ctx context.Context, name string) error {
+	const op errors.Op = "wallet.DeleteContact"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeleteContact(dbtx, name)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/decred/dcrd/wire"
+)
+
+// attestationVersion is the version of the serialized attestation message.
+const attestationVersion = 1
+
+// attestationMessageLen is the length of a serialized attestation message:
+// version, network, sequence, time, tip hash, tip height, balances hash,
+// ticket count and previous attestation hash.
+const attestationMessageLen = 1 + 4 + 8 + 8 + 32 + 4 + 32 + 4 + 32
+
+// Attestation is a signed record of the wallet's state, allowing external
+// monitors to detect both wallet downtime (by the age of the latest
+// attestation) and tampering of the reported state (by verifying the
+// signature against a previously recorded public key).  Each attestation
+// commits to the previous one, so a monitor may also detect attestations
+// being replaced or rolled back.
+type Attestation struct {
+	Net          wire.CurrencyNet
+	Sequence     uint64
+	Time         time.Time
+	TipHash      chainhash.Hash
+	TipHeight    int32
+	BalancesHash chainhash.Hash
+	TicketCount  uint32
+	PrevHash     chainhash.Hash
+
+	PubKey    []byte // compressed secp256k1 public key
+	Signature []byte // DER encoded ECDSA signature of Hash
+}
+
+// Message returns the serialized attestation which is signed.  The public key
+// and signature are not part of the message.
+func (a *Attestation) Message() []byte {
+	b := make([]byte, 0, attestationMessageLen)
+	b = append(b, attestationVersion)
+	b = binary.LittleEndian.AppendUint32(b, uint32(a.Net))
+	b = binary.LittleEndian.AppendUint64(b, a.Sequence)
+	b = binary.LittleEndian.AppendUint64(b, uint64(a.Time.Unix()))
+	b = append(b, a.TipHash[:]...)
+	b = binary.LittleEndian.AppendUint32(b, uint32(a.TipHeight))
+	b = append(b, a.BalancesHash[:]...)
+	b = binary.LittleEndian.AppendUint32(b, a.TicketCount)
+	b = append(b, a.PrevHash[:]...)
+	return b
+}
+
+// Hash returns the BLAKE-256 hash of the attestation message.
+func (a *Attestation) Hash() chainhash.Hash {
+	return chainhash.HashH(a.Message())
+}
+
+// Verify checks that the attestation is signed by its public key.
+func (a *Attestation) Verify() error {
+	const op errors.Op = "wallet.Attestation.Verify"
+
+	pubKey, err := secp256k1.ParsePubKey(a.PubKey)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	sig, err := ecdsa.ParseDERSignature(a.Signature)
+	if err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+	hash := a.Hash()
+	if !sig.Verify(hash[:], pubKey) {
+		return errors.E(op, errors.Crypto, "invalid attestation signature")
+	}
+	return nil
+}
+
+func (a *Attestation) serialize() []byte {
+	b := a.Message()
+	b = append(b, a.PubKey...)
+	return append(b, a.Signature...)
+}
+
+func deserializeAttestation(b []byte) (*Attestation, error) {
+	const pubKeyLen = secp256k1.PubKeyBytesLenCompressed
+	if len(b) < attestationMessageLen+pubKeyLen || b[0] != attestationVersion {
+		return nil, errors.E(errors.Encoding, "invalid serialized attestation")
+	}
+	a := &Attestation{
+		Net:         wire.CurrencyNet(binary.LittleEndian.Uint32(b[1:])),
+		Sequence:    binary.LittleEndian.Uint64(b[5:]),
+		Time:        time.Unix(int64(binary.LittleEndian.Uint64(b[13:])), 0),
+		TipHeight:   int32(binary.LittleEndian.Uint32(b[53:])),
+		TicketCount: binary.LittleEndian.Uint32(b[89:]),
+	}
+	copy(a.TipHash[:], b[21:53])
+	copy(a.BalancesHash[:], b[57:89])
+	copy(a.PrevHash[:], b[93:125])
+	a.PubKey = b[attestationMessageLen : attestationMessageLen+pubKeyLen]
+	a.Signature = b[attestationMessageLen+pubKeyLen:]
+	return a, nil
+}
+
+// balancesHash returns a hash committing to the balances of every account.
+func balancesHash(balances []Balances) chainhash.Hash {
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Account < balances[j].Account
+	})
+	buf := new(bytes.Buffer)
+	for i := range balances {
+		b := &balances[i]
+		for _, v := range []uint64{
+			uint64(b.Account),
+			uint64(b.Total),
+			uint64(b.Spendable),
+			uint64(b.ImmatureCoinbaseRewards),
+			uint64(b.ImmatureStakeGeneration),
+			uint64(b.LockedByTickets),
+			uint64(b.VotingAuthority),
+			uint64(b.Unconfirmed),
+		} {
+			buf.Write(binary.LittleEndian.AppendUint64(nil, v))
+		}
+	}
+	return chainhash.HashH(buf.Bytes())
+}
+
+// attestationKey returns the attestation signing key, creating and recording
+// a new key if the wallet does not have one.
+func attestationKey(dbtx walletdb.ReadWriteTx) (*secp256k1.PrivateKey, error) {
+	b, err := udb.AttestationKey(dbtx)
+	if err == nil {
+		return secp256k1.PrivKeyFromBytes(b), nil
+	}
+	if !errors.Is(err, errors.NotExist) {
+		return nil, err
+	}
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	err = udb.PutAttestationKey(dbtx, key.Serialize())
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Attest creates, signs and records a new attestation of the wallet's current
+// main chain tip, account balances and number of unspent tickets.  The
+// signing key is created the first time the wallet attests to its state.
+func (w *Wallet) Attest(ctx context.Context) (*Attestation, error) {
+	const op errors.Op = "wallet.Attest"
+
+	balances, err := w.AccountBalances(ctx, 1)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	stakeInfo, err := w.StakeInfo(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	a := &Attestation{
+		Net:          w.chainParams.Net,
+		Time:         time.Now(),
+		BalancesHash: balancesHash(balances),
+		TicketCount:  stakeInfo.Unspent,
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		a.TipHash, a.TipHeight = w.txStore.MainChainTip(dbtx)
+
+		prev, err := udb.LatestAttestation(dbtx)
+		switch {
+		case errors.Is(err, errors.NotExist):
+		case err != nil:
+			return err
+		default:
+			prevAttestation, err := deserializeAttestation(prev)
+			if err != nil {
+				return err
+			}
+			a.Sequence = prevAttestation.Sequence + 1
+			a.PrevHash = prevAttestation.Hash()
+		}
+
+		key, err := attestationKey(dbtx)
+		if err != nil {
+			return err
+		}
+		hash := a.Hash()
+		a.PubKey = key.PubKey().SerializeCompressed()
+		a.Signature = ecdsa.Sign(key, hash[:]).Serialize()
+		return udb.PutLatestAttestation(dbtx, a.serialize())
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return a, nil
+}
+
+// LatestAttestation returns the most recently recorded attestation.  Errors
+// with NotExist if the wallet has never attested to its state.
+func (w *Wallet) LatestAttestation(ctx context.Context) (*Attestation, error) {
+	const op errors.Op = "wallet.LatestAttestation"
+
+	var a *Attestation
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		b, err := udb.LatestAttestation(dbtx)
+		if err != nil {
+			return err
+		}
+		a, err = deserializeAttestation(b)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return a, nil
+}
+
+// RunAttestations records a new attestation every interval until the context
+// is canceled.  Failures to attest are logged and retried at the next
+// interval.
+func (w *Wallet) RunAttestations(ctx context.Context, interval time.Duration) error {
+	const op errors.Op = "wallet.RunAttestations"
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		a, err := w.Attest(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Errorf("%v: %v", op, err)
+		} else {
+			log.Debugf("Attested wallet state at block %v height %d "+
+				"(sequence %d)", &a.TipHash, a.TipHeight, a.Sequence)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
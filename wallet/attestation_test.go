@@ -0,0 +1,64 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestAttestation(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := &Attestation{
+		Net:          wire.SimNet,
+		Sequence:     7,
+		Time:         time.Unix(1700000000, 0),
+		TipHash:      chainhash.Hash{1},
+		TipHeight:    1234,
+		BalancesHash: balancesHash([]Balances{{Account: 1, Total: 2}, {Account: 0, Total: 1}}),
+		TicketCount:  3,
+		PrevHash:     chainhash.Hash{2},
+	}
+	if n := len(a.Message()); n != attestationMessageLen {
+		t.Fatalf("message length %d, want %d", n, attestationMessageLen)
+	}
+	hash := a.Hash()
+	a.PubKey = key.PubKey().SerializeCompressed()
+	a.Signature = ecdsa.Sign(key, hash[:]).Serialize()
+	if err := a.Verify(); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	b, err := deserializeAttestation(a.serialize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b.Message(), a.Message()) || !b.Time.Equal(a.Time) ||
+		!bytes.Equal(b.PubKey, a.PubKey) || !bytes.Equal(b.Signature, a.Signature) {
+		t.Fatalf("deserialized attestation %+v differs from %+v", b, a)
+	}
+	if err := b.Verify(); err != nil {
+		t.Fatalf("verify deserialized: %v", err)
+	}
+
+	// Balances are committed to independent of account order.
+	if balancesHash([]Balances{{Account: 0, Total: 1}, {Account: 1, Total: 2}}) != a.BalancesHash {
+		t.Fatal("balances hash depends on account order")
+	}
+
+	b.TicketCount++
+	if err := b.Verify(); err == nil {
+		t.Fatal("verified tampered attestation")
+	}
+}
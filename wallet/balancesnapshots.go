@@ -0,0 +1,141 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// balanceSnapshotCheckInterval is how often RunBalanceSnapshots checks
+// whether the snapshot of the current day has been recorded.
+const balanceSnapshotCheckInterval = time.Hour
+
+// utcDay returns the start of the UTC day containing t.
+func utcDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// RecordBalanceSnapshot records a snapshot of the wallet's total balance,
+// balance locked by tickets, unspent ticket count, and vote rewards earned
+// since the previous snapshot, replacing any existing snapshot of the current
+// UTC day.  The first snapshot recorded by a wallet reports no rewards.
+func (w *Wallet) RecordBalanceSnapshot(ctx context.Context) (*udb.BalanceSnapshot, error) {
+	const op errors.Op = "wallet.RecordBalanceSnapshot"
+
+	balances, err := w.AccountBalances(ctx, 1)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	stakeInfo, err := w.StakeInfo(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	now := time.Now()
+	s := &udb.BalanceSnapshot{
+		Day:          utcDay(now),
+		Time:         now,
+		TicketCount:  stakeInfo.Unspent,
+		TotalSubsidy: stakeInfo.TotalSubsidy,
+	}
+	for i := range balances {
+		s.Total += balances[i].Total
+		s.LockedByTickets += balances[i].LockedByTickets
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, s.Height = w.txStore.MainChainTip(dbtx)
+
+		// Rewards are measured from the latest snapshot of a previous
+		// day, or from the previous snapshot of that day when this
+		// snapshot replaces one of the current day.
+		prev, err := udb.LatestBalanceSnapshot(dbtx)
+		switch {
+		case errors.Is(err, errors.NotExist):
+		case err != nil:
+			return err
+		case prev.Day.Equal(s.Day):
+			s.Rewards = prev.Rewards + s.TotalSubsidy - prev.TotalSubsidy
+		default:
+			s.Rewards = s.TotalSubsidy - prev.TotalSubsidy
+		}
+		if s.Rewards < 0 {
+			s.Rewards = 0
+		}
+		return udb.PutBalanceSnapshot(dbtx, s)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
+
+// BalanceHistory returns the recorded balance snapshots of the most recent
+// days, oldest first.  The current UTC day is included.
+func (w *Wallet) BalanceHistory(ctx context.Context, days int) ([]*udb.BalanceSnapshot, error) {
+	const op errors.Op = "wallet.BalanceHistory"
+
+	since := utcDay(time.Now()).AddDate(0, 0, 1-days)
+	var snapshots []*udb.BalanceSnapshot
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		snapshots, err = udb.BalanceSnapshots(dbtx, since)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return snapshots, nil
+}
+
+// RunBalanceSnapshots records a balance snapshot once each UTC day until the
+// context is canceled.  Days without a recorded snapshot (for example, when
+// the wallet is not running) are skipped.  Failures to record a snapshot are
+// logged and retried.
+func (w *Wallet) RunBalanceSnapshots(ctx context.Context) error {
+	const op errors.Op = "wallet.RunBalanceSnapshots"
+
+	ticker := time.NewTicker(balanceSnapshotCheckInterval)
+	defer ticker.Stop()
+	for {
+		var recorded bool
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			latest, err := udb.LatestBalanceSnapshot(dbtx)
+			if errors.Is(err, errors.NotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			recorded = !latest.Day.Before(utcDay(time.Now()))
+			return nil
+		})
+		if err == nil && !recorded {
+			var s *udb.BalanceSnapshot
+			s, err = w.RecordBalanceSnapshot(ctx)
+			if err == nil {
+				log.Infof("Recorded balance snapshot: total %v, locked "+
+					"by tickets %v, %d tickets, rewards %v", s.Total,
+					s.LockedByTickets, s.TicketCount, s.Rewards)
+			}
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Errorf("%v: %v", op, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
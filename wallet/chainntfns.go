@@ -7,6 +7,7 @@ package wallet
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"time"
 
@@ -158,16 +159,19 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 			if err != nil {
 				return err
 			}
+
+			w.recordEventTx(dbtx, &udb.WalletEvent{
+				Kind:   udb.WalletEventReorg,
+				Height: sideChainForkHeight,
+				Hash:   chain[len(chain)-1].Hash,
+				Detail: fmt.Sprintf("%d blocks detached from old tip %v, "+
+					"%d blocks attached", len(prevChain), &tipHash, len(chain)),
+			})
 		}
 
 		birthState := udb.BirthState(dbtx)
 
 		for _, n := range chain {
-			if voteVersion(w.chainParams) < n.Header.StakeVersion {
-				log.Warnf("Old vote version detected (v%v), please update your "+
-					"wallet to the latest version.", voteVersion(w.chainParams))
-			}
-
 			watch, err := w.extendMainChain(ctx, op, dbtx, n, relevantTxs[*n.Hash])
 			if err != nil {
 				return err
@@ -232,14 +236,19 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 		// TODO: The stake difficulty passed here is not correct.  This must be
 		// the difficulty of the next block, not the tip block.
 		tip := chain[len(chain)-1]
-		hashes, err := w.txStore.PruneUnmined(dbtx, tip.Header.SBits)
+		pruned, err := w.txStore.PruneUnmined(dbtx, tip.Header.SBits)
 		if err != nil {
 			log.Errorf("Failed to prune unmined transactions when "+
 				"connecting block height %v: %v", tip.Header.Height, err)
 		}
 
-		for _, hash := range hashes {
-			w.NtfnServer.notifyRemovedTransaction(*hash)
+		for _, p := range pruned {
+			w.NtfnServer.notifyRemovedTransaction(&RemovedTransactionNotification{
+				TxHash:         p.Hash,
+				Expired:        p.Expired,
+				TicketPurchase: p.TicketPurchase,
+				Returned:       p.Returned,
+			})
 		}
 		return nil
 	})
@@ -281,6 +290,20 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 	w.NtfnServer.notifyMainChainTipChanged(chainTipChanges)
 	w.NtfnServer.sendAttachedBlockNotification(ctx)
 
+	if tip := chain[len(chain)-1]; voteVersion(w.chainParams) < tip.Header.StakeVersion {
+		log.Warnf("Old vote version detected (v%v, network stake version v%v), "+
+			"please update your wallet to the latest version.",
+			voteVersion(w.chainParams), tip.Header.StakeVersion)
+		w.NtfnServer.notifyVoteVersion(&VoteVersionNotification{
+			VoteVersionStatus: VoteVersionStatus{
+				VoteVersion:         voteVersion(w.chainParams),
+				NetworkStakeVersion: tip.Header.StakeVersion,
+			},
+			BlockHash:   tip.Hash,
+			BlockHeight: int32(tip.Header.Height),
+		})
+	}
+
 	return prevChain, nil
 }
 
@@ -678,6 +701,23 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			return nil
 		}
 
+		// Votes with an outdated version are counted as abstaining on
+		// every agenda of newer versions.  Never abstain silently.  The
+		// voted block may not be processed yet, but the stake version
+		// only changes at stake version intervals, so the main chain tip
+		// is checked instead.
+		tipHash, _ := w.txStore.MainChainTip(dbtx)
+		header, err := w.txStore.GetBlockHeader(dbtx, &tipHash)
+		if err != nil {
+			return err
+		}
+		if voteVersion(w.chainParams) < header.StakeVersion {
+			log.Warnf("Voting on block %v with outdated vote version v%v "+
+				"(network stake version v%v): votes abstain on all agendas "+
+				"of newer versions", blockHash, voteVersion(w.chainParams),
+				header.StakeVersion)
+		}
+
 		votes = make([]*wire.MsgTx, len(ticketHashes))
 		usedVoteBits = make([]stake.VoteBits, len(ticketHashes))
 
@@ -845,6 +885,14 @@ func (w *Wallet) VoteOnOwnedTickets(ctx context.Context, winningTicketHashes []*
 			if err != nil {
 				return err
 			}
+			ticketHash := &voteRecords[i].MsgTx.TxIn[1].PreviousOutPoint.Hash
+			w.recordEventTx(dbtx, &udb.WalletEvent{
+				Kind:   udb.WalletEventVoteCreated,
+				Height: blockHeight,
+				Hash:   &voteRecords[i].Hash,
+				Detail: fmt.Sprintf("ticket %v voted on block %v",
+					ticketHash, blockHash),
+			})
 		}
 		return nil
 	})
@@ -8,6 +8,7 @@ package wallet
 import (
 	"context"
 	"encoding/binary"
+	"fmt"
 	"sort"
 	"time"
 
@@ -78,6 +79,37 @@ const (
 	OutputSelectionAlgorithmAll
 )
 
+// InputTree specifies the transaction trees of previous outputs which may be
+// selected as inputs when authoring transactions.
+type InputTree uint8
+
+const (
+	// InputTreeAny allows selecting outputs from both the regular and
+	// stake transaction trees.  Stake tree outputs are only selected after
+	// reaching maturity.
+	InputTreeAny InputTree = iota
+
+	// InputTreeRegular only allows selecting outputs of regular tree
+	// transactions.  Vote, revocation, ticket change, and treasury outputs
+	// are never selected.
+	InputTreeRegular
+
+	// InputTreeStake only allows selecting matured outputs of stake tree
+	// transactions.
+	InputTreeStake
+)
+
+func (t InputTree) allows(tree int8) bool {
+	switch t {
+	case InputTreeRegular:
+		return tree == wire.TxTreeRegular
+	case InputTreeStake:
+		return tree == wire.TxTreeStake
+	default:
+		return true
+	}
+}
+
 // NewUnsignedTransaction constructs an unsigned transaction using unspent
 // account outputs.
 //
@@ -92,6 +124,9 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 	const op errors.Op = "wallet.NewUnsignedTransaction"
 
 	ignoreInput := func(op *wire.OutPoint) bool {
+		if !w.inputTree.allows(op.Tree) {
+			return true
+		}
 		_, ok := w.lockedOutpoints[outpoint{op.Hash, op.Index}]
 		return ok
 	}
@@ -113,6 +148,9 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 				return errors.E(errors.NotExist, "missing account")
 			}
 		}
+		if err := checkSpendPolicy(ctx, dbtx, account); err != nil {
+			return err
+		}
 
 		if inputSource == nil {
 			sourceImpl := w.txStore.MakeInputSource(dbtx, account,
@@ -147,12 +185,12 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 
 		var err error
 		authoredTx, err = txauthor.NewUnsignedTransaction(outputs, relayFeePerKb,
-			inputSource, changeSource, w.chainParams.MaxTxSize)
+			inputSource, changeSource, w.maxTxSize)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		return w.checkTxOutputLimit(authoredTx.Tx)
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -341,6 +379,21 @@ func (w *Wallet) checkHighFees(totalInput dcrutil.Amount, tx *wire.MsgTx) error
 	return nil
 }
 
+// ErrTxOutputLimit describes the error of authoring a transaction with more
+// outputs than permitted by the wallet's configured maximum output count.
+var ErrTxOutputLimit = errors.New("transaction output count exceeds allowed maximum")
+
+// checkTxOutputLimit returns an error if an authored transaction has more
+// outputs than permitted by the wallet's maximum output count.
+func (w *Wallet) checkTxOutputLimit(tx *wire.MsgTx) error {
+	if w.maxTxOutputs == 0 || len(tx.TxOut) <= w.maxTxOutputs {
+		return nil
+	}
+	return errors.E(errors.Policy, errors.Errorf("%w: %d outputs "+
+		"including change, maximum %d", ErrTxOutputLimit,
+		len(tx.TxOut), w.maxTxOutputs))
+}
+
 // publishAndWatch publishes an authored transaction to the network and begins watching for
 // relevant transactions.
 func (w *Wallet) publishAndWatch(ctx context.Context, op errors.Op, n NetworkBackend, tx *wire.MsgTx,
@@ -389,6 +442,16 @@ type authorTx struct {
 	dontSignTx         bool
 	isTreasury         bool
 
+	// outputLabels optionally records labels for outputs, by the index
+	// of the output in outputs.  Empty labels are not recorded.
+	outputLabels []string
+
+	// inputTree limits the transaction tree of selected inputs.
+	inputTree InputTree
+
+	// excludeChange skips inputs paid to internal addresses.
+	excludeChange bool
+
 	atx                 *txauthor.AuthoredTx
 	changeSourceUpdates []func(walletdb.ReadWriteTx) error
 	watch               []wire.OutPoint
@@ -409,6 +472,9 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 		w.lockedOutpointMu.Unlock()
 	}()
 	ignoreInput := func(op *wire.OutPoint) bool {
+		if !a.inputTree.allows(op.Tree) {
+			return true
+		}
 		_, ok := w.lockedOutpoints[outpoint{op.Hash, op.Index}]
 		return ok
 	}
@@ -419,6 +485,16 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
+		err := checkSpendPolicy(ctx, dbtx, a.account)
+		if err != nil {
+			return err
+		}
+
+		ignoreInput := ignoreInput
+		if a.excludeChange {
+			ignoreInput = w.ignoreChangeInputs(dbtx, ignoreInput)
+		}
+
 		// Create the unsigned transaction.
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 		inputSource := w.txStore.MakeInputSource(dbtx, a.account,
@@ -442,10 +518,21 @@ func (w *Wallet) authorTx(ctx context.Context, op errors.Op, a *authorTx) error
 				gapPolicy: gapPolicyWrap,
 			}
 		}
-		var err error
 		atx, err = txauthor.NewUnsignedTransaction(a.outputs, a.txFee,
 			inputSource.SelectInputs, changeSource,
-			w.chainParams.MaxTxSize)
+			w.maxTxSize)
+		if err != nil {
+			return err
+		}
+		err = w.checkTxOutputLimit(atx.Tx)
+		if err != nil {
+			return err
+		}
+		spent := atx.TotalInput
+		if atx.ChangeIndex >= 0 {
+			spent -= dcrutil.Amount(atx.Tx.TxOut[atx.ChangeIndex].Value)
+		}
+		err = w.checkVoteFeeReserve(dbtx, spent, 0)
 		if err != nil {
 			return err
 		}
@@ -549,7 +636,28 @@ func (w *Wallet) recordAuthoredTx(ctx context.Context, op errors.Op, a *authorTx
 		// relevant transactions, since this does a lot of extra work.
 		var err error
 		watch, err = w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
-		return err
+		if err != nil {
+			return err
+		}
+
+		// Change position may have been randomized, so labeled outputs
+		// are located in the authored transaction.
+		for i, label := range a.outputLabels {
+			if label == "" {
+				continue
+			}
+			for idx, out := range a.atx.Tx.TxOut {
+				if out != a.outputs[i] {
+					continue
+				}
+				err := udb.PutOutputLabel(dbtx, &rec.Hash, uint32(idx), label)
+				if err != nil {
+					return err
+				}
+				break
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		return errors.E(op, err)
@@ -619,7 +727,7 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 	const minAmount = 0
 	const maxResults = 0
 	eligible, err := w.findEligibleOutputsAmount(dbtx, account, minconf,
-		amountRequired, topHeight, minAmount, maxResults)
+		amountRequired, topHeight, minAmount, maxResults, false)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
 	}
@@ -795,24 +903,46 @@ func (w *Wallet) compressWallet(ctx context.Context, op errors.Op, maxNumIns int
 func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx walletdb.ReadWriteTx, maxNumIns int, account uint32,
 	changeAddr stdaddr.Address) (*chainhash.Hash, error) {
 
-	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
-
 	n, err := w.NetworkBackend()
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 
+	err = checkSpendPolicy(ctx, dbtx, account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	// Get current block's height
 	_, tipHeight := w.txStore.MainChainTip(dbtx)
 
 	minconf := int32(1)
-	eligible, err := w.findEligibleOutputs(dbtx, account, minconf, tipHeight)
+	eligible, err := w.findEligibleOutputs(dbtx, account, minconf, tipHeight, false)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
 	if len(eligible) <= 1 {
 		return nil, errors.E(op, "too few outputs to consolidate")
 	}
+	tx, err := w.sweepInputs(ctx, op, dbtx, n, eligible, maxNumIns, 0, account, changeAddr)
+	if err != nil {
+		return nil, err
+	}
+	txHash := tx.TxHash()
+	return &txHash, nil
+}
+
+// sweepInputs publishes and returns a transaction spending up to maxNumIns of
+// the eligible inputs to a single output paying changeAddr, or a new internal
+// address of account when changeAddr is nil.  The transaction pays the
+// wallet's relay fee.  When maxFee is non-zero, no more inputs are added once
+// the fee would exceed maxFee.  The lockedOutpointMu mutex must be held.
+func (w *Wallet) sweepInputs(ctx context.Context, op errors.Op, dbtx walletdb.ReadWriteTx, n NetworkBackend,
+	eligible []Input, maxNumIns int, maxFee dcrutil.Amount, account uint32,
+	changeAddr stdaddr.Address) (*wire.MsgTx, error) {
+
+	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	var err error
 	for i := range eligible {
 		op := eligible[i].OutPoint
 		w.lockedOutpoints[outpoint{op.Hash, op.Index}] = struct{}{}
@@ -841,8 +971,8 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		PkScript: pkScript,
 		Version:  vers,
 	})
-	maximumTxSize := w.chainParams.MaxTxSize
-	if w.chainParams.Net == wire.MainNet {
+	maximumTxSize := w.maxTxSize
+	if w.chainParams.Net == wire.MainNet && maxStandardTxSize < maximumTxSize {
 		maximumTxSize = maxStandardTxSize
 	}
 
@@ -859,6 +989,14 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 		if msgtx.SerializeSize() > maximumTxSize {
 			break
 		}
+		if maxFee != 0 {
+			sizes := append(scriptSizes[:len(scriptSizes):len(scriptSizes)],
+				txsizes.RedeemP2PKHSigScriptSize)
+			size := txsizes.EstimateSerializeSize(sizes, msgtx.TxOut, 0)
+			if txrules.FeeForSerializeSize(w.RelayFee(), size) > maxFee {
+				break
+			}
+		}
 
 		txIn := wire.NewTxIn(&e.OutPoint, e.PrevOut.Value, nil)
 		msgtx.AddTxIn(txIn)
@@ -873,7 +1011,15 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 	szEst := txsizes.EstimateSerializeSize(scriptSizes, msgtx.TxOut, 0)
 	feeEst := txrules.FeeForSerializeSize(w.RelayFee(), szEst)
 
+	if count == 0 {
+		return nil, errors.E(op, errors.Policy,
+			"no inputs can be swept within the fee limit")
+	}
 	msgtx.TxOut[0].Value = int64(totalAdded - feeEst)
+	if txrules.IsDustOutput(msgtx.TxOut[0], w.RelayFee()) {
+		return nil, errors.E(op, errors.Invalid,
+			"swept amount after fees would be dust")
+	}
 
 	err = w.signP2PKHMsgTx(msgtx, forSigning, addrmgrNs)
 	if err != nil {
@@ -907,7 +1053,7 @@ func (w *Wallet) compressWalletInternal(ctx context.Context, op errors.Op, dbtx
 	txHash := msgtx.TxHash()
 	log.Infof("Successfully consolidated funds in transaction %v", &txHash)
 
-	return &txHash, nil
+	return msgtx, nil
 }
 
 // makeTicket creates a ticket from a split transaction output.
@@ -991,7 +1137,10 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 	for i := 0; i < req.Count; i++ {
 		mixOut[i] = &wire.TxOut{Value: int64(neededPerTicket), Version: 0, PkScript: p2pkhSizedScript}
 	}
-	relayFee := w.RelayFee()
+	relayFee, err := req.feeRate(w)
+	if err != nil {
+		return nil, nil, err
+	}
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
 	defer func() {
 		if err != nil {
@@ -1025,6 +1174,10 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 	w.lockedOutpointMu.Lock()
 	var atx *txauthor.AuthoredTx
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ignoreInput := ignoreInput
+		if req.ExcludeChange {
+			ignoreInput = w.ignoreChangeInputs(dbtx, ignoreInput)
+		}
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 		inputSource := w.txStore.MakeInputSource(dbtx, req.SourceAccount,
 			req.MinConf, tipHeight, ignoreInput)
@@ -1038,7 +1191,7 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 		var err error
 		atx, err = txauthor.NewUnsignedTransaction(mixOut, relayFee,
 			inputSource.SelectInputs, changeSource,
-			w.chainParams.MaxTxSize)
+			w.maxTxSize)
 		if err != nil {
 			return err
 		}
@@ -1114,15 +1267,21 @@ func (w *Wallet) individualSplit(ctx context.Context, req *PurchaseTicketsReques
 	}
 
 	const op errors.Op = "individualSplit"
+	feeRate, err := req.feeRate(w)
+	if err != nil {
+		return nil, nil, err
+	}
 	a := &authorTx{
 		outputs:            splitOuts,
 		account:            req.SourceAccount,
 		changeAccount:      req.ChangeAccount,
 		minconf:            req.MinConf,
 		randomizeChangeIdx: false,
-		txFee:              w.RelayFee(),
+		txFee:              feeRate,
 		dontSignTx:         req.DontSignTx,
 		isTreasury:         false,
+		inputTree:          w.inputTree,
+		excludeChange:      req.ExcludeChange,
 	}
 	err = w.authorTx(ctx, op, a)
 	if err != nil {
@@ -1145,6 +1304,26 @@ func (w *Wallet) individualSplit(ctx context.Context, req *PurchaseTicketsReques
 
 var errVSPFeeRequiresUTXOSplit = errors.New("paying VSP fee requires UTXO split")
 
+// ticketPurchaseExpiry returns the expiry height of ticket purchases which may
+// be mined in the next blocks blocks after the tip, but never after the stake
+// difficulty window of the purchase ends.  Purchases not mined by then would
+// be invalid at the next ticket price, and instead expire and return their
+// funds to the wallet.
+func ticketPurchaseExpiry(params *chaincfg.Params, tipHeight, blocks int32) int32 {
+	windowSize := int32(params.StakeDiffWindowSize)
+	windowEnd := (tipHeight/windowSize + 1) * windowSize
+	if tipHeight+1 == windowEnd {
+		// The next block begins a new window, and tickets are being
+		// purchased at its stake difficulty.
+		windowEnd += windowSize
+	}
+	expiry := tipHeight + 1 + blocks
+	if expiry > windowEnd {
+		expiry = windowEnd
+	}
+	return expiry
+}
+
 // purchaseTickets indicates to the wallet that a ticket should be purchased
 // using all currently available funds.   Also, when the spend limit in the
 // request is greater than or equal to 0, tickets that cost more than that limit
@@ -1160,15 +1339,30 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 	if req.Expiry < 0 {
 		return nil, errors.E(op, errors.Invalid, "negative expiry")
 	}
+	switch {
+	case req.ExpiryBlocks < 0:
+		return nil, errors.E(op, errors.Invalid, "negative expiry blocks")
+	case req.ExpiryBlocks == 1:
+		return nil, errors.E(op, errors.Invalid, "expiry blocks must be "+
+			"at least 2 for tickets to be mined after their split transaction")
+	case req.ExpiryBlocks != 0 && req.Expiry != 0:
+		return nil, errors.E(op, errors.Invalid, "expiry height and "+
+			"expiry blocks may not be used together")
+	}
 
 	// Perform a sanity check on expiry.
 	var tipHeight int32
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		_, tipHeight = w.txStore.MainChainTip(dbtx)
-		return nil
+		return checkSpendPolicy(ctx, dbtx, req.SourceAccount)
 	})
 	if err != nil {
-		return nil, err
+		return nil, errors.E(op, err)
+	}
+	if req.ExpiryBlocks != 0 {
+		req.Expiry = ticketPurchaseExpiry(w.chainParams, tipHeight,
+			req.ExpiryBlocks)
+		req.ExpiryBlocks = 0
 	}
 	if req.Expiry <= tipHeight+1 && req.Expiry > 0 {
 		return nil, errors.E(op, errors.Invalid, "expiry height must be above next block height")
@@ -1212,7 +1406,10 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 	// unset in the request, use the global ticket fee increment.
 	var neededPerTicket dcrutil.Amount
 	var estSize int
-	ticketRelayFee := w.RelayFee()
+	ticketRelayFee, err := req.feeRate(w)
+	if err != nil {
+		return nil, err
+	}
 
 	// A solo ticket has:
 	//   - a single input redeeming a P2PKH for the worst case size
@@ -1231,6 +1428,16 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 	ticketFee := txrules.FeeForSerializeSize(ticketRelayFee, estSize)
 	neededPerTicket = ticketFee + ticketPrice
 
+	// Ensure the purchase leaves enough spendable funds to pay the vote
+	// and revocation fees of all unspent tickets, including the new ones.
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return w.checkVoteFeeReserve(dbtx,
+			neededPerTicket*dcrutil.Amount(req.Count), req.Count)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
 	// After tickets are created and published, watch for future
 	// relevant transactions
 	var watchOutPoints []wire.OutPoint
@@ -1311,8 +1518,9 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 		var lowBalance bool
 		for i := 0; i < req.Count; i++ {
 			if req.extraSplitOutput == nil {
-				credits, err := w.ReserveOutputsForAmount(ctx,
-					req.SourceAccount, fee, req.MinConf)
+				credits, err := w.reserveOutputsForAmount(ctx,
+					req.SourceAccount, fee, req.MinConf,
+					req.ExcludeChange)
 
 				if errors.Is(err, errors.InsufficientBalance) {
 					lowBalance = true
@@ -1325,12 +1533,12 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 				vspFeeCredits = append(vspFeeCredits, credits)
 			}
 
-			credits, err := w.ReserveOutputsForAmount(ctx, req.SourceAccount,
-				ticketPrice, req.MinConf)
+			credits, err := w.reserveOutputsForAmount(ctx, req.SourceAccount,
+				ticketPrice, req.MinConf, req.ExcludeChange)
 			if errors.Is(err, errors.InsufficientBalance) {
 				lowBalance = true
 				credits, _ = w.reserveOutputs(ctx, req.SourceAccount,
-					req.MinConf)
+					req.MinConf, req.ExcludeChange)
 				if len(credits) != 0 {
 					ticketCredits = append(ticketCredits, credits)
 				}
@@ -1633,6 +1841,13 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 			return purchaseTicketsResponse, errors.E(op, err)
 		}
 		log.Infof("Published ticket purchase %v", ticket.TxHash())
+		w.recordEvent(ctx, &udb.WalletEvent{
+			Kind:   udb.WalletEventTicketPurchased,
+			Height: tipHeight,
+			Hash:   purchaseTicketsResponse.TicketHashes[i],
+			Detail: fmt.Sprintf("ticket price %v",
+				dcrutil.Amount(ticket.TxOut[0].Value)),
+		})
 
 		// Pay VSP fee when configured to do so.
 		if req.VSPClient == nil {
@@ -1678,6 +1893,12 @@ func (w *Wallet) purchaseTickets(ctx context.Context, op errors.Op,
 // ReserveOutputsForAmount returns locked spendable outpoints from the given
 // account.  It is the responsibility of the caller to unlock the outpoints.
 func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, amount dcrutil.Amount, minconf int32) ([]Input, error) {
+	return w.reserveOutputsForAmount(ctx, account, amount, minconf, false)
+}
+
+func (w *Wallet) reserveOutputsForAmount(ctx context.Context, account uint32,
+	amount dcrutil.Amount, minconf int32, excludeChange bool) ([]Input, error) {
+
 	defer w.lockedOutpointMu.Unlock()
 	w.lockedOutpointMu.Lock()
 
@@ -1690,7 +1911,7 @@ func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, am
 		const minAmount = 0
 		const maxResults = 0
 		outputs, err = w.findEligibleOutputsAmount(dbtx, account, minconf, amount, tipHeight,
-			minAmount, maxResults)
+			minAmount, maxResults, excludeChange)
 		if err != nil {
 			return err
 		}
@@ -1708,7 +1929,9 @@ func (w *Wallet) ReserveOutputsForAmount(ctx context.Context, account uint32, am
 	return outputs, nil
 }
 
-func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int32) ([]Input, error) {
+func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int32,
+	excludeChange bool) ([]Input, error) {
+
 	defer w.lockedOutpointMu.Unlock()
 	w.lockedOutpointMu.Lock()
 
@@ -1718,7 +1941,8 @@ func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int
 		_, tipHeight := w.txStore.MainChainTip(dbtx)
 
 		var err error
-		outputs, err = w.findEligibleOutputs(dbtx, account, minconf, tipHeight)
+		outputs, err = w.findEligibleOutputs(dbtx, account, minconf, tipHeight,
+			excludeChange)
 		if err != nil {
 			return err
 		}
@@ -1740,7 +1964,7 @@ func (w *Wallet) reserveOutputs(ctx context.Context, account uint32, minconf int
 // outputs.  Prefer to use findEligibleOutputsAmount with various filter options
 // instead.
 func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minconf int32,
-	currentHeight int32) ([]Input, error) {
+	currentHeight int32, excludeChange bool) ([]Input, error) {
 
 	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
@@ -1818,6 +2042,10 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 			continue
 		}
 
+		if excludeChange && w.isInternalAddress(addrmgrNs, addrs[0]) {
+			continue
+		}
+
 		txOut := &wire.TxOut{
 			Value:    int64(output.Amount),
 			Version:  wire.DefaultPkScriptVersion, // XXX
@@ -1832,10 +2060,43 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 	return eligible, nil
 }
 
+// isInternalAddress returns whether addr is a wallet address derived from an
+// account's internal branch.  Change outputs and the outputs of ticket split
+// transactions pay to these addresses.
+func (w *Wallet) isInternalAddress(addrmgrNs walletdb.ReadBucket, addr stdaddr.Address) bool {
+	ma, err := w.manager.Address(addrmgrNs, addr)
+	if err != nil {
+		return false
+	}
+	return ma.Internal()
+}
+
+// ignoreChangeInputs wraps an input source ignore function to also ignore
+// outputs paid to internal addresses.
+func (w *Wallet) ignoreChangeInputs(dbtx walletdb.ReadTx,
+	ignore func(*wire.OutPoint) bool) func(*wire.OutPoint) bool {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	return func(op *wire.OutPoint) bool {
+		if ignore(op) {
+			return true
+		}
+		credit, err := w.txStore.UnspentOutput(txmgrNs, *op, true)
+		if err != nil {
+			return true
+		}
+		_, addrs := stdscript.ExtractAddrs(scriptVersionAssumed,
+			credit.PkScript, w.chainParams)
+		return len(addrs) != 1 || w.isInternalAddress(addrmgrNs, addrs[0])
+	}
+}
+
 // findEligibleOutputsAmount uses wtxmgr to find a number of unspent outputs
 // while doing maturity checks there.
 func (w *Wallet) findEligibleOutputsAmount(dbtx walletdb.ReadTx, account uint32, minconf int32,
-	amount dcrutil.Amount, currentHeight int32, minAmount dcrutil.Amount, maxResults int) ([]Input, error) {
+	amount dcrutil.Amount, currentHeight int32, minAmount dcrutil.Amount, maxResults int,
+	excludeChange bool) ([]Input, error) {
 
 	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 
@@ -1910,6 +2171,10 @@ func (w *Wallet) findEligibleOutputsAmount(dbtx walletdb.ReadTx, account uint32,
 			return true
 		}
 
+		if excludeChange && w.isInternalAddress(addrmgrNs, addrs[0]) {
+			return true
+		}
+
 		return false
 	}
 
@@ -0,0 +1,264 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// FinalDepositNotification describes a deposit which has been mined with at
+// least the required number of confirmations for the duration of the
+// reorganization safety delay.  Outputs are the wallet's external outputs
+// paid by the deposit transaction.
+type FinalDepositNotification struct {
+	TxHash        *chainhash.Hash
+	BlockHash     *chainhash.Hash
+	BlockHeight   int32
+	Confirmations int32
+	Outputs       []TransactionSummaryOutput
+}
+
+// FinalDepositNotificationsClient provides notifications of deposits reaching
+// finality until the caller's context signals done.  Notifications are
+// received by calling Recv.
+type FinalDepositNotificationsClient struct {
+	minConf int32
+	delay   time.Duration
+
+	pending map[chainhash.Hash]*pendingDeposit
+	c       chan *FinalDepositNotification
+	ctx     context.Context
+	s       *NotificationServer
+}
+
+// pendingDeposit records the finality progress of an observed deposit.
+// Reached is the time at which the deposit was first observed with the
+// required number of confirmations in block, and is zero when the deposit
+// has fewer confirmations.
+type pendingDeposit struct {
+	outputs []TransactionSummaryOutput
+	block   chainhash.Hash
+	reached time.Time
+}
+
+// update records the current confirmations and mining block of the deposit,
+// and reports whether the deposit is final at time now.  The safety delay is
+// restarted whenever the deposit loses the required confirmations or is
+// reorganized into a different block.  When the deposit is not final and has
+// the required confirmations, the time at which it will become final is
+// returned.
+func (d *pendingDeposit) update(confs int32, block *chainhash.Hash, minConf int32,
+	delay time.Duration, now time.Time) (final bool, deadline time.Time) {
+
+	if confs < minConf || block == nil {
+		d.reached = time.Time{}
+		return false, time.Time{}
+	}
+	if d.reached.IsZero() || d.block != *block {
+		d.block = *block
+		d.reached = now
+	}
+	deadline = d.reached.Add(delay)
+	if !now.Before(deadline) {
+		return true, time.Time{}
+	}
+	return false, deadline
+}
+
+// FinalDepositNotifications registers a client for notifications of deposits
+// becoming final.  A deposit is any transaction observed after registration
+// which pays the wallet's external addresses without spending wallet outputs.
+// A deposit is final once it has been mined with at least minConf
+// confirmations, and has remained mined in the same block with these
+// confirmations for the delay since first reaching them.  Reorganizations
+// which remove confirmations or move the deposit to another block restart the
+// delay, so that rapid reorganizations do not result in premature
+// notifications.
+func (s *NotificationServer) FinalDepositNotifications(ctx context.Context,
+	minConf int32, delay time.Duration) *FinalDepositNotificationsClient {
+
+	if minConf < 1 {
+		minConf = 1
+	}
+	c := &FinalDepositNotificationsClient{
+		minConf: minConf,
+		delay:   delay,
+		pending: make(map[chainhash.Hash]*pendingDeposit),
+		c:       make(chan *FinalDepositNotification),
+		ctx:     ctx,
+		s:       s,
+	}
+	txs := s.TransactionNotifications()
+	tips := s.MainTipChangedNotifications()
+	go c.run(txs, tips)
+	return c
+}
+
+// Recv waits for the next deposit to become final.  Returns context.Canceled
+// when the context is canceled.
+func (c *FinalDepositNotificationsClient) Recv() (*FinalDepositNotification, error) {
+	select {
+	case <-c.ctx.Done():
+		return nil, context.Canceled
+	case n := <-c.c:
+		return n, nil
+	}
+}
+
+func (c *FinalDepositNotificationsClient) run(txs TransactionNotificationsClient,
+	tips MainTipChangedNotificationsClient) {
+
+	defer txs.Done()
+	defer tips.Done()
+
+	timer := time.NewTimer(0)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	defer timer.Stop()
+
+	// Final deposits are queued so that receiving wallet notifications is
+	// never blocked by a slow client.
+	var queue []*FinalDepositNotification
+	for {
+		var send chan *FinalDepositNotification
+		var next *FinalDepositNotification
+		if len(queue) > 0 {
+			send = c.c
+			next = queue[0]
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return
+		case send <- next:
+			queue[0] = nil
+			queue = queue[1:]
+			continue
+		case n := <-txs.C:
+			c.observe(n)
+		case <-tips.C:
+		case <-timer.C:
+		}
+
+		finals, deadline, err := c.process(time.Now())
+		if err != nil {
+			if c.ctx.Err() == nil {
+				log.Errorf("Failed to process deposit finality: %v", err)
+			}
+			continue
+		}
+		queue = append(queue, finals...)
+
+		timer.Stop()
+		select {
+		case <-timer.C:
+		default:
+		}
+		if !deadline.IsZero() {
+			timer.Reset(time.Until(deadline))
+		}
+	}
+}
+
+// observe begins tracking deposits included in the transaction notification.
+func (c *FinalDepositNotificationsClient) observe(n *TransactionNotifications) {
+	add := func(s *TransactionSummary) {
+		if len(s.MyInputs) != 0 {
+			return
+		}
+		if _, ok := c.pending[*s.Hash]; ok {
+			return
+		}
+		var outputs []TransactionSummaryOutput
+		for _, out := range s.MyOutputs {
+			if !out.Internal {
+				outputs = append(outputs, out)
+			}
+		}
+		if len(outputs) == 0 {
+			return
+		}
+		c.pending[*s.Hash] = &pendingDeposit{outputs: outputs}
+	}
+	for i := range n.UnminedTransactions {
+		add(&n.UnminedTransactions[i])
+	}
+	for i := range n.AttachedBlocks {
+		b := &n.AttachedBlocks[i]
+		for j := range b.Transactions {
+			add(&b.Transactions[j])
+		}
+	}
+}
+
+// process updates the finality progress of every pending deposit, returning
+// notifications for each deposit which became final and the earliest time at
+// which another pending deposit may become final.  Deposits removed from the
+// wallet are no longer tracked.
+func (c *FinalDepositNotificationsClient) process(now time.Time) ([]*FinalDepositNotification, time.Time, error) {
+	if len(c.pending) == 0 {
+		return nil, time.Time{}, nil
+	}
+
+	w := c.s.wallet
+	var finals []*FinalDepositNotification
+	var next time.Time
+	err := walletdb.View(c.ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		for txHash, d := range c.pending {
+			txHash := txHash // copy
+			height, err := w.txStore.TxBlockHeight(dbtx, &txHash)
+			switch {
+			case errors.Is(err, errors.NotExist):
+				delete(c.pending, txHash)
+				continue
+			case err != nil:
+				return err
+			}
+
+			var confs int32
+			var blockHash *chainhash.Hash
+			if height > 0 {
+				details, err := w.txStore.TxDetails(txmgrNs, &txHash)
+				if err != nil {
+					return err
+				}
+				_, invalidated := w.txStore.BlockInMainChain(dbtx, &details.Block.Hash)
+				if !invalidated {
+					confs = confirms(height, tipHeight)
+					blockHash = &details.Block.Hash
+				}
+			}
+
+			final, deadline := d.update(confs, blockHash, c.minConf, c.delay, now)
+			if final {
+				delete(c.pending, txHash)
+				finals = append(finals, &FinalDepositNotification{
+					TxHash:        &txHash,
+					BlockHash:     blockHash,
+					BlockHeight:   height,
+					Confirmations: confs,
+					Outputs:       d.outputs,
+				})
+				continue
+			}
+			if !deadline.IsZero() && (next.IsZero() || deadline.Before(next)) {
+				next = deadline
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return finals, next, nil
+}
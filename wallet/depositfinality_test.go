@@ -0,0 +1,56 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+func TestPendingDepositUpdate(t *testing.T) {
+	t.Parallel()
+
+	const minConf = 6
+	const delay = time.Minute
+	start := time.Unix(1700000000, 0)
+	blockA := &chainhash.Hash{1}
+	blockB := &chainhash.Hash{2}
+
+	tests := []struct {
+		name     string
+		confs    int32
+		block    *chainhash.Hash
+		at       time.Duration
+		final    bool
+		deadline time.Duration // zero when no deadline
+	}{
+		{"unmined", 0, nil, 0, false, 0},
+		{"too few confirmations", 5, blockA, time.Second, false, 0},
+		{"reached confirmations", 6, blockA, 2 * time.Second, false, 62 * time.Second},
+		{"within delay", 7, blockA, 30 * time.Second, false, 62 * time.Second},
+		{"reorged out", 5, blockA, 40 * time.Second, false, 0},
+		{"reconfirmed", 6, blockA, 50 * time.Second, false, 110 * time.Second},
+		{"moved to other block", 6, blockB, 60 * time.Second, false, 120 * time.Second},
+		{"delay elapsed", 9, blockB, 120 * time.Second, true, 0},
+	}
+
+	d := new(pendingDeposit)
+	for _, test := range tests {
+		final, deadline := d.update(test.confs, test.block, minConf, delay,
+			start.Add(test.at))
+		if final != test.final {
+			t.Errorf("%s: final = %v, want %v", test.name, final, test.final)
+		}
+		var want time.Time
+		if test.deadline != 0 {
+			want = start.Add(test.deadline)
+		}
+		if !deadline.Equal(want) {
+			t.Errorf("%s: deadline = %v, want %v", test.name, deadline, want)
+		}
+	}
+}
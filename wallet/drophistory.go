@@ -0,0 +1,109 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/wire"
+)
+
+// DroppedHistory describes the rebuild of the transaction history performed
+// by DropTransactionHistory.
+type DroppedHistory struct {
+	// RescanFrom is the height the main chain was rescanned from.
+	RescanFrom int32
+
+	// UnminedKept and UnminedDropped are the number of unmined
+	// transactions which were added back to the wallet after the rescan
+	// and which were discarded.
+	UnminedKept    int
+	UnminedDropped int
+}
+
+// DropTransactionHistory removes all transaction history from the wallet and
+// rebuilds it by rescanning the main chain from the wallet birthday, or from
+// the first block when no birthday is recorded.  Block headers and committed
+// filters are kept, so no chain data is redownloaded.  When keepUnmined is
+// set, unmined transactions are added back after the rescan, except those
+// which conflict with the rescanned history.  Other transaction processing is
+// blocked while the history is removed.  This function blocks until the
+// rescan completes.
+func (w *Wallet) DropTransactionHistory(ctx context.Context, n NetworkBackend,
+	keepUnmined bool) (*DroppedHistory, error) {
+
+	const op errors.Op = "wallet.DropTransactionHistory"
+
+	res := new(DroppedHistory)
+	var unmined []*udb.TxRecord
+	var tipHeight int32
+	w.lockedOutpointMu.Lock()
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var err error
+		unmined, err = w.txStore.UnminedTxs(dbtx)
+		if err != nil {
+			return err
+		}
+
+		_, tipHeight = w.txStore.MainChainTip(dbtx)
+		res.RescanFrom = 1
+		if bs := udb.BirthState(dbtx); bs != nil && !bs.SetFromTime &&
+			int32(bs.Height) > res.RescanFrom {
+			res.RescanFrom = min(int32(bs.Height), tipHeight+1)
+		}
+		return w.txStore.DropTransactionHistory(dbtx, res.RescanFrom)
+	})
+	w.lockedOutpointMu.Unlock()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	log.Infof("Dropped transaction history; rescanning from height %d",
+		res.RescanFrom)
+
+	if !keepUnmined {
+		res.UnminedDropped = len(unmined)
+	}
+	if res.RescanFrom <= tipHeight {
+		err = w.RescanFromHeight(ctx, n, res.RescanFrom)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	if !keepUnmined {
+		return res, nil
+	}
+
+	// Unmined transactions are sorted in dependency order and are added
+	// back individually so that a conflict with the rescanned history only
+	// discards the conflicting transaction.
+	var watchOutPoints []wire.OutPoint
+	for _, rec := range unmined {
+		var watch []wire.OutPoint
+		w.lockedOutpointMu.Lock()
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			var err error
+			watch, err = w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+			return err
+		})
+		w.lockedOutpointMu.Unlock()
+		if err != nil {
+			log.Warnf("Discarding unmined transaction %v: %v", &rec.Hash, err)
+			res.UnminedDropped++
+			continue
+		}
+		watchOutPoints = append(watchOutPoints, watch...)
+		res.UnminedKept++
+	}
+	if len(watchOutPoints) > 0 {
+		err = n.LoadTxFilter(ctx, false, nil, watchOutPoints)
+		if err != nil {
+			log.Errorf("Failed to watch outpoints: %v", err)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+)
+
+// dumpUnknownTime is written as the first use time of keys and scripts which
+// have never received funds.  It is interpreted as an unknown birthday by
+// ParseLegacyKeyDump.
+var dumpUnknownTime = time.Unix(1, 0).UTC()
+
+// DumpWallet writes a human-readable text export of every private key and
+// redeem script controlled by the wallet to out.  Each line records the
+// account name as the label, the BIP0044 derivation path of derived keys, and
+// the time the key or script first received funds.  Private keys are written
+// in a format that may be imported with ImportLegacyKeys.
+//
+// The wallet and any individually-encrypted accounts must be unlocked.  Keys
+// of watching-only accounts are not included.
+func (w *Wallet) DumpWallet(ctx context.Context, out io.Writer) error {
+	const op errors.Op = "wallet.DumpWallet"
+
+	bw := bufio.NewWriter(out)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		tipHash, tipHeight := w.txStore.MainChainTip(dbtx)
+		coinType, err := w.manager.CoinType(dbtx)
+		if err != nil {
+			return err
+		}
+
+		// Record the earliest block time any address received funds.
+		firstUse := make(map[string]time.Time)
+		rangeFn := func(details []udb.TxDetails) (bool, error) {
+			for i := range details {
+				d := &details[i]
+				for _, c := range d.Credits {
+					out := d.MsgTx.TxOut[c.Index]
+					_, addrs := stdscript.ExtractAddrs(out.Version,
+						out.PkScript, w.chainParams)
+					for _, a := range addrs {
+						k := a.String()
+						if _, ok := firstUse[k]; !ok {
+							firstUse[k] = d.Block.Time
+						}
+					}
+				}
+			}
+			return false, nil
+		}
+		err = w.txStore.RangeTransactions(ctx, txmgrNs, 0, tipHeight, rangeFn)
+		if err != nil {
+			return err
+		}
+
+		// Collect all addresses before accessing private keys, as the
+		// address manager may not be reentered during iteration.
+		var maddrs []udb.ManagedAddress
+		accountNames := make(map[uint32]string)
+		err = w.manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			name, err := w.manager.AccountName(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			accountNames[account] = name
+			return w.manager.ForEachAccountAddress(addrmgrNs, account,
+				func(maddr udb.ManagedAddress) error {
+					maddrs = append(maddrs, maddr)
+					return nil
+				})
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(bw, "# Wallet dump created by dcrwallet\n")
+		fmt.Fprintf(bw, "# * Created on %s\n", time.Now().UTC().Format(time.RFC3339))
+		fmt.Fprintf(bw, "# * Best block at time of backup was %d (%v)\n",
+			tipHeight, &tipHash)
+		fmt.Fprintf(bw, "#\n")
+
+		for _, maddr := range maddrs {
+			addr := maddr.Address()
+			used, ok := firstUse[addr.String()]
+			if !ok {
+				used = dumpUnknownTime
+			}
+			timestamp := used.UTC().Format(time.RFC3339)
+			label := url.QueryEscape(accountNames[maddr.Account()])
+
+			switch maddr := maddr.(type) {
+			case udb.ManagedPubKeyAddress:
+				have, err := w.manager.HavePrivateKey(addrmgrNs, addr)
+				if err != nil {
+					return err
+				}
+				if !have {
+					continue
+				}
+				key, zero, err := w.manager.PrivateKey(addrmgrNs, addr)
+				if err != nil {
+					return err
+				}
+				wif, err := dcrutil.NewWIF(key.Serialize(),
+					w.chainParams.PrivateKeyID, dcrec.STEcdsaSecp256k1)
+				zero()
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(bw, "%s %s label=%s", wif, timestamp, label)
+				if !maddr.Imported() {
+					var branch uint32
+					if maddr.Internal() {
+						branch = udb.InternalBranch
+					}
+					fmt.Fprintf(bw, " hdkeypath=m/44'/%d'/%d'/%d/%d",
+						coinType, maddr.Account(), branch, maddr.Index())
+				}
+				fmt.Fprintf(bw, " # addr=%s\n", addr)
+
+			case udb.ManagedScriptAddress:
+				_, script := maddr.RedeemScript()
+				fmt.Fprintf(bw, "%x %s script=1 label=%s # addr=%s\n",
+					script, timestamp, label, addr)
+			}
+		}
+
+		fmt.Fprintf(bw, "\n# End of dump\n")
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if err := bw.Flush(); err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+)
+
+func TestDumpWallet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0, WithGapPolicyWrap())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = w.DumpWallet(ctx, &buf)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("dumped locked wallet: %v", err)
+	}
+
+	err = w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	err = w.DumpWallet(ctx, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump := buf.String()
+	if !strings.Contains(dump, "# addr="+addr.String()+"\n") {
+		t.Fatalf("dump does not include address %v", addr)
+	}
+
+	keys, err := ParseLegacyKeyDump(strings.NewReader(dump), w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif, err := w.DumpWIFPrivateKey(ctx, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, k := range keys {
+		if k.WIF.String() == wif {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Errorf("dump does not include private key of address %v", addr)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// DisplayAddressRequest describes an address which an ExternalSigner is
+// asked to derive and display for confirmation.
+type DisplayAddressRequest struct {
+	// AccountXpub is the extended public key of the account the address
+	// is derived from.
+	AccountXpub string `json:"accountxpub"`
+
+	// Branch and Child are the BIP0044 branch and child indexes of the
+	// address, relative to the account extended key.
+	Branch uint32 `json:"branch"`
+	Child  uint32 `json:"child"`
+
+	// Address is the address expected to be displayed by the signer.
+	Address string `json:"address"`
+}
+
+// ExternalSigner is an external signing device, such as a hardware wallet,
+// which holds the private keys of an account.
+type ExternalSigner interface {
+	// DisplayAddress asks the device to derive and display the requested
+	// address, and returns whether the device confirmed the address.
+	DisplayAddress(ctx context.Context, req *DisplayAddressRequest) (bool, error)
+}
+
+// ExternalAddressVerification is the result of verifying an address with an
+// ExternalSigner.
+type ExternalAddressVerification struct {
+	Account   uint32
+	Branch    uint32
+	Child     uint32
+	Confirmed bool
+}
+
+// VerifyExternalAddress asks an external signer to display and confirm a
+// wallet address, in order to detect receive addresses which have been
+// tampered with.  Before asking the signer, the address is rederived from
+// the account extended public key and must match the passed address.
+func (w *Wallet) VerifyExternalAddress(ctx context.Context, signer ExternalSigner,
+	a stdaddr.Address) (*ExternalAddressVerification, error) {
+
+	const op errors.Op = "wallet.VerifyExternalAddress"
+
+	ka, err := w.KnownAddress(ctx, a)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	bip0044Addr, ok := ka.(BIP0044Address)
+	if !ok {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("address %v is not derived from an account "+
+				"extended public key", a))
+	}
+	_, branch, child := bip0044Addr.Path()
+	account, err := w.AccountNumber(ctx, ka.AccountName())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	xpub, err := w.AccountXpub(ctx, account)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Rederive the address from the account xpub to ensure the address
+	// recorded by the wallet database is the one the signer will derive.
+	branchKey, err := xpub.Child(branch)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	derived, err := deriveChildAddress(branchKey, child, w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if derived.String() != a.String() {
+		return nil, errors.E(op, errors.Invalid,
+			errors.Errorf("address %v does not match derived address %v",
+				a, derived))
+	}
+
+	confirmed, err := signer.DisplayAddress(ctx, &DisplayAddressRequest{
+		AccountXpub: xpub.String(),
+		Branch:      branch,
+		Child:       child,
+		Address:     a.String(),
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return &ExternalAddressVerification{
+		Account:   account,
+		Branch:    branch,
+		Child:     child,
+		Confirmed: confirmed,
+	}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+)
+
+type testSigner struct {
+	req     *DisplayAddressRequest
+	confirm bool
+}
+
+func (s *testSigner) DisplayAddress(ctx context.Context, req *DisplayAddressRequest) (bool, error) {
+	s.req = req
+	return s.confirm, nil
+}
+
+func TestVerifyExternalAddress(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0, WithGapPolicyWrap())
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := w.AccountXpub(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer := &testSigner{confirm: true}
+	v, err := w.VerifyExternalAddress(ctx, signer, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Confirmed {
+		t.Errorf("address was not confirmed")
+	}
+	if signer.req.Address != addr.String() {
+		t.Errorf("signer displayed %v, expected %v", signer.req.Address, addr)
+	}
+	if signer.req.AccountXpub != xpub.String() {
+		t.Errorf("signer was passed wrong account xpub")
+	}
+	if v.Account != 0 || v.Branch != 0 || v.Child != signer.req.Child {
+		t.Errorf("unexpected path %d/%d/%d", v.Account, v.Branch, v.Child)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+// txFeeRate returns the fee rate per kB used to author a transaction.  A zero
+// feeRate selects the wallet's relay fee.  Fee rates below the wallet's relay
+// fee are rejected, as transactions paying them may not be relayed.
+func (w *Wallet) txFeeRate(feeRate dcrutil.Amount) (dcrutil.Amount, error) {
+	relayFee := w.RelayFee()
+	switch {
+	case feeRate == 0:
+		return relayFee, nil
+	case feeRate < relayFee:
+		return 0, errors.E(errors.Invalid, errors.Errorf("fee rate %v/kB "+
+			"is below the relay fee %v/kB", feeRate, relayFee))
+	case feeRate > dcrutil.MaxAmount:
+		return 0, errors.E(errors.Invalid, errors.Errorf("fee rate %v/kB "+
+			"exceeds the maximum amount", feeRate))
+	}
+	return feeRate, nil
+}
+
+// feeRate returns the fee rate per kB of the purchase's transactions.
+func (req *PurchaseTicketsRequest) feeRate(w *Wallet) (dcrutil.Amount, error) {
+	return w.txFeeRate(req.FeeRate)
+}
+
+// TxFee returns the fee paid by a transaction, and the fee rate per kB which
+// it achieves.  The input amounts are read from the transaction inputs, so the
+// transaction must commit to the values of its inputs, as transactions
+// authored by the wallet do.  The fee rate of unsigned transactions does not
+// account for the size of their signature scripts.
+func TxFee(tx *wire.MsgTx) (fee, feeRate dcrutil.Amount) {
+	for _, in := range tx.TxIn {
+		fee += dcrutil.Amount(in.ValueIn)
+	}
+	for _, out := range tx.TxOut {
+		fee -= dcrutil.Amount(out.Value)
+	}
+	feeRate = fee * 1000 / dcrutil.Amount(tx.SerializeSize())
+	return fee, feeRate
+}
+
+// SendOutputsFeeRate creates and sends a payment transaction paying feeRate
+// per kB, which must not be below the wallet's relay fee.  A zero feeRate
+// pays the relay fee, as SendOutputs does.  The published transaction is
+// returned.
+func (w *Wallet) SendOutputsFeeRate(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32,
+	minconf int32, feeRate dcrutil.Amount) (*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.SendOutputsFeeRate"
+	return w.sendOutputs(ctx, op, outputs, account, changeAccount, minconf, feeRate)
+}
+
+func (w *Wallet) sendOutputs(ctx context.Context, op errors.Op, outputs []*wire.TxOut, account,
+	changeAccount uint32, minconf int32, feeRate dcrutil.Amount) (*wire.MsgTx, error) {
+
+	relayFee := w.RelayFee()
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, relayFee)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	feeRate, err := w.txFeeRate(feeRate)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	a := &authorTx{
+		outputs:            outputs,
+		account:            account,
+		changeAccount:      changeAccount,
+		minconf:            minconf,
+		randomizeChangeIdx: true,
+		txFee:              feeRate,
+		dontSignTx:         false,
+		isTreasury:         false,
+		inputTree:          w.inputTree,
+	}
+	err = w.authorTx(ctx, op, a)
+	if err != nil {
+		return nil, err
+	}
+	err = w.recordAuthoredTx(ctx, op, a)
+	if err != nil {
+		return nil, err
+	}
+	err = w.publishAndWatch(ctx, op, nil, a.atx.Tx, a.watch)
+	if err != nil {
+		return nil, err
+	}
+	return a.atx.Tx, nil
+}
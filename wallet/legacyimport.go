@@ -0,0 +1,193 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+)
+
+// legacyBirthdayWindow is subtracted from the earliest key birthday when
+// determining the rescan start height, accounting for inaccurate clocks and
+// block timestamps.
+const legacyBirthdayWindow = 2 * time.Hour
+
+// LegacyKey is a private key read from the key dump of a legacy keypool
+// wallet.
+type LegacyKey struct {
+	WIF *dcrutil.WIF
+
+	// Birthday is the time the key was created or first used.  The zero
+	// value indicates that the birthday is unknown and that the key may
+	// have been used at any point in the chain's history.
+	Birthday time.Time
+}
+
+// ParseLegacyKeyDump parses the text key dump of a legacy btcwallet or
+// dcrwallet keypool wallet.  Each line begins with a WIF-encoded private key,
+// optionally followed by the RFC3339 timestamp of the key's creation.  Any
+// remaining fields (such as labels and "# addr=" comments) are ignored, as
+// are empty lines and lines beginning with '#'.  Lines describing redeem
+// scripts (marked with a "script=1" field) are skipped.
+func ParseLegacyKeyDump(r io.Reader, params *chaincfg.Params) ([]LegacyKey, error) {
+	const op errors.Op = "wallet.ParseLegacyKeyDump"
+
+	var keys []LegacyKey
+	s := bufio.NewScanner(r)
+	for lineno := 1; s.Scan(); lineno++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if slices.Contains(fields, "script=1") {
+			continue
+		}
+		wif, err := dcrutil.DecodeWIF(fields[0], params.PrivateKeyID)
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding,
+				errors.Errorf("line %d: %v", lineno, err))
+		}
+		key := LegacyKey{WIF: wif}
+		if len(fields) > 1 && fields[1][0] != '#' {
+			t, err := time.Parse(time.RFC3339, fields[1])
+			if err != nil {
+				return nil, errors.E(op, errors.Encoding,
+					errors.Errorf("line %d: invalid timestamp: %v", lineno, err))
+			}
+			// Timestamps of 1 (or earlier) are used by legacy
+			// wallets to record unknown key birthdays.
+			if t.Unix() > 1 {
+				key.Birthday = t
+			}
+		}
+		keys = append(keys, key)
+	}
+	if err := s.Err(); err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	return keys, nil
+}
+
+// LegacyImportResult describes the result of importing the keys of a legacy
+// keypool wallet.
+type LegacyImportResult struct {
+	// Imported contains the P2PKH addresses of each newly imported key.
+	Imported []stdaddr.Address
+
+	// Existing is the number of keys that were already imported.
+	Existing int
+
+	// RescanFrom is the main chain block height from which the wallet
+	// must be rescanned to discover transactions of the imported keys.
+	// It is -1 when no new keys were imported.
+	RescanFrom int32
+}
+
+// ImportLegacyKeys imports the private keys of a legacy keypool wallet to the
+// imported account.  Keys that have already been imported are skipped.  The
+// result reports the height from which a rescan must begin, determined by the
+// earliest birthday of any newly imported key, but the rescan is not
+// performed.
+func (w *Wallet) ImportLegacyKeys(ctx context.Context, keys []LegacyKey) (*LegacyImportResult, error) {
+	const op errors.Op = "wallet.ImportLegacyKeys"
+
+	res := &LegacyImportResult{RescanFrom: -1}
+	var props *udb.AccountProperties
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		var birthday time.Time
+		unknownBirthday := false
+		for i := range keys {
+			maddr, err := w.manager.ImportPrivateKey(addrmgrNs, keys[i].WIF)
+			if errors.Is(err, errors.Exist) {
+				res.Existing++
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			res.Imported = append(res.Imported, maddr.Address())
+
+			switch b := keys[i].Birthday; {
+			case b.IsZero():
+				unknownBirthday = true
+			case birthday.IsZero() || b.Before(birthday):
+				birthday = b
+			}
+		}
+		if len(res.Imported) == 0 {
+			return nil
+		}
+
+		var err error
+		props, err = w.manager.AccountProperties(addrmgrNs,
+			udb.ImportedAddrAccount)
+		if err != nil {
+			return err
+		}
+
+		if unknownBirthday {
+			res.RescanFrom = 0
+			return nil
+		}
+		res.RescanFrom = w.mainChainHeightAtTime(dbtx,
+			birthday.Add(-legacyBirthdayWindow))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(res.Imported) == 0 {
+		return res, nil
+	}
+
+	if n, err := w.NetworkBackend(); err == nil {
+		err := n.LoadTxFilter(ctx, false, res.Imported, nil)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+
+	log.Infof("Imported %d legacy keys (%d already imported)",
+		len(res.Imported), res.Existing)
+
+	w.NtfnServer.notifyAccountProperties(props)
+
+	return res, nil
+}
+
+// mainChainHeightAtTime returns the height of the first main chain block
+// with a timestamp at or after t.  When no such block exists, the main chain
+// tip height is returned.
+func (w *Wallet) mainChainHeightAtTime(dbtx walletdb.ReadTx, t time.Time) int32 {
+	ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	_, tipHeight := w.txStore.MainChainTip(dbtx)
+	i := sort.Search(int(tipHeight)+1, func(i int) bool {
+		hash, err := w.txStore.GetMainChainBlockHashForHeight(ns, int32(i))
+		if err != nil {
+			return true
+		}
+		header, err := w.txStore.GetBlockHeader(dbtx, &hash)
+		if err != nil {
+			return true
+		}
+		return !header.Timestamp.Before(t)
+	})
+	return min(int32(i), tipHeight)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+func TestImportLegacyKeys(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	wifs := make([]string, 2)
+	for i := range wifs {
+		priv, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		wif, err := dcrutil.NewWIF(priv.Serialize(),
+			w.ChainParams().PrivateKeyID, dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wifs[i] = wif.String()
+	}
+
+	birthday := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	dump := fmt.Sprintf("# Wallet dump\n\n"+
+		"%s %s label= # addr=unused\n"+
+		"%s 1970-01-01T00:00:01Z reserve=1\n"+
+		"51 1970-01-01T00:00:01Z script=1 # addr=unused\n",
+		wifs[0], birthday.Format(time.RFC3339), wifs[1])
+
+	keys, err := ParseLegacyKeyDump(strings.NewReader(dump), w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("parsed %d keys, expected 2", len(keys))
+	}
+	if !keys[0].Birthday.Equal(birthday) {
+		t.Errorf("birthday %v, expected %v", keys[0].Birthday, birthday)
+	}
+	if !keys[1].Birthday.IsZero() {
+		t.Errorf("expected unknown birthday, got %v", keys[1].Birthday)
+	}
+
+	_, err = ParseLegacyKeyDump(strings.NewReader("notawif\n"), w.ChainParams())
+	if err == nil {
+		t.Errorf("parsed invalid key dump without error")
+	}
+
+	err = w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := w.ImportLegacyKeys(ctx, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Imported) != 2 || res.Existing != 0 {
+		t.Errorf("imported %d keys (%d existing), expected 2 (0 existing)",
+			len(res.Imported), res.Existing)
+	}
+	if res.RescanFrom != 0 {
+		t.Errorf("rescan from %d, expected 0", res.RescanFrom)
+	}
+
+	res, err = w.ImportLegacyKeys(ctx, keys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Imported) != 0 || res.Existing != 2 {
+		t.Errorf("imported %d keys (%d existing), expected 0 (2 existing)",
+			len(res.Imported), res.Existing)
+	}
+	if res.RescanFrom != -1 {
+		t.Errorf("rescan from %d, expected -1", res.RescanFrom)
+	}
+}
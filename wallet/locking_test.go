@@ -149,3 +149,96 @@ func testTimeoutReplacement(ctx context.Context, t *testing.T, w *Wallet) {
 		t.Fatal("previous timeout was not read in background")
 	}
 }
+
+func TestVotingDomain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 2
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.NextAccount(ctx, "voting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	votingAddr, err := w.NewExternalAddress(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spendingAddr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.AddVotingAccount(ctx, account)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("adding account without a voting passphrase: "+
+			"expected Locked error, got %v", err)
+	}
+	votingPass := []byte("voting passphrase")
+	err = w.SetVotingPassphrase(ctx, votingPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.AddVotingAccount(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Voting account keys must remain available after locking the
+	// spending keys, while other accounts are locked.
+	w.Lock()
+	if _, err := w.DumpWIFPrivateKey(ctx, votingAddr); err != nil {
+		t.Fatalf("voting account key locked with wallet: %v", err)
+	}
+	_, err = w.DumpWIFPrivateKey(ctx, spendingAddr)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("spending key: expected Locked error, got %v", err)
+	}
+
+	w.LockVotingDomain()
+	_, err = w.DumpWIFPrivateKey(ctx, votingAddr)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("locked voting domain: expected Locked error, got %v", err)
+	}
+	err = w.SetVotingPassphrase(ctx, []byte("new voting passphrase"))
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("changing passphrase of locked voting domain: "+
+			"expected Locked error, got %v", err)
+	}
+
+	// The wallet passphrase must not unlock the voting domain.
+	_, err = w.UnlockVotingDomain(ctx, testPrivPass)
+	if !errors.Is(err, errors.Passphrase) {
+		t.Fatalf("expected Passphrase error, got %v", err)
+	}
+	accounts, err := w.UnlockVotingDomain(ctx, votingPass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts[0] != account {
+		t.Fatalf("voting accounts %v, expected [%d]", accounts, account)
+	}
+	if _, err := w.DumpWIFPrivateKey(ctx, votingAddr); err != nil {
+		t.Fatalf("voting account key after unlocking voting domain: %v", err)
+	}
+	_, err = w.DumpWIFPrivateKey(ctx, spendingAddr)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("spending key: expected Locked error, got %v", err)
+	}
+
+	err = w.RemoveVotingAccount(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.DumpWIFPrivateKey(ctx, votingAddr)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("removed voting account: expected Locked error, got %v", err)
+	}
+}
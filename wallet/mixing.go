@@ -298,6 +298,10 @@ func (w *Wallet) MixOutput(ctx context.Context, output *wire.OutPoint, changeAcc
 	var prevScriptVersion uint16
 	var amount dcrutil.Amount
 	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		err := checkSpendPolicy(ctx, dbtx, changeAccount)
+		if err != nil {
+			return err
+		}
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 		txDetails, err := w.txStore.TxDetails(txmgrNs, &output.Hash)
 		if err != nil {
@@ -463,13 +467,16 @@ func (w *Wallet) MixAccount(ctx context.Context, changeAccount, mixAccount,
 	w.lockedOutpointMu.Lock()
 	var credits []Input
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
-		var err error
+		err := checkSpendPolicy(ctx, dbtx, changeAccount)
+		if err != nil {
+			return err
+		}
 		const minconf = 2
 		const targetAmount = 0
 		var minAmount = splitPoints[len(splitPoints)-1]
 		var maxResults = cap(w.mixSems.splitSems[0]) * len(splitPoints)
 		credits, err = w.findEligibleOutputsAmount(dbtx, changeAccount, minconf,
-			targetAmount, tipHeight, minAmount, maxResults)
+			targetAmount, tipHeight, minAmount, maxResults, false)
 		return err
 	})
 	if err != nil {
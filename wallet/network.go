@@ -6,9 +6,11 @@ package wallet
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/gcs/v4"
@@ -73,11 +75,35 @@ func (w *Wallet) NetworkBackend() (NetworkBackend, error) {
 }
 
 // SetNetworkBackend sets the network backend used by various functions of the
-// wallet.
+// wallet.  Connections to and disconnections from network backends, other than
+// the offline backend, are recorded as wallet events.
 func (w *Wallet) SetNetworkBackend(n NetworkBackend) {
 	w.networkBackendMu.Lock()
+	prev := w.networkBackend
 	w.networkBackend = n
 	w.networkBackendMu.Unlock()
+
+	isOnline := func(n NetworkBackend) bool {
+		_, offline := n.(OfflineNetworkBackend)
+		return n != nil && !offline
+	}
+	ctx := context.Background()
+	if isOnline(prev) {
+		e := &udb.WalletEvent{Kind: udb.WalletEventDisconnected}
+		_, e.Height = w.MainChainTip(ctx)
+		if err := prev.Err(); err != nil {
+			e.Detail = err.Error()
+		}
+		w.recordEvent(ctx, e)
+	}
+	if isOnline(n) {
+		e := &udb.WalletEvent{Kind: udb.WalletEventConnected}
+		_, e.Height = w.MainChainTip(ctx)
+		if s, ok := n.(fmt.Stringer); ok {
+			e.Detail = s.String()
+		}
+		w.recordEvent(ctx, e)
+	}
 }
 
 type networkContext struct {
@@ -46,6 +46,11 @@ type NotificationServer struct {
 	tipChangedClients         []chan *MainTipChangedNotification
 	confClients               []*ConfirmationNotificationsClient
 	removedTransactionClients []chan *RemovedTransactionNotification
+	paymentTemplateClients    []chan *PaymentTemplateNotification
+	sweepClients              []chan *SweepNotification
+	voteVersionClients        []chan *VoteVersionNotification
+	tipDivergenceClients      []chan *TipDivergenceNotification
+	balanceClients            []chan *BalanceNotification
 	mu                        sync.Mutex // Only protects registered clients
 	wallet                    *Wallet    // smells like hacks
 }
@@ -177,6 +182,7 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 		Fee:         fee,
 		Timestamp:   receiveTime.Unix(),
 		Type:        transactionType,
+		Tree:        TxTree(details.TxType),
 	}
 }
 
@@ -233,11 +239,15 @@ func (s *NotificationServer) notifyUnminedTransaction(dbtx walletdb.ReadTx, deta
 	}
 
 	clients := s.transactions
-	if len(clients) == 0 {
+	if len(clients) == 0 && len(s.balanceClients) == 0 {
 		return
 	}
 
 	unminedTxs := []TransactionSummary{makeTxSummary(dbtx, s.wallet, details)}
+	s.sendBalanceNotifications(dbtx, unminedTxs)
+	if len(clients) == 0 {
+		return
+	}
 	unminedHashes, err := s.wallet.txStore.UnminedTxHashes(dbtx.ReadBucket(wtxmgrNamespaceKey))
 	if err != nil {
 		log.Errorf("Cannot fetch unmined transaction hashes: %v", err)
@@ -306,7 +316,7 @@ func (s *NotificationServer) notifyAttachedBlock(block *wire.BlockHeader, blockH
 func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context) {
 	// Avoid work if possible
 	s.mu.Lock()
-	if len(s.transactions) == 0 {
+	if len(s.transactions) == 0 && len(s.balanceClients) == 0 {
 		s.currentTxNtfn = nil
 		s.mu.Unlock()
 		return
@@ -334,9 +344,14 @@ func (s *NotificationServer) sendAttachedBlockNotification(ctx context.Context)
 		if err != nil {
 			return err
 		}
+		var minedTxs []TransactionSummary
 		for _, b := range currentTxNtfn.AttachedBlocks {
 			relevantAccounts(bals, b.Transactions)
+			minedTxs = append(minedTxs, b.Transactions...)
 		}
+		s.mu.Lock()
+		s.sendBalanceNotifications(dbtx, minedTxs)
+		s.mu.Unlock()
 		return totalBalances(dbtx, w, bals)
 
 	})
@@ -398,6 +413,7 @@ type TransactionSummary struct {
 	Fee         dcrutil.Amount
 	Timestamp   int64
 	Type        TransactionType
+	Tree        int8
 }
 
 // TransactionType describes the which type of transaction is has been observed to be.
@@ -441,6 +457,15 @@ func TxTransactionType(tx *wire.MsgTx) TransactionType {
 	}
 }
 
+// TxTree returns the block tree (regular or stake) that transactions of a stake
+// transaction type are included in.
+func TxTree(txType stake.TxType) int8 {
+	if txType == stake.TxTypeRegular {
+		return wire.TxTreeRegular
+	}
+	return wire.TxTreeStake
+}
+
 // TransactionSummaryInput describes a transaction input that is relevant to the
 // wallet.  The Index field marks the transaction input index of the transaction
 // (not included here).  The PreviousAccount and PreviousAmount fields describe
@@ -523,8 +548,13 @@ func (c *TransactionNotificationsClient) Done() {
 }
 
 // RemovedTransactionNotification includes the removed transaction hash.
+// Transactions pruned from the unmined set describe why they were removed and
+// the value of the wallet outputs they spent which are again spendable.
 type RemovedTransactionNotification struct {
-	TxHash chainhash.Hash
+	TxHash         chainhash.Hash
+	Expired        bool
+	TicketPurchase bool
+	Returned       dcrutil.Amount
 }
 
 // RemovedTransactionNotificationsClient receives RemovedTransactionNotifications over the channel C.
@@ -571,16 +601,13 @@ func (c *RemovedTransactionNotificationsClient) Done() {
 	}()
 }
 
-func (s *NotificationServer) notifyRemovedTransaction(hash chainhash.Hash) {
+func (s *NotificationServer) notifyRemovedTransaction(n *RemovedTransactionNotification) {
 	defer s.mu.Unlock()
 	s.mu.Lock()
 	clients := s.removedTransactionClients
 	if len(clients) == 0 {
 		return
 	}
-	n := &RemovedTransactionNotification{
-		TxHash: hash,
-	}
 	for _, c := range clients {
 		c <- n
 	}
@@ -670,6 +697,262 @@ func (c *AccountNotificationsClient) Done() {
 	}()
 }
 
+// PaymentTemplateNotification describes the result of executing a payment
+// template.  Hash is the hash of the published payment transaction, and is nil
+// when Err is non-nil.
+type PaymentTemplateNotification struct {
+	Name string
+	Hash *chainhash.Hash
+	Err  error
+}
+
+// PaymentTemplateNotificationsClient receives PaymentTemplateNotifications
+// over the channel C.
+type PaymentTemplateNotificationsClient struct {
+	C      chan *PaymentTemplateNotification
+	server *NotificationServer
+}
+
+// PaymentTemplateNotifications returns a client for receiving the results of
+// payment template executions over a channel.  The channel is unbuffered.
+// When finished, the client's Done method should be called to disassociate
+// the client from the server.
+func (s *NotificationServer) PaymentTemplateNotifications() PaymentTemplateNotificationsClient {
+	c := make(chan *PaymentTemplateNotification)
+	s.mu.Lock()
+	s.paymentTemplateClients = append(s.paymentTemplateClients, c)
+	s.mu.Unlock()
+	return PaymentTemplateNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *PaymentTemplateNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.paymentTemplateClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.paymentTemplateClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+func (s *NotificationServer) notifyPaymentTemplateExecuted(n *PaymentTemplateNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.paymentTemplateClients {
+		c <- n
+	}
+}
+
+// SweepNotification describes a published transaction sweeping matured
+// outputs of SourceAccount into Account.  Amount is the value received by
+// Account after fees.
+type SweepNotification struct {
+	Hash          *chainhash.Hash
+	SourceAccount uint32
+	Account       uint32
+	Inputs        int
+	Amount        dcrutil.Amount
+}
+
+// SweepNotificationsClient receives SweepNotifications over the channel C.
+type SweepNotificationsClient struct {
+	C      chan *SweepNotification
+	server *NotificationServer
+}
+
+// SweepNotifications returns a client for receiving notifications of matured
+// output sweeps over a channel.  The channel is unbuffered.  When finished,
+// the client's Done method should be called to disassociate the client from
+// the server.
+func (s *NotificationServer) SweepNotifications() SweepNotificationsClient {
+	c := make(chan *SweepNotification)
+	s.mu.Lock()
+	s.sweepClients = append(s.sweepClients, c)
+	s.mu.Unlock()
+	return SweepNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *SweepNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.sweepClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.sweepClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+func (s *NotificationServer) notifySweep(n *SweepNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.sweepClients {
+		c <- n
+	}
+}
+
+// VoteVersionNotification warns that the network has upgraded to a newer
+// stake version than the wallet supports, and that votes created by the
+// wallet are counted as abstaining on the agendas of the newer versions.  It
+// is sent after every change to the main chain tip for as long as the wallet
+// remains outdated.
+type VoteVersionNotification struct {
+	VoteVersionStatus
+	BlockHash   *chainhash.Hash
+	BlockHeight int32
+}
+
+// VoteVersionNotificationsClient receives VoteVersionNotifications over the
+// channel C.
+type VoteVersionNotificationsClient struct {
+	C      chan *VoteVersionNotification
+	server *NotificationServer
+}
+
+// VoteVersionNotifications returns a client for receiving outdated vote
+// version warnings over a channel.  The channel is unbuffered.  When
+// finished, the client's Done method should be called to disassociate the
+// client from the server.
+func (s *NotificationServer) VoteVersionNotifications() VoteVersionNotificationsClient {
+	c := make(chan *VoteVersionNotification)
+	s.mu.Lock()
+	s.voteVersionClients = append(s.voteVersionClients, c)
+	s.mu.Unlock()
+	return VoteVersionNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *VoteVersionNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.voteVersionClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.voteVersionClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+func (s *NotificationServer) notifyVoteVersion(n *VoteVersionNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.voteVersionClients {
+		c <- n
+	}
+}
+
+// TipDivergenceNotification warns that the best block height reported by an
+// additional tip source differs from the wallet's main chain tip height by
+// more than the allowed number of blocks.  It is sent after every check of the
+// source for as long as the heights remain diverged.
+type TipDivergenceNotification struct {
+	Source       string
+	Height       int32 // Best height reported by the source
+	WalletHeight int32 // Wallet main chain tip height
+}
+
+// TipDivergenceNotificationsClient receives TipDivergenceNotifications over
+// the channel C.
+type TipDivergenceNotificationsClient struct {
+	C      chan *TipDivergenceNotification
+	server *NotificationServer
+}
+
+// TipDivergenceNotifications returns a client for receiving chain tip
+// divergence alerts over a channel.  The channel is unbuffered.  When
+// finished, the client's Done method should be called to disassociate the
+// client from the server.
+func (s *NotificationServer) TipDivergenceNotifications() TipDivergenceNotificationsClient {
+	c := make(chan *TipDivergenceNotification)
+	s.mu.Lock()
+	s.tipDivergenceClients = append(s.tipDivergenceClients, c)
+	s.mu.Unlock()
+	return TipDivergenceNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *TipDivergenceNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.tipDivergenceClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.tipDivergenceClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+func (s *NotificationServer) notifyTipDivergence(n *TipDivergenceNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.tipDivergenceClients {
+		c <- n
+	}
+}
+
 // MainTipChangedNotification describes processed changes to the main chain tip
 // block.  Attached and detached blocks are sorted by increasing heights.
 //
@@ -988,3 +1271,102 @@ func (c *ConfirmationNotificationsClient) process(tipHeight int32) {
 	case <-c.ctx.Done():
 	}
 }
+
+// BalanceNotification describes the balances of an account after they were
+// changed by a transaction.  All balances are in atoms, and are calculated
+// with one required confirmation.
+type BalanceNotification struct {
+	Balances
+	TxHash *chainhash.Hash // last transaction changing the balances
+}
+
+// BalanceNotificationsClient receives BalanceNotifications over the channel C.
+type BalanceNotificationsClient struct {
+	C      chan *BalanceNotification
+	server *NotificationServer
+}
+
+// BalanceNotifications returns a client for receiving notifications of
+// account balance changes over a channel.  The channel is unbuffered.  When
+// finished, the client's Done method should be called to disassociate the
+// client from the server.
+func (s *NotificationServer) BalanceNotifications() BalanceNotificationsClient {
+	c := make(chan *BalanceNotification)
+	s.mu.Lock()
+	s.balanceClients = append(s.balanceClients, c)
+	s.mu.Unlock()
+	return BalanceNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *BalanceNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.balanceClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.balanceClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// sendBalanceNotifications notifies balance clients of the balances of every
+// account with inputs or outputs in txs.  Each notification references the
+// last transaction of txs affecting the account.
+//
+// This method must be called with s.mu held.
+func (s *NotificationServer) sendBalanceNotifications(dbtx walletdb.ReadTx, txs []TransactionSummary) {
+	if len(s.balanceClients) == 0 || len(txs) == 0 {
+		return
+	}
+
+	var accounts []uint32
+	hashes := make(map[uint32]*chainhash.Hash)
+	record := func(account uint32, hash *chainhash.Hash) {
+		if _, ok := hashes[account]; !ok {
+			accounts = append(accounts, account)
+		}
+		hashes[account] = hash
+	}
+	for i := range txs {
+		for _, d := range txs[i].MyInputs {
+			record(d.PreviousAccount, txs[i].Hash)
+		}
+		for _, c := range txs[i].MyOutputs {
+			record(c.Account, txs[i].Hash)
+		}
+	}
+
+	bals, err := s.wallet.txStore.AccountBalances(dbtx, 1)
+	if err != nil {
+		log.Errorf("Cannot determine balances for balance notifications: %v", err)
+		return
+	}
+	for _, account := range accounts {
+		n := &BalanceNotification{
+			Balances: Balances{Account: account},
+			TxHash:   hashes[account],
+		}
+		if b, ok := bals[account]; ok {
+			n.Balances = *b
+		}
+		for _, c := range s.balanceClients {
+			c <- n
+		}
+	}
+}
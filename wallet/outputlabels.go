@@ -0,0 +1,128 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txauthor"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+// SendLabeledOutputs creates and sends payment transactions paying each
+// output.  Labels, when non-nil, must have the same length as outputs, and
+// every non-empty label is recorded for its output in the published
+// transaction.
+//
+// When split is true and the outputs can not be paid by a single transaction
+// without exceeding the maximum transaction size or output count, the outputs
+// are divided across multiple transactions.  All published transactions are
+// returned in the order they were published.  If an error occurs after some
+// transactions have been published, these transactions are returned along
+// with the error.
+//
+// Transactions pay feeRate per kB, or the wallet's relay fee when feeRate is
+// zero.
+func (w *Wallet) SendLabeledOutputs(ctx context.Context, outputs []*wire.TxOut, labels []string,
+	account, changeAccount uint32, minconf int32, split bool, feeRate dcrutil.Amount) ([]*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.SendLabeledOutputs"
+	if labels != nil && len(labels) != len(outputs) {
+		return nil, errors.E(op, errors.Invalid, "label count does not match output count")
+	}
+	relayFee := w.RelayFee()
+	for _, output := range outputs {
+		err := txrules.CheckOutput(output, relayFee)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	feeRate, err := w.txFeeRate(feeRate)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var txs []*wire.MsgTx
+	var send func(outputs []*wire.TxOut, labels []string) error
+	send = func(outputs []*wire.TxOut, labels []string) error {
+		a := &authorTx{
+			outputs:            outputs,
+			outputLabels:       labels,
+			account:            account,
+			changeAccount:      changeAccount,
+			minconf:            minconf,
+			randomizeChangeIdx: true,
+			txFee:              feeRate,
+			inputTree:          w.inputTree,
+		}
+		err := w.authorTx(ctx, op, a)
+		tooLarge := errors.Is(err, txauthor.ErrTxTooLarge) ||
+			errors.Is(err, ErrTxOutputLimit)
+		if split && len(outputs) > 1 && tooLarge {
+			mid := len(outputs) / 2
+			var labelsA, labelsB []string
+			if labels != nil {
+				labelsA, labelsB = labels[:mid], labels[mid:]
+			}
+			err := send(outputs[:mid], labelsA)
+			if err != nil {
+				return err
+			}
+			return send(outputs[mid:], labelsB)
+		}
+		if err != nil {
+			return err
+		}
+		err = w.recordAuthoredTx(ctx, op, a)
+		if err != nil {
+			return err
+		}
+		err = w.publishAndWatch(ctx, op, nil, a.atx.Tx, a.watch)
+		if err != nil {
+			return err
+		}
+		txs = append(txs, a.atx.Tx)
+		return nil
+	}
+	err = send(outputs, labels)
+	if err != nil {
+		return txs, err
+	}
+	return txs, nil
+}
+
+// SetOutputLabel records a label for a transaction output.  An empty label
+// removes any recorded label.
+func (w *Wallet) SetOutputLabel(ctx context.Context, out *wire.OutPoint, label string) error {
+	const op errors.Op = "wallet.SetOutputLabel"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutOutputLabel(dbtx, &out.Hash, out.Index, label)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// OutputLabels returns the recorded output labels of a transaction, keyed by
+// output index.
+func (w *Wallet) OutputLabels(ctx context.Context, txHash *chainhash.Hash) (map[uint32]string, error) {
+	const op errors.Op = "wallet.OutputLabels"
+	var labels map[uint32]string
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		labels = udb.OutputLabels(dbtx, txHash)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return labels, nil
+}
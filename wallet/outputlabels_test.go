@@ -0,0 +1,53 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestOutputLabels(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	txHash := chainhash.Hash{1}
+	otherHash := chainhash.Hash{2}
+	set := func(hash *chainhash.Hash, index uint32, label string) {
+		t.Helper()
+		err := w.SetOutputLabel(ctx, wire.NewOutPoint(hash, index, 0), label)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	set(&txHash, 0, "payout")
+	set(&txHash, 2, "refund")
+	set(&otherHash, 0, "other")
+
+	labels, err := w.OutputLabels(ctx, &txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 2 || labels[0] != "payout" || labels[2] != "refund" {
+		t.Fatalf("unexpected labels %v", labels)
+	}
+
+	// Empty labels remove the recorded label.
+	set(&txHash, 0, "")
+	labels, err = w.OutputLabels(ctx, &txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 1 || labels[2] != "refund" {
+		t.Fatalf("unexpected labels after removal %v", labels)
+	}
+}
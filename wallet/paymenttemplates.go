@@ -0,0 +1,228 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sync"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+// CreatePaymentTemplate records a new payment template.  When the template
+// has a non-zero interval, its first scheduled execution occurs interval
+// blocks after the current main chain tip.  Errors with Exist if a template
+// with the same name is already recorded.
+func (w *Wallet) CreatePaymentTemplate(ctx context.Context, t *udb.PaymentTemplate) error {
+	const op errors.Op = "wallet.CreatePaymentTemplate"
+
+	switch {
+	case t.Name == "":
+		return errors.E(op, errors.Invalid, "payment template name is empty")
+	case len(t.Outputs) == 0:
+		return errors.E(op, errors.Invalid, "payment template has no outputs")
+	case t.Interval < 0:
+		return errors.E(op, errors.Invalid, "negative payment template interval")
+	case t.MinConf < 0:
+		return errors.E(op, errors.Invalid, "negative minconf")
+	}
+	relayFee := w.RelayFee()
+	for _, output := range t.Outputs {
+		err := txrules.CheckOutput(output, relayFee)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, t.Account)
+		if err != nil {
+			return err
+		}
+		_, err = udb.PaymentTemplateByName(dbtx, t.Name)
+		if err == nil {
+			return errors.E(errors.Exist, errors.Errorf(
+				"payment template %q already exists", t.Name))
+		}
+		if !errors.Is(err, errors.NotExist) {
+			return err
+		}
+		if t.Interval > 0 {
+			_, tipHeight := w.txStore.MainChainTip(dbtx)
+			t.NextHeight = tipHeight + t.Interval
+		}
+		return udb.PutPaymentTemplate(dbtx, t)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// PaymentTemplates returns all recorded payment templates, sorted by name.
+func (w *Wallet) PaymentTemplates(ctx context.Context) ([]*udb.PaymentTemplate, error) {
+	const op errors.Op = "wallet.PaymentTemplates"
+	var templates []*udb.PaymentTemplate
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		templates, err = udb.PaymentTemplates(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return templates, nil
+}
+
+// DeletePaymentTemplate removes a recorded payment template.
+func (w *Wallet) DeletePaymentTemplate(ctx context.Context, name string) error {
+	const op errors.Op = "wallet.DeletePaymentTemplate"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.DeletePaymentTemplate(dbtx, name)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// ExecutePaymentTemplate creates and publishes a transaction paying the
+// outputs of the named payment template, using change from the template's
+// account.  The schedule of the template is not modified.  The result is
+// delivered to PaymentTemplateNotifications clients.
+func (w *Wallet) ExecutePaymentTemplate(ctx context.Context, name string) (*chainhash.Hash, error) {
+	const op errors.Op = "wallet.ExecutePaymentTemplate"
+	var t *udb.PaymentTemplate
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		t, err = udb.PaymentTemplateByName(dbtx, name)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	hash, err := w.executePaymentTemplate(ctx, t)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return hash, nil
+}
+
+func (w *Wallet) executePaymentTemplate(ctx context.Context, t *udb.PaymentTemplate) (*chainhash.Hash, error) {
+	hash, err := w.SendOutputs(ctx, t.Outputs, t.Account, t.Account, t.MinConf)
+	if err != nil {
+		log.Errorf("Failed to execute payment template %q: %v", t.Name, err)
+	} else {
+		log.Infof("Executed payment template %q in transaction %v", t.Name, hash)
+	}
+	w.NtfnServer.notifyPaymentTemplateExecuted(&PaymentTemplateNotification{
+		Name: t.Name,
+		Hash: hash,
+		Err:  err,
+	})
+	return hash, err
+}
+
+// RunPaymentTemplates executes scheduled payment templates as the main chain
+// tip advances until the context is canceled.  Each template is executed once
+// the tip reaches its next scheduled height, and is rescheduled interval
+// blocks later.  The schedule is advanced before executing the payment, so
+// that a payment is never repeated for the same period; failed payments are
+// retried on the next block.
+func (w *Wallet) RunPaymentTemplates(ctx context.Context) error {
+	const op errors.Op = "wallet.RunPaymentTemplates"
+
+	// Payments are executed by a separate goroutine, as the notification
+	// server blocks on delivering tip changes while payments are executed
+	// and their results are delivered.  Only the most recent tip height
+	// is kept when a previous execution is still in progress.
+	heights := make(chan int32, 1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for height := range heights {
+			err := w.executeScheduledPaymentTemplates(ctx, height)
+			if err != nil && ctx.Err() == nil {
+				log.Errorf("%v: %v", op, err)
+			}
+		}
+	}()
+	defer wg.Wait()
+	defer close(heights)
+
+	tipChange := w.NtfnServer.MainTipChangedNotifications()
+	defer tipChange.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n := <-tipChange.C:
+			if len(n.AttachedBlocks) == 0 {
+				continue
+			}
+			select {
+			case <-heights:
+			default:
+			}
+			heights <- n.NewHeight
+		}
+	}
+}
+
+func (w *Wallet) executeScheduledPaymentTemplates(ctx context.Context, height int32) error {
+	var due []*udb.PaymentTemplate
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		templates, err := udb.PaymentTemplates(dbtx)
+		if err != nil {
+			return err
+		}
+		for _, t := range templates {
+			if t.Interval == 0 || t.NextHeight > height {
+				continue
+			}
+			next := *t
+			next.NextHeight = height + t.Interval
+			err := udb.PutPaymentTemplate(dbtx, &next)
+			if err != nil {
+				return err
+			}
+			due = append(due, t)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, t := range due {
+		if _, err := w.executePaymentTemplate(ctx, t); err == nil {
+			continue
+		}
+		// Restore the previous schedule so the payment is retried on
+		// the next block.
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			cur, err := udb.PaymentTemplateByName(dbtx, t.Name)
+			if errors.Is(err, errors.NotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			cur.NextHeight = t.NextHeight
+			return udb.PutPaymentTemplate(dbtx, cur)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestPaymentTemplates(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(ctx, t, &cfg, nil)
+	defer teardown()
+
+	addr, err := w.NewExternalAddress(ctx, 0, WithGapPolicyWrap())
+	if err != nil {
+		t.Fatal(err)
+	}
+	vers, script := addr.PaymentScript()
+	tmpl := &udb.PaymentTemplate{
+		Name:     "payroll",
+		Account:  0,
+		MinConf:  1,
+		Outputs:  []*wire.TxOut{{Value: 1e8, Version: vers, PkScript: script}},
+		Interval: 10,
+	}
+	err = w.CreatePaymentTemplate(ctx, tmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.CreatePaymentTemplate(ctx, tmpl)
+	if !errors.Is(err, errors.Exist) {
+		t.Fatalf("expected Exist error creating duplicate template, got %v", err)
+	}
+
+	templates, err := w.PaymentTemplates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(templates) != 1 {
+		t.Fatalf("expected 1 template, got %d", len(templates))
+	}
+	got := templates[0]
+	_, tipHeight := w.MainChainTip(ctx)
+	switch {
+	case got.Name != tmpl.Name, got.Account != tmpl.Account,
+		got.MinConf != tmpl.MinConf, got.Interval != tmpl.Interval:
+		t.Fatalf("template fields did not round trip: %+v", got)
+	case got.NextHeight != tipHeight+tmpl.Interval:
+		t.Fatalf("next height %d, expected %d", got.NextHeight,
+			tipHeight+tmpl.Interval)
+	case len(got.Outputs) != 1, got.Outputs[0].Value != 1e8,
+		!bytes.Equal(got.Outputs[0].PkScript, script):
+		t.Fatalf("template outputs did not round trip")
+	}
+
+	err = w.DeletePaymentTemplate(ctx, tmpl.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.DeletePaymentTemplate(ctx, tmpl.Name)
+	if !errors.Is(err, errors.NotExist) {
+		t.Fatalf("expected NotExist error deleting removed template, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v3"
+)
+
+func TestTicketPurchaseExpiry(t *testing.T) {
+	t.Parallel()
+
+	params := chaincfg.MainNetParams()
+	window := int32(params.StakeDiffWindowSize)
+
+	tests := []struct {
+		name      string
+		tipHeight int32
+		blocks    int32
+		expiry    int32
+	}{{
+		name:      "within window",
+		tipHeight: 10 * window,
+		blocks:    5,
+		expiry:    10*window + 6,
+	}, {
+		name:      "limited to window end",
+		tipHeight: 11*window - 10,
+		blocks:    20,
+		expiry:    11 * window,
+	}, {
+		name:      "next block begins window",
+		tipHeight: 11*window - 1,
+		blocks:    2 * window,
+		expiry:    12 * window,
+	}}
+	for _, test := range tests {
+		expiry := ticketPurchaseExpiry(params, test.tipHeight, test.blocks)
+		if expiry != test.expiry {
+			t.Errorf("%s: expiry %d, want %d", test.name, expiry, test.expiry)
+		}
+	}
+}
@@ -7,6 +7,7 @@ package wallet
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"decred.org/dcrwallet/v5/errors"
@@ -210,7 +211,28 @@ func (w *Wallet) saveRescanned(ctx context.Context, dbtx walletdb.ReadWriteTx,
 // progress channel, if non-nil, is sent non-error progress notifications with
 // the heights the rescan has completed through, starting with the start height.
 func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
-	startHash *chainhash.Hash, height int32, p chan<- RescanProgress) error {
+	startHash *chainhash.Hash, height int32, p chan<- RescanProgress) (err error) {
+
+	startHeight := height
+	relevantTxs := 0
+
+	w.recordEvent(ctx, &udb.WalletEvent{
+		Kind:   udb.WalletEventRescanStarted,
+		Height: startHeight,
+		Hash:   startHash,
+	})
+	defer func() {
+		e := &udb.WalletEvent{
+			Kind:   udb.WalletEventRescanFinished,
+			Height: height - 1,
+			Detail: fmt.Sprintf("%d relevant transactions", relevantTxs),
+		}
+		if err != nil {
+			e.Detail += ", failed: " + err.Error()
+		}
+		// The rescan context may be canceled.
+		w.recordEvent(context.WithoutCancel(ctx), e)
+	}()
 
 	w.logRescannedTransactionsMu.Lock()
 	logTxs := w.logRescannedTransactions
@@ -228,8 +250,10 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		}
 
 		var rescanBlocks []chainhash.Hash
+		var tipHeight int32
 		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			_, tipHeight = w.txStore.MainChainTip(dbtx)
 			var err error
 			rescanBlocks, err = w.txStore.GetMainChainBlockHashes(txmgrNs,
 				&rescanFrom, inclusive, blockHashStorage)
@@ -321,6 +345,7 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 		}()
 
 		err = n.Rescan(ctx, rescanBlocks, func(blockHash *chainhash.Hash, txs []*wire.MsgTx) error {
+			relevantTxs += len(txs)
 			errc := make(chan error)
 			ch <- rescannedBlock{
 				blockHash: blockHash,
@@ -344,7 +369,12 @@ func (w *Wallet) rescan(ctx context.Context, n NetworkBackend,
 			return err
 		}
 		if p != nil {
-			p <- RescanProgress{ScannedThrough: through}
+			p <- RescanProgress{
+				StartHeight:    startHeight,
+				ScannedThrough: through,
+				TipHeight:      tipHeight,
+				RelevantTxs:    relevantTxs,
+			}
 		}
 		rescanFrom = rescanBlocks[len(rescanBlocks)-1]
 		height += int32(len(rescanBlocks))
@@ -409,7 +439,30 @@ func (w *Wallet) RescanFromHeight(ctx context.Context, n NetworkBackend, startHe
 // errors during processing of the rescan.
 type RescanProgress struct {
 	Err            error
+	StartHeight    int32
 	ScannedThrough int32
+
+	// TipHeight is the height of the main chain tip block when the
+	// progress was recorded.  The rescan continues through the tip block.
+	TipHeight int32
+
+	// RelevantTxs is the number of relevant transactions found by the
+	// rescan so far.
+	RelevantTxs int
+}
+
+// Percent returns the percentage of blocks between the start height and the
+// tip height which have been rescanned.
+func (p *RescanProgress) Percent() float64 {
+	total := p.TipHeight - p.StartHeight + 1
+	if total <= 0 || p.ScannedThrough >= p.TipHeight {
+		return 100
+	}
+	scanned := p.ScannedThrough - p.StartHeight + 1
+	if scanned <= 0 {
+		return 0
+	}
+	return float64(scanned) * 100 / float64(total)
 }
 
 // RescanProgressFromHeight rescans for relevant transactions in all blocks in
@@ -0,0 +1,290 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// Transaction store buckets reported by SelfCheck discrepancies.
+const (
+	SelfCheckBucketUnspent = "unspent"
+	SelfCheckBucketBalance = "balance"
+)
+
+// SelfCheckDiscrepancy describes a difference between the live transaction
+// store and the state re-derived by SelfCheck.
+type SelfCheckDiscrepancy struct {
+	// Bucket names the transaction store bucket in which the discrepancy
+	// was found.
+	Bucket string
+
+	// OutPoint is the output that differs, and is unset for balance
+	// discrepancies.
+	OutPoint wire.OutPoint
+
+	Expected dcrutil.Amount
+	Actual   dcrutil.Amount
+	Reason   string
+}
+
+// SelfCheckResult describes the outcome of a self check.
+type SelfCheckResult struct {
+	ScannedThrough  int32
+	ExpectedBalance dcrutil.Amount
+	ActualBalance   dcrutil.Amount
+	ExpectedUnspent int
+	ActualUnspent   int
+	Discrepancies   []SelfCheckDiscrepancy
+}
+
+// selfCheckCredit is an output re-derived by a self check.
+type selfCheckCredit struct {
+	amount dcrutil.Amount
+	tree   int8
+	ticket bool
+}
+
+// selfCheckStore is the temporary store of unspent outputs re-derived from
+// the blocks of the main chain.
+type selfCheckStore struct {
+	unspent map[outpoint]selfCheckCredit
+}
+
+// selfCheckTx spends any re-derived outputs redeemed by tx and records each
+// output of tx paying to a wallet address.
+func (w *Wallet) selfCheckTx(dbtx walletdb.ReadTx, s *selfCheckStore, tx *wire.MsgTx) error {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+	txType := stake.DetermineTxType(tx)
+	for i, in := range tx.TxIn {
+		if i == 0 && txType == stake.TxTypeSSGen {
+			// Stakebase inputs do not spend any outputs.
+			continue
+		}
+		prev := &in.PreviousOutPoint
+		delete(s.unspent, outpoint{prev.Hash, prev.Index})
+	}
+
+	txHash := tx.TxHash()
+	for i, output := range tx.TxOut {
+		if output.Value == 0 {
+			// Ticket commitments and other zero value outputs are
+			// not recorded as credits.
+			continue
+		}
+		class, addrs := stdscript.ExtractAddrs(output.Version,
+			output.PkScript, w.chainParams)
+		if class == stdscript.STNonStandard {
+			continue
+		}
+		for _, addr := range addrs {
+			_, err := w.manager.Address(addrmgrNs, addr)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			credit := selfCheckCredit{
+				amount: dcrutil.Amount(output.Value),
+				ticket: class == stdscript.STStakeSubmissionPubKeyHash ||
+					class == stdscript.STStakeSubmissionScriptHash,
+			}
+			if txType != stake.TxTypeRegular {
+				credit.tree = wire.TxTreeStake
+			}
+			s.unspent[outpoint{txHash, uint32(i)}] = credit
+			break
+		}
+	}
+	return nil
+}
+
+// selfCheckBlock processes the relevant transactions of a main chain block.
+// Regular transactions of blocks disapproved by the following block's voters
+// are not processed.
+func (w *Wallet) selfCheckBlock(dbtx walletdb.ReadTx, s *selfCheckStore,
+	blockHash *chainhash.Hash, txs []*wire.MsgTx) error {
+
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	header, err := w.txStore.GetBlockHeader(dbtx, blockHash)
+	if err != nil {
+		return err
+	}
+	invalidated := false
+	childHash, err := w.txStore.GetMainChainBlockHashForHeight(txmgrNs,
+		int32(header.Height)+1)
+	if err == nil {
+		child, err := w.txStore.GetBlockHeader(dbtx, &childHash)
+		if err != nil {
+			return err
+		}
+		invalidated = !dcrutil.IsFlagSet16(child.VoteBits, dcrutil.BlockValid)
+	}
+
+	for _, tx := range txs {
+		if invalidated && stake.DetermineTxType(tx) == stake.TxTypeRegular {
+			continue
+		}
+		if err := w.selfCheckTx(dbtx, s, tx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SelfCheck re-derives the wallet's unspent outputs and mined balance from
+// scratch by scanning every main chain block using the trusted chain source
+// n, and compares the result against the live transaction store.  The
+// re-derived state is held in a temporary in-memory store and is never
+// written to the database.  Each difference is reported as a discrepancy
+// naming the transaction store bucket it was found in.
+//
+// Relevant transactions are discovered through n using the wallet's loaded
+// transaction filter, and outputs are attributed to the wallet using the
+// address manager.  Unmined transactions are not considered.
+func (w *Wallet) SelfCheck(ctx context.Context, n NetworkBackend) (*SelfCheckResult, error) {
+	const op errors.Op = "wallet.SelfCheck"
+
+	s := &selfCheckStore{unspent: make(map[outpoint]selfCheckCredit)}
+	res := new(SelfCheckResult)
+
+	var rescanFrom chainhash.Hash
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		rescanFrom, err = w.txStore.GetMainChainBlockHashForHeight(txmgrNs, 0)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	blockHashStorage := make([]chainhash.Hash, maxBlocksPerRescan)
+	for {
+		var blocks []chainhash.Hash
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			var err error
+			blocks, err = w.txStore.GetMainChainBlockHashes(txmgrNs,
+				&rescanFrom, false, blockHashStorage)
+			return err
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(blocks) == 0 {
+			break
+		}
+
+		log.Infof("Self check scanning %d blocks after %v", len(blocks),
+			&rescanFrom)
+		err = n.Rescan(ctx, blocks, func(blockHash *chainhash.Hash, txs []*wire.MsgTx) error {
+			return walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+				return w.selfCheckBlock(dbtx, s, blockHash, txs)
+			})
+		})
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		rescanFrom = blocks[len(blocks)-1]
+	}
+
+	actual := make(map[outpoint]selfCheckCredit)
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var tip chainhash.Hash
+		tip, res.ScannedThrough = w.txStore.MainChainTip(dbtx)
+		if tip != rescanFrom {
+			return errors.E(errors.Invalid,
+				"main chain changed during self check")
+		}
+		var err error
+		res.ActualBalance, err = w.txStore.MinedBalance(dbtx)
+		if err != nil {
+			return err
+		}
+		return w.txStore.ForEachMinedUnspentCredit(dbtx,
+			func(op *wire.OutPoint, amount dcrutil.Amount, ticket bool) error {
+				actual[outpoint{op.Hash, op.Index}] = selfCheckCredit{
+					amount: amount,
+					tree:   op.Tree,
+					ticket: ticket,
+				}
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	res.ExpectedUnspent = len(s.unspent)
+	res.ActualUnspent = len(actual)
+	for k, expected := range s.unspent {
+		if !expected.ticket {
+			res.ExpectedBalance += expected.amount
+		}
+		live, ok := actual[k]
+		outPoint := wire.OutPoint{Hash: k.hash, Index: k.index, Tree: expected.tree}
+		switch {
+		case !ok:
+			res.Discrepancies = append(res.Discrepancies, SelfCheckDiscrepancy{
+				Bucket:   SelfCheckBucketUnspent,
+				OutPoint: outPoint,
+				Expected: expected.amount,
+				Reason:   "unspent output missing from store",
+			})
+		case live.amount != expected.amount:
+			res.Discrepancies = append(res.Discrepancies, SelfCheckDiscrepancy{
+				Bucket:   SelfCheckBucketUnspent,
+				OutPoint: outPoint,
+				Expected: expected.amount,
+				Actual:   live.amount,
+				Reason:   "unspent output amount differs",
+			})
+		}
+	}
+	for k, live := range actual {
+		if _, ok := s.unspent[k]; ok {
+			continue
+		}
+		res.Discrepancies = append(res.Discrepancies, SelfCheckDiscrepancy{
+			Bucket:   SelfCheckBucketUnspent,
+			OutPoint: wire.OutPoint{Hash: k.hash, Index: k.index, Tree: live.tree},
+			Actual:   live.amount,
+			Reason:   "store records spent or unknown output as unspent",
+		})
+	}
+	sort.Slice(res.Discrepancies, func(i, j int) bool {
+		a, b := &res.Discrepancies[i].OutPoint, &res.Discrepancies[j].OutPoint
+		if a.Hash != b.Hash {
+			return a.Hash.String() < b.Hash.String()
+		}
+		return a.Index < b.Index
+	})
+	if res.ExpectedBalance != res.ActualBalance {
+		res.Discrepancies = append(res.Discrepancies, SelfCheckDiscrepancy{
+			Bucket:   SelfCheckBucketBalance,
+			Expected: res.ExpectedBalance,
+			Actual:   res.ActualBalance,
+			Reason: fmt.Sprintf("mined balance differs by %v",
+				res.ActualBalance-res.ExpectedBalance),
+		})
+	}
+
+	log.Infof("Self check through block %d found %d discrepancies",
+		res.ScannedThrough, len(res.Discrepancies))
+	return res, nil
+}
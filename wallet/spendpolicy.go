@@ -0,0 +1,85 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+type manualSpendKey struct{}
+
+// WithManualSpend returns a context which marks transactions authored and
+// signed using it as manually requested, permitting spends from warm
+// accounts.  RPC servers mark the contexts of client requests, and automated
+// wallet processes must not use contexts derived from them.
+func WithManualSpend(ctx context.Context) context.Context {
+	return context.WithValue(ctx, manualSpendKey{}, true)
+}
+
+func isManualSpend(ctx context.Context) bool {
+	manual, _ := ctx.Value(manualSpendKey{}).(bool)
+	return manual
+}
+
+// SetAccountSpendPolicy records the spend policy of an account.
+func (w *Wallet) SetAccountSpendPolicy(ctx context.Context, account uint32, policy udb.SpendPolicy) error {
+	const op errors.Op = "wallet.SetAccountSpendPolicy"
+	switch policy {
+	case udb.SpendPolicyHot, udb.SpendPolicyWarm, udb.SpendPolicyCold:
+	default:
+		return errors.E(op, errors.Invalid, errors.Errorf("unknown spend policy %d", policy))
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		return udb.SetAccountSpendPolicy(dbtx, account, policy)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// AccountSpendPolicy returns the spend policy of an account.
+func (w *Wallet) AccountSpendPolicy(ctx context.Context, account uint32) (udb.SpendPolicy, error) {
+	const op errors.Op = "wallet.AccountSpendPolicy"
+	var policy udb.SpendPolicy
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		policy = udb.AccountSpendPolicy(dbtx, account)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return policy, nil
+}
+
+// checkSpendPolicy errors with Policy when the spend policy of an account
+// does not permit spending its outputs with the context.
+func checkSpendPolicy(ctx context.Context, dbtx walletdb.ReadTx, account uint32) error {
+	switch udb.AccountSpendPolicy(dbtx, account) {
+	case udb.SpendPolicyCold:
+		return errors.E(errors.Policy, errors.Errorf("account %d is cold "+
+			"and its outputs may not be spent", account))
+	case udb.SpendPolicyWarm:
+		if !isManualSpend(ctx) {
+			return errors.E(errors.Policy, errors.Errorf("account %d is warm "+
+				"and its outputs may only be spent by manual requests", account))
+		}
+	}
+	return nil
+}
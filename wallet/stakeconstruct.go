@@ -0,0 +1,273 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/deployments"
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txauthor"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// NewUnsignedTicket constructs an unsigned ticket purchase transaction
+// spending unspent outputs of an account, for signing outside of the wallet.
+// Unlike tickets purchased by the wallet, the ticket is funded directly
+// without a split transaction: every input is paired with a commitment to
+// commitmentAddr and a stake change output, and any change is returned to
+// changeAddr (or a new internal address of the account, when nil).  The
+// selected outputs are not locked.
+func (w *Wallet) NewUnsignedTicket(ctx context.Context, account uint32, minConf int32,
+	ticketPrice, feePerKb dcrutil.Amount, votingAddr, commitmentAddr,
+	changeAddr stdaddr.StakeAddress) (*txauthor.AuthoredTx, error) {
+
+	const op errors.Op = "wallet.NewUnsignedTicket"
+
+	if ticketPrice <= 0 {
+		return nil, errors.E(op, errors.Invalid, "ticket price must be positive")
+	}
+	if votingAddr == nil || commitmentAddr == nil {
+		return nil, errors.E(op, errors.Invalid, "nil voting or commitment address")
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return checkSpendPolicy(ctx, dbtx, account)
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Each input adds a ticket commitment and an OP_SSTXCHANGE tagged
+	// output.  Size estimates assume P2PKH inputs and change.
+	const stakeSubmissionPkScriptSize = txsizes.P2PKHPkScriptSize + 1
+	const stakeChangePkScriptSize = txsizes.P2PKHPkScriptSize + 1
+	estimateSize := func(inputs int) int {
+		inSizes := make([]int, inputs)
+		outSizes := []int{stakeSubmissionPkScriptSize}
+		for i := range inSizes {
+			inSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+			outSizes = append(outSizes, txsizes.TicketCommitmentScriptSize,
+				stakeChangePkScriptSize)
+		}
+		return txsizes.EstimateSerializeSizeFromScriptSizes(inSizes,
+			outSizes, 0)
+	}
+
+	// Select inputs for the ticket price and fee, increasing the fee
+	// estimate until it covers the number of selected inputs.
+	var inputs []Input
+	var fee dcrutil.Amount
+	for n := 1; ; {
+		fee = txrules.FeeForSerializeSize(feePerKb, estimateSize(n))
+		inputs, err = w.ReserveOutputsForAmount(ctx, account,
+			ticketPrice+fee, minConf)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		for i := range inputs {
+			w.UnlockOutpoint(&inputs[i].OutPoint.Hash, inputs[i].OutPoint.Index)
+		}
+		if len(inputs) <= n {
+			break
+		}
+		n = len(inputs)
+	}
+
+	amounts := make([]int64, len(inputs))
+	changes := make([]int64, len(inputs))
+	var totalInput int64
+	for i := range inputs {
+		amounts[i] = inputs[i].PrevOut.Value
+		totalInput += amounts[i]
+	}
+
+	// Return the excess input value as change, starting with the last
+	// selected input, unless it is dust and is better paid as fee.
+	change := totalInput - int64(ticketPrice) - int64(fee)
+	if txrules.IsDustAmount(dcrutil.Amount(change), stakeChangePkScriptSize, feePerKb) {
+		change = 0
+	}
+	if change > 0 && changeAddr == nil {
+		addr, err := w.NewChangeAddress(ctx, account)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		var ok bool
+		changeAddr, ok = addr.(stdaddr.StakeAddress)
+		if !ok {
+			return nil, errors.E(op, errors.Bug,
+				errors.Errorf("change address %v is not a stake address", addr))
+		}
+	}
+	for i := len(inputs) - 1; i >= 0 && change > 0; i-- {
+		changes[i] = min(change, amounts[i])
+		change -= changes[i]
+	}
+	_, amountsCommitted, err := stake.SStxNullOutputAmounts(amounts,
+		changes, int64(ticketPrice))
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+
+	// Zero value change outputs pay to a zeroed P2PKH address.
+	zeroed := [20]byte{}
+	addrZeroed, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(zeroed[:],
+		w.chainParams)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	mtx := wire.NewMsgTx()
+	vers, pkScript := votingAddr.VotingRightsScript()
+	mtx.AddTxOut(&wire.TxOut{
+		Value:    int64(ticketPrice),
+		Version:  vers,
+		PkScript: pkScript,
+	})
+	prevScripts := make([][]byte, 0, len(inputs))
+	changeIndex := -1
+	for i := range inputs {
+		in := &inputs[i]
+		mtx.AddTxIn(wire.NewTxIn(&in.OutPoint, in.PrevOut.Value, nil))
+		prevScripts = append(prevScripts, in.PrevOut.PkScript)
+
+		vers, pkScript := commitmentAddr.RewardCommitmentScript(
+			amountsCommitted[i], 0, revocationFeeLimit)
+		mtx.AddTxOut(&wire.TxOut{
+			Value:    0,
+			Version:  vers,
+			PkScript: pkScript,
+		})
+
+		changeDest := addrZeroed
+		if changes[i] != 0 {
+			changeDest = changeAddr
+			changeIndex = len(mtx.TxOut)
+		}
+		vers, pkScript = changeDest.StakeChangeScript()
+		mtx.AddTxOut(&wire.TxOut{
+			Value:    changes[i],
+			Version:  vers,
+			PkScript: pkScript,
+		})
+	}
+
+	if err := stake.CheckSStx(mtx); err != nil {
+		return nil, errors.E(op, errors.Bug, err)
+	}
+
+	return &txauthor.AuthoredTx{
+		Tx:                           mtx,
+		PrevScripts:                  prevScripts,
+		TotalInput:                   dcrutil.Amount(totalInput),
+		ChangeIndex:                  changeIndex,
+		EstimatedSignedSerializeSize: estimateSize(len(inputs)),
+	}, nil
+}
+
+// ticketPurchase returns the ticket purchase transaction of a ticket recorded
+// by the wallet.
+func (w *Wallet) ticketPurchase(ctx context.Context, ticketHash *chainhash.Hash) (*wire.MsgTx, error) {
+	var ticket *wire.MsgTx
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		ticket, err = w.txStore.Tx(txmgrNs, ticketHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !stake.IsSStx(ticket) {
+		return nil, errors.E(errors.Invalid,
+			errors.Errorf("%v is not a ticket", ticketHash))
+	}
+	return ticket, nil
+}
+
+// NewUnsignedVote constructs an unsigned vote of a ticket recorded by the
+// wallet, voting on the block with the given hash and height using the
+// provided vote bits.  The second input, which redeems the ticket, must be
+// signed by the holder of the ticket's voting rights.
+func (w *Wallet) NewUnsignedVote(ctx context.Context, ticketHash, blockHash *chainhash.Hash,
+	blockHeight int32, voteBits stake.VoteBits) (*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.NewUnsignedVote"
+
+	ticket, err := w.ticketPurchase(ctx, ticketHash)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Without a RPC syncer, DCP0010 and DCP0012 are assumed to have
+	// activated.
+	dcp0010Active := true
+	dcp0012Active := true
+	if n, err := w.NetworkBackend(); err == nil {
+		if dq, ok := n.(deployments.Querier); ok {
+			dcp0010Active, err = deployments.DCP0010Active(ctx,
+				blockHeight, w.chainParams, dq)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			dcp0012Active, err = deployments.DCP0012Active(ctx,
+				blockHeight, w.chainParams, dq)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+	}
+
+	vote, err := createUnsignedVote(ticketHash, ticket, blockHeight,
+		blockHash, voteBits, w.subsidyCache, w.chainParams,
+		dcp0010Active, dcp0012Active)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return vote, nil
+}
+
+// NewUnsignedRevocation constructs an automatic revocation of a missed or
+// expired ticket recorded by the wallet.  The revocation amounts depend on the
+// header of the block the revocation is mined after, which is the main chain
+// tip when prevBlockHash is nil.  Automatic revocations do not pay a fee and
+// do not require a signature.
+func (w *Wallet) NewUnsignedRevocation(ctx context.Context, ticketHash,
+	prevBlockHash *chainhash.Hash) (*wire.MsgTx, error) {
+
+	const op errors.Op = "wallet.NewUnsignedRevocation"
+
+	ticket, err := w.ticketPurchase(ctx, ticketHash)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if prevBlockHash == nil {
+		tipHash, _ := w.MainChainTip(ctx)
+		prevBlockHash = &tipHash
+	}
+	header, err := w.BlockHeader(ctx, prevBlockHash)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	headerBytes, err := header.Bytes()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	revocation, err := stake.CreateRevocationFromTicket(ticketHash,
+		stake.ConvertToMinimalOutputs(ticket), 0,
+		stake.TxVersionAutoRevocations, w.chainParams, headerBytes, true)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	return revocation, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// EarningsPeriod is the length of the periods stake earnings are grouped by.
+type EarningsPeriod uint8
+
+// Periods of stake earnings.  All periods begin at midnight UTC, and weeks
+// begin on Monday.
+const (
+	EarningsDaily EarningsPeriod = iota
+	EarningsWeekly
+	EarningsMonthly
+	EarningsYearly
+)
+
+// start returns the start of the period containing t.
+func (p EarningsPeriod) start(t time.Time) time.Time {
+	day := utcDay(t)
+	switch p {
+	case EarningsWeekly:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case EarningsMonthly:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case EarningsYearly:
+		return time.Date(day.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// StakeEarnings describes the vote rewards earned by the wallet's tickets and
+// the fees paid to purchase them.
+type StakeEarnings struct {
+	Tickets    uint32 // Tickets purchased
+	Votes      uint32 // Votes cast
+	Rewards    dcrutil.Amount
+	TicketFees dcrutil.Amount // Transaction fees of ticket purchases
+	PoolFees   dcrutil.Amount // Fees paid to VSPs
+}
+
+// Net returns the rewards less all fees paid.
+func (e *StakeEarnings) Net() dcrutil.Amount {
+	return e.Rewards - e.TicketFees - e.PoolFees
+}
+
+func (e *StakeEarnings) add(o *StakeEarnings) {
+	e.Tickets += o.Tickets
+	e.Votes += o.Votes
+	e.Rewards += o.Rewards
+	e.TicketFees += o.TicketFees
+	e.PoolFees += o.PoolFees
+}
+
+// PeriodStakeEarnings describes the stake earnings of a single period.
+type PeriodStakeEarnings struct {
+	Start time.Time
+	StakeEarnings
+}
+
+// StakeEarnings returns the lifetime stake earnings of the wallet, and the
+// earnings of every period in which a ticket was purchased or a vote was cast,
+// oldest first.  Rewards are attributed to the period of the vote, and fees to
+// the period of the ticket purchase.  Times are taken from the block a
+// transaction is mined in, or the time it was received when unmined.
+//
+// As with StakeInfo, rewards are the stakebase sum of each vote, and only
+// tickets the wallet has voting authority for are considered.  Ticket fees
+// are only known for tickets funded entirely by the wallet.
+func (w *Wallet) StakeEarnings(ctx context.Context, period EarningsPeriod) (*StakeEarnings, []PeriodStakeEarnings, error) {
+	const op errors.Op = "wallet.StakeEarnings"
+
+	periods := make(map[time.Time]*StakeEarnings)
+	periodOf := func(t time.Time) *StakeEarnings {
+		start := period.start(t)
+		e := periods[start]
+		if e == nil {
+			e = new(StakeEarnings)
+			periods[start] = e
+		}
+		return e
+	}
+	txTime := func(details *udb.TxDetails) time.Time {
+		if details.Block.Height == -1 {
+			return details.Received
+		}
+		return details.Block.Time
+	}
+
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		it := w.txStore.IterateTickets(dbtx)
+		defer it.Close()
+		for it.Next() {
+			owned, _, err := w.hasVotingAuthority(addrmgrNs, &it.MsgTx)
+			if err != nil {
+				return err
+			}
+			if !owned {
+				continue
+			}
+
+			ticket, err := w.txStore.TxDetails(txmgrNs, &it.Hash)
+			if err != nil {
+				return err
+			}
+			e := periodOf(txTime(ticket))
+			e.Tickets++
+			if len(ticket.Debits) == len(ticket.MsgTx.TxIn) {
+				var fee int64
+				for _, in := range ticket.MsgTx.TxIn {
+					fee += in.ValueIn
+				}
+				for _, out := range ticket.MsgTx.TxOut {
+					fee -= out.Value
+				}
+				e.TicketFees += dcrutil.Amount(fee)
+			}
+			poolFee, err := w.ticketPoolFee(dbtx, &it.Hash)
+			if err != nil {
+				return err
+			}
+			e.PoolFees += poolFee
+
+			if it.SpenderHash == (chainhash.Hash{}) {
+				continue
+			}
+			spender, err := w.txStore.TxDetails(txmgrNs, &it.SpenderHash)
+			if err != nil {
+				return err
+			}
+			switch {
+			case isVote(&spender.MsgTx):
+				e := periodOf(txTime(spender))
+				e.Votes++
+				e.Rewards += dcrutil.Amount(spender.MsgTx.TxIn[0].ValueIn)
+			case isRevocation(&spender.MsgTx):
+			default:
+				return errors.E(errors.IO, errors.Errorf("ticket spender %v is neither vote nor revocation", &it.SpenderHash))
+			}
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return nil, nil, errors.E(op, err)
+	}
+
+	lifetime := new(StakeEarnings)
+	byPeriod := make([]PeriodStakeEarnings, 0, len(periods))
+	for start, e := range periods {
+		lifetime.add(e)
+		byPeriod = append(byPeriod, PeriodStakeEarnings{Start: start, StakeEarnings: *e})
+	}
+	sort.Slice(byPeriod, func(i, j int) bool {
+		return byPeriod[i].Start.Before(byPeriod[j].Start)
+	})
+	return lifetime, byPeriod, nil
+}
+
+// ticketPoolFee returns the fee paid to a VSP for a ticket, or zero if no fee
+// payment is recorded.
+func (w *Wallet) ticketPoolFee(dbtx walletdb.ReadTx, ticketHash *chainhash.Hash) (dcrutil.Amount, error) {
+	data, err := udb.GetVSPTicket(dbtx, *ticketHash)
+	if errors.Is(err, errors.NotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if data.FeeHash == (chainhash.Hash{}) {
+		return 0, nil
+	}
+	switch udb.FeeStatus(data.FeeTxStatus) {
+	case udb.VSPFeeProcessPaid, udb.VSPFeeProcessConfirmed:
+	default:
+		return 0, nil
+	}
+	if data.FeeAmount != 0 {
+		return dcrutil.Amount(data.FeeAmount), nil
+	}
+	amount, err := w.vspFeeAmount(dbtx, &data.FeeHash)
+	if errors.Is(err, errors.NotExist) {
+		return 0, nil
+	}
+	return amount, err
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEarningsPeriodStart(t *testing.T) {
+	t.Parallel()
+
+	// Thursday, late in the UTC day.
+	tm := time.Date(2024, time.February, 29, 23, 30, 0, 0, time.UTC)
+	tests := []struct {
+		period EarningsPeriod
+		t      time.Time
+		start  time.Time
+	}{
+		{EarningsDaily, tm, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{EarningsWeekly, tm, time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)},
+		{EarningsMonthly, tm, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{EarningsYearly, tm, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+
+		// Local times are grouped by their UTC day.
+		{EarningsDaily, tm.In(time.FixedZone("UTC+2", 2*60*60)),
+			time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+
+		// Weeks begin on Monday, including for Sundays.
+		{EarningsWeekly, time.Date(2024, time.March, 3, 12, 0, 0, 0, time.UTC),
+			time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)},
+		{EarningsWeekly, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, test := range tests {
+		start := test.period.start(test.t)
+		if !start.Equal(test.start) {
+			t.Errorf("test %d: start of period %d containing %v is %v, "+
+				"want %v", i, test.period, test.t, start, test.start)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sync"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// maxSweepInputs limits the number of inputs spent by a single sweep
+// transaction.
+const maxSweepInputs = 500
+
+// sweepKind describes the kinds of matured outputs selected for sweeping.
+type sweepKind uint8
+
+const (
+	// sweepStakeTree selects all stake tree outputs: votes, revocations,
+	// ticket change, and treasury outputs.
+	sweepStakeTree sweepKind = 1 << iota
+
+	// sweepRewards selects vote, revocation, and coinbase outputs.
+	sweepRewards
+)
+
+// SweepStakeOutputs moves the matured stake tree outputs (from votes,
+// revocations, ticket change, and treasury transactions) of every other
+// account into a new internal address of account.  One transaction is
+// published for each account with sweepable outputs, and the hashes of the
+// published transactions are returned.  Accounts whose keys are locked, and
+// accounts whose sweepable outputs would not cover the transaction fee, are
+// skipped.
+func (w *Wallet) SweepStakeOutputs(ctx context.Context, account uint32) ([]*chainhash.Hash, error) {
+	const op errors.Op = "wallet.SweepStakeOutputs"
+	hashes, err := w.sweepMatured(ctx, op, account, sweepStakeTree, 0)
+	if err != nil {
+		return hashes, errors.E(op, err)
+	}
+	return hashes, nil
+}
+
+// SweepMaturedRewards consolidates the matured coinbase, vote, and revocation
+// outputs of every account, including account itself, into a new internal
+// address of account.  One transaction is published for each account with
+// sweepable outputs, and the hashes of the published transactions are
+// returned.  When maxFee is non-zero, no sweep transaction pays a fee greater
+// than maxFee, and remaining outputs are swept by later calls.  Accounts whose
+// keys are locked are skipped.
+func (w *Wallet) SweepMaturedRewards(ctx context.Context, account uint32, maxFee dcrutil.Amount) ([]*chainhash.Hash, error) {
+	const op errors.Op = "wallet.SweepMaturedRewards"
+	hashes, err := w.sweepMatured(ctx, op, account, sweepRewards, maxFee)
+	if err != nil {
+		return hashes, errors.E(op, err)
+	}
+	return hashes, nil
+}
+
+func (w *Wallet) sweepMatured(ctx context.Context, op errors.Op, account uint32,
+	kind sweepKind, maxFee dcrutil.Amount) ([]*chainhash.Hash, error) {
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	var accounts []uint32
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		return w.manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
+			if acct != account || kind&sweepRewards != 0 {
+				accounts = append(accounts, acct)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []*chainhash.Hash
+	for _, acct := range accounts {
+		var tx *wire.MsgTx
+		var inputs int
+		err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			err := checkSpendPolicy(ctx, dbtx, acct)
+			if err != nil {
+				return err
+			}
+			_, tipHeight := w.txStore.MainChainTip(dbtx)
+			eligible, err := w.findSweepableOutputs(dbtx, acct, tipHeight, kind)
+			if err != nil {
+				return err
+			}
+			// A single output already in the destination account
+			// is not consolidated.
+			if len(eligible) == 0 || (acct == account && len(eligible) == 1) {
+				return nil
+			}
+			tx, err = w.sweepInputs(ctx, op, dbtx, n, eligible,
+				maxSweepInputs, maxFee, account, nil)
+			if tx != nil {
+				inputs = len(tx.TxIn)
+			}
+			return err
+		})
+		switch {
+		case errors.Is(err, errors.Locked), errors.Is(err, errors.Invalid),
+			errors.Is(err, errors.Policy):
+			log.Debugf("Skipping sweep of account %d: %v", acct, err)
+			continue
+		case err != nil:
+			return hashes, err
+		case tx == nil:
+			continue
+		}
+		hash := tx.TxHash()
+		log.Infof("Swept %d matured outputs of account %d in transaction %v",
+			inputs, acct, &hash)
+		hashes = append(hashes, &hash)
+		w.NtfnServer.notifySweep(&SweepNotification{
+			Hash:          &hash,
+			SourceAccount: acct,
+			Account:       account,
+			Inputs:        inputs,
+			Amount:        dcrutil.Amount(tx.TxOut[0].Value),
+		})
+	}
+	return hashes, nil
+}
+
+// findSweepableOutputs returns the matured P2PKH (or stake-tagged P2PKH)
+// unspent outputs of an account which are selected by kind.  The
+// lockedOutpointMu mutex must be held.
+func (w *Wallet) findSweepableOutputs(dbtx walletdb.ReadTx, account uint32, tipHeight int32,
+	kind sweepKind) ([]Input, error) {
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	unspent, err := w.txStore.UnspentOutputs(dbtx)
+	if err != nil {
+		return nil, err
+	}
+	var eligible []Input
+	for _, output := range unspent {
+		if _, locked := w.lockedOutpoints[outpoint{output.Hash, output.Index}]; locked {
+			continue
+		}
+		if !confirmed(1, output.Height, tipHeight) {
+			continue
+		}
+
+		class, addrs := stdscript.ExtractAddrs(scriptVersionAssumed,
+			output.PkScript, w.chainParams)
+		if len(addrs) != 1 {
+			continue
+		}
+		subClass, _ := txrules.StakeSubScriptType(class)
+		if subClass != stdscript.STPubKeyHashEcdsaSecp256k1 {
+			continue
+		}
+		var selected, mature bool
+		switch class {
+		case stdscript.STStakeGenPubKeyHash, stdscript.STStakeRevocationPubKeyHash:
+			selected = kind&(sweepStakeTree|sweepRewards) != 0
+			mature = coinbaseMatured(w.chainParams, output.Height, tipHeight)
+		case stdscript.STTreasuryGenPubKeyHash:
+			selected = kind&sweepStakeTree != 0
+			mature = coinbaseMatured(w.chainParams, output.Height, tipHeight)
+		case stdscript.STStakeChangePubKeyHash:
+			selected = kind&sweepStakeTree != 0
+			mature = ticketChangeMatured(w.chainParams, output.Height, tipHeight)
+		case stdscript.STPubKeyHashEcdsaSecp256k1:
+			selected = kind&sweepRewards != 0 && output.FromCoinBase
+			mature = coinbaseMatured(w.chainParams, output.Height, tipHeight)
+		}
+		if !selected || !mature {
+			continue
+		}
+
+		addrAcct, err := w.manager.AddrAccount(addrmgrNs, addrs[0])
+		if err != nil || addrAcct != account {
+			continue
+		}
+		eligible = append(eligible, Input{
+			OutPoint: output.OutPoint,
+			PrevOut: wire.TxOut{
+				Value:    int64(output.Amount),
+				Version:  scriptVersionAssumed,
+				PkScript: output.PkScript,
+			},
+		})
+	}
+	return eligible, nil
+}
+
+// RunStakeSweep sweeps matured stake tree outputs of all other accounts into
+// account each time a block is attached to the main chain, until the context
+// is canceled.
+func (w *Wallet) RunStakeSweep(ctx context.Context, account uint32) error {
+	const op errors.Op = "wallet.RunStakeSweep"
+	err := w.checkSweepAccount(ctx, account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return w.runOnAttachedBlocks(ctx, func() {
+		_, err := w.SweepStakeOutputs(ctx, account)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("%v: %v", op, err)
+		}
+	})
+}
+
+// RunMaturedRewardsSweep consolidates the matured coinbase, vote, and
+// revocation outputs of all accounts into account each time a block is
+// attached to the main chain, until the context is canceled.  No sweep
+// transaction pays a fee greater than maxFee, when non-zero.  Sweeps are
+// delivered to SweepNotifications clients.
+func (w *Wallet) RunMaturedRewardsSweep(ctx context.Context, account uint32, maxFee dcrutil.Amount) error {
+	const op errors.Op = "wallet.RunMaturedRewardsSweep"
+	err := w.checkSweepAccount(ctx, account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return w.runOnAttachedBlocks(ctx, func() {
+		_, err := w.SweepMaturedRewards(ctx, account, maxFee)
+		if err != nil && ctx.Err() == nil {
+			log.Errorf("%v: %v", op, err)
+		}
+	})
+}
+
+func (w *Wallet) checkSweepAccount(ctx context.Context, account uint32) error {
+	if account == udb.ImportedAddrAccount {
+		return errors.E(errors.Invalid, "cannot sweep to the imported account")
+	}
+	return walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := w.manager.AccountName(addrmgrNs, account)
+		return err
+	})
+}
+
+// runOnAttachedBlocks calls fn after blocks are attached to the main chain,
+// until the context is canceled.  Calls are performed by a separate goroutine
+// so that tip change notifications are not blocked while fn runs, and
+// pending calls are coalesced when a previous call is in progress.
+func (w *Wallet) runOnAttachedBlocks(ctx context.Context, fn func()) error {
+	pending := make(chan struct{}, 1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range pending {
+			fn()
+		}
+	}()
+	defer wg.Wait()
+	defer close(pending)
+
+	tipChange := w.NtfnServer.MainTipChangedNotifications()
+	defer tipChange.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n := <-tipChange.C:
+			if len(n.AttachedBlocks) == 0 {
+				continue
+			}
+			select {
+			case pending <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
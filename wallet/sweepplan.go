@@ -0,0 +1,247 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+)
+
+// SweepPlanTx is a single unsigned transaction of a sweep plan.
+type SweepPlanTx struct {
+	Tx         *wire.MsgTx
+	TotalInput dcrutil.Amount
+	Fee        dcrutil.Amount
+
+	inputs []Input
+}
+
+// SweepPlan is a set of transactions sweeping the spendable outputs of one or
+// more accounts to a single address.  Plans are deterministic: the same
+// outputs, destination and fee rate always result in the same transactions
+// and plan ID.
+type SweepPlan struct {
+	// ID commits to every transaction of the plan.
+	ID chainhash.Hash
+
+	Transactions []*SweepPlanTx
+
+	// Uneconomic is the number of outputs which are not swept because
+	// their value does not pay the fee to spend them.
+	Uneconomic int
+}
+
+// planSweep computes a sweep plan.  The lockedOutpointMu mutex must be held.
+func (w *Wallet) planSweep(ctx context.Context, dbtx walletdb.ReadTx, accounts []uint32,
+	minConf int32, feeRate dcrutil.Amount, dest stdaddr.Address) (*SweepPlan, error) {
+
+	if len(accounts) == 0 {
+		return nil, errors.E(errors.Invalid, "no accounts to sweep")
+	}
+
+	_, tipHeight := w.txStore.MainChainTip(dbtx)
+	var eligible []Input
+	for _, account := range accounts {
+		err := checkSpendPolicy(ctx, dbtx, account)
+		if err != nil {
+			return nil, err
+		}
+		inputs, err := w.findEligibleOutputs(dbtx, account, minConf,
+			tipHeight, false)
+		if err != nil {
+			return nil, err
+		}
+		eligible = append(eligible, inputs...)
+	}
+	slices.SortFunc(eligible, func(a, b Input) int {
+		if c := bytes.Compare(a.OutPoint.Hash[:], b.OutPoint.Hash[:]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.OutPoint.Index, b.OutPoint.Index)
+	})
+
+	maxTxSize := w.maxTxSize
+	if w.chainParams.Net == wire.MainNet && maxStandardTxSize < maxTxSize {
+		maxTxSize = maxStandardTxSize
+	}
+	vers, pkScript := dest.PaymentScript()
+	out := &wire.TxOut{Version: vers, PkScript: pkScript}
+	estimateSize := func(inputs int) int {
+		scriptSizes := make([]int, inputs)
+		for i := range scriptSizes {
+			scriptSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+		}
+		return txsizes.EstimateSerializeSize(scriptSizes,
+			[]*wire.TxOut{out}, 0)
+	}
+	maxInputs := 0
+	for estimateSize(maxInputs+1) <= maxTxSize {
+		maxInputs++
+	}
+	if maxInputs == 0 {
+		return nil, errors.E(errors.Invalid, "destination output exceeds "+
+			"the maximum transaction size")
+	}
+
+	plan := new(SweepPlan)
+	inputFee := txrules.FeeForSerializeSize(feeRate, txsizes.RedeemP2PKHInputSize)
+	economic := eligible[:0]
+	for i := range eligible {
+		if dcrutil.Amount(eligible[i].PrevOut.Value) <= inputFee {
+			plan.Uneconomic++
+			continue
+		}
+		economic = append(economic, eligible[i])
+	}
+
+	ids := make([]byte, 0, (len(economic)/maxInputs+1)*chainhash.HashSize)
+	for len(economic) > 0 {
+		n := min(len(economic), maxInputs)
+		inputs := economic[:n:n]
+		economic = economic[n:]
+
+		tx := wire.NewMsgTx()
+		var totalInput dcrutil.Amount
+		for i := range inputs {
+			tx.AddTxIn(wire.NewTxIn(&inputs[i].OutPoint,
+				inputs[i].PrevOut.Value, nil))
+			totalInput += dcrutil.Amount(inputs[i].PrevOut.Value)
+		}
+		fee := txrules.FeeForSerializeSize(feeRate, estimateSize(n))
+		tx.AddTxOut(&wire.TxOut{
+			Value:    int64(totalInput - fee),
+			Version:  out.Version,
+			PkScript: out.PkScript,
+		})
+		if txrules.IsDustOutput(tx.TxOut[0], feeRate) {
+			plan.Uneconomic += n
+			continue
+		}
+
+		plan.Transactions = append(plan.Transactions, &SweepPlanTx{
+			Tx:         tx,
+			TotalInput: totalInput,
+			Fee:        fee,
+			inputs:     inputs,
+		})
+		txHash := tx.TxHash()
+		ids = append(ids, txHash[:]...)
+	}
+	plan.ID = chainhash.HashH(ids)
+
+	return plan, nil
+}
+
+// PlanSweep plans the transactions to sweep all spendable outputs of the
+// accounts with at least minConf confirmations to dest, paying feeRate.
+// Outputs are spent in outpoint order, using as few transactions as the
+// maximum transaction size allows.  The planned transactions are not signed
+// or published; the plan is executed by ExecuteSweepPlan.
+func (w *Wallet) PlanSweep(ctx context.Context, accounts []uint32, minConf int32,
+	feeRate dcrutil.Amount, dest stdaddr.Address) (*SweepPlan, error) {
+
+	const op errors.Op = "wallet.PlanSweep"
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	var plan *SweepPlan
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		plan, err = w.planSweep(ctx, dbtx, accounts, minConf, feeRate, dest)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return plan, nil
+}
+
+// ExecuteSweepPlan recomputes the sweep plan described by the arguments,
+// and signs and publishes its transactions if it matches the plan previously
+// returned by PlanSweep with the same ID.  Errors with Invalid if the plan
+// has changed, for example because the wallet received or spent outputs of
+// the accounts.  The hashes of the published transactions are returned.
+func (w *Wallet) ExecuteSweepPlan(ctx context.Context, id *chainhash.Hash, accounts []uint32,
+	minConf int32, feeRate dcrutil.Amount, dest stdaddr.Address) ([]*chainhash.Hash, error) {
+
+	const op errors.Op = "wallet.ExecuteSweepPlan"
+
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	var hashes []*chainhash.Hash
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+		plan, err := w.planSweep(ctx, dbtx, accounts, minConf, feeRate, dest)
+		if err != nil {
+			return err
+		}
+		if plan.ID != *id {
+			return errors.E(errors.Invalid, "sweep plan has changed")
+		}
+
+		txs := make([]*wire.MsgTx, 0, len(plan.Transactions))
+		for _, ptx := range plan.Transactions {
+			tx := ptx.Tx
+			err := w.signP2PKHMsgTx(tx, ptx.inputs, addrmgrNs)
+			if err != nil {
+				return err
+			}
+			err = validateMsgTx(op, tx, creditScripts(ptx.inputs))
+			if err != nil {
+				return err
+			}
+			err = w.checkHighFees(ptx.TotalInput, tx)
+			if err != nil {
+				return err
+			}
+			txs = append(txs, tx)
+		}
+		if len(txs) == 0 {
+			return errors.E(errors.Invalid, "sweep plan has no transactions")
+		}
+
+		err = n.PublishTransactions(ctx, txs...)
+		if err != nil {
+			return err
+		}
+		for _, tx := range txs {
+			rec, err := w.insertIntoTxMgr(dbtx, tx)
+			if err != nil {
+				return err
+			}
+			err = w.insertCreditsIntoTxMgr(op, dbtx, tx, rec)
+			if err != nil {
+				return err
+			}
+			txHash := tx.TxHash()
+			hashes = append(hashes, &txHash)
+			log.Infof("Published sweep plan %v transaction %v", id, &txHash)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return hashes, nil
+}
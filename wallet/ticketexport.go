@@ -0,0 +1,165 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4/stdscript"
+	"github.com/decred/dcrd/wire"
+)
+
+// TicketExport describes the voting rights of a single ticket, allowing the
+// duty of voting the ticket to be handed over to another wallet.
+type TicketExport struct {
+	// Ticket is the ticket purchase transaction.
+	Ticket *wire.MsgTx
+
+	// BlockHash is the block the ticket is mined in, and is nil for
+	// unmined tickets.
+	BlockHash *chainhash.Hash
+
+	// VotingKeys are the private keys of the ticket's voting address, or
+	// the keys owned by the exporting wallet of a P2SH voting address.
+	VotingKeys []*dcrutil.WIF
+
+	// RedeemScript is the redeem script of a P2SH voting address.
+	RedeemScript []byte
+}
+
+// ExportTicket returns the voting rights of a ticket owned by the wallet: the
+// ticket transaction, the block it is mined in, and the private keys (and
+// redeem script, for P2SH voting addresses) required to vote it.  The wallet
+// must be unlocked, and errors with NotExist if the wallet does not hold any
+// private key for the ticket's voting address.
+func (w *Wallet) ExportTicket(ctx context.Context, ticketHash *chainhash.Hash) (*TicketExport, error) {
+	const op errors.Op = "wallet.ExportTicket"
+
+	e := new(TicketExport)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+		details, err := w.txStore.TxDetails(txmgrNs, ticketHash)
+		if err != nil {
+			return err
+		}
+		if details.TxType != stake.TxTypeSStx {
+			return errors.E(errors.Invalid,
+				errors.Errorf("%v is not a ticket", ticketHash))
+		}
+		e.Ticket = &details.MsgTx
+		if details.Block.Height != -1 {
+			blockHash := details.Block.Hash
+			e.BlockHash = &blockHash
+		}
+
+		out := e.Ticket.TxOut[0]
+		class, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript,
+			w.chainParams)
+		if class == stdscript.STStakeSubmissionScriptHash && len(addrs) == 1 {
+			e.RedeemScript, err = w.manager.RedeemScript(addrmgrNs, addrs[0])
+			if err != nil {
+				return err
+			}
+			_, addrs = stdscript.ExtractAddrs(scriptVersionAssumed,
+				e.RedeemScript, w.chainParams)
+		}
+		for _, addr := range addrs {
+			key, zero, err := w.manager.PrivateKey(addrmgrNs, addr)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			wif, err := dcrutil.NewWIF(key.Serialize(),
+				w.chainParams.PrivateKeyID, dcrec.STEcdsaSecp256k1)
+			zero()
+			if err != nil {
+				return err
+			}
+			e.VotingKeys = append(e.VotingKeys, wif)
+		}
+		if len(e.VotingKeys) == 0 {
+			return errors.E(errors.NotExist, errors.Errorf("no voting "+
+				"keys for ticket %v", ticketHash))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return e, nil
+}
+
+// ImportTicket registers a ticket exported by another wallet for voting.  The
+// voting keys are imported to the imported account, the redeem script of a
+// P2SH voting address is imported, and the ticket is recorded as mined in its
+// block (or as unmined, when no block is specified), even when the wallet is
+// in manual ticket mode.  Keys and scripts already known to the wallet are
+// not an error.
+func (w *Wallet) ImportTicket(ctx context.Context, e *TicketExport) error {
+	const op errors.Op = "wallet.ImportTicket"
+
+	if e.Ticket == nil || !stake.IsSStx(e.Ticket) {
+		return errors.E(op, errors.Invalid, "export does not describe a ticket")
+	}
+	if len(e.VotingKeys) == 0 {
+		return errors.E(op, errors.Invalid, "export contains no voting keys")
+	}
+	for _, wif := range e.VotingKeys {
+		_, err := w.ImportPrivateKey(ctx, wif)
+		if err != nil && !errors.Is(err, errors.Exist) {
+			return errors.E(op, err)
+		}
+	}
+	if e.RedeemScript != nil {
+		err := w.ImportScript(ctx, e.RedeemScript)
+		if err != nil && !errors.Is(err, errors.Exist) {
+			return errors.E(op, err)
+		}
+	}
+
+	// The imported keys must give the wallet voting authority over the
+	// ticket.
+	var mine bool
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		mine, _, err = w.hasVotingAuthority(addrmgrNs, e.Ticket)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if !mine {
+		return errors.E(op, errors.Invalid, "voting keys do not match "+
+			"the ticket's voting address")
+	}
+
+	err = w.AddTransaction(ctx, e.Ticket, e.BlockHash)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if n, err := w.NetworkBackend(); err == nil {
+		ticketHash := e.Ticket.TxHash()
+		err := n.LoadTxFilter(ctx, false, nil, []wire.OutPoint{
+			{Hash: ticketHash, Index: 0, Tree: wire.TxTreeStake},
+		})
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	log.Infof("Imported voting rights of ticket %v", e.Ticket.TxHash())
+	return nil
+}
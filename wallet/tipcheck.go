@@ -0,0 +1,173 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+)
+
+// TipSource is an additional source of the best block height, such as a block
+// explorer or another node, which the wallet's main chain tip is cross-checked
+// against.
+type TipSource interface {
+	// BestHeight returns the height of the source's best block.
+	BestHeight(ctx context.Context) (int32, error)
+
+	// String describes the source in logs and status reports.
+	String() string
+}
+
+// HTTPTipSource is a TipSource which queries the best block height from an
+// HTTP endpoint.  The response body must be a JSON number, as returned by
+// dcrdata's /api/block/best/height endpoint, or a JSON object with a numeric
+// "height" field.
+type HTTPTipSource struct {
+	URL    string
+	Client *http.Client
+}
+
+// BestHeight implements the TipSource interface.
+func (s *HTTPTipSource) BestHeight(ctx context.Context) (int32, error) {
+	const op errors.Op = "wallet.HTTPTipSource.BestHeight"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, errors.E(op, errors.IO, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.E(op, errors.IO,
+			errors.Errorf("tip source responded with status %q", resp.Status))
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if err != nil {
+		return 0, errors.E(op, errors.IO, err)
+	}
+	var height int32
+	if err := json.Unmarshal(body, &height); err == nil {
+		return height, nil
+	}
+	var obj struct {
+		Height *int32 `json:"height"`
+	}
+	if err := json.Unmarshal(body, &obj); err != nil || obj.Height == nil {
+		return 0, errors.E(op, errors.Encoding,
+			"tip source response is not a block height")
+	}
+	return *obj.Height, nil
+}
+
+// String returns the URL of the source.
+func (s *HTTPTipSource) String() string {
+	return s.URL
+}
+
+// TipCheck describes the most recent cross-check of the wallet's main chain
+// tip against an additional tip source.
+type TipCheck struct {
+	Source       string
+	Time         time.Time
+	Height       int32 // Best height reported by the source
+	WalletHeight int32 // Wallet main chain tip height
+	Diverged     bool
+	Err          error // Error querying the source; heights are unset
+}
+
+// Divergence returns the number of blocks the source's best height is ahead
+// of (positive) or behind (negative) the wallet's main chain tip.
+func (c *TipCheck) Divergence() int32 {
+	return c.Height - c.WalletHeight
+}
+
+// TipChecks returns the most recent cross-checks of the main chain tip against
+// each source passed to RunTipChecks.
+func (w *Wallet) TipChecks() []TipCheck {
+	w.tipChecksMu.Lock()
+	defer w.tipChecksMu.Unlock()
+	return append([]TipCheck(nil), w.tipChecks...)
+}
+
+// RunTipChecks cross-checks the wallet's main chain tip against each source
+// at every interval until the context is canceled.  A source whose best
+// height differs from the main chain tip by more than maxDivergence blocks
+// raises an alert, which is logged, recorded as a wallet event, and sent to
+// TipDivergenceNotifications clients.  This protects voting wallets from a
+// stalled or eclipsed network backend.  Errors querying a source are logged
+// and do not raise an alert.
+func (w *Wallet) RunTipChecks(ctx context.Context, sources []TipSource,
+	maxDivergence int32, interval time.Duration) error {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		w.checkTips(ctx, sources, maxDivergence)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Wallet) checkTips(ctx context.Context, sources []TipSource, maxDivergence int32) {
+	checks := make([]TipCheck, len(sources))
+	for i, s := range sources {
+		c := &checks[i]
+		c.Source = s.String()
+		height, err := s.BestHeight(ctx)
+		c.Time = time.Now()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.Err = err
+			log.Warnf("Failed to query chain tip source %v: %v", s, err)
+			continue
+		}
+		_, c.WalletHeight = w.MainChainTip(ctx)
+		c.Height = height
+		divergence := c.Divergence()
+		c.Diverged = divergence > maxDivergence || -divergence > maxDivergence
+		if !c.Diverged {
+			continue
+		}
+
+		log.Warnf("Main chain tip height %d diverges by %d blocks from "+
+			"best height %d reported by %v; the network backend may be "+
+			"stalled or eclipsed", c.WalletHeight, divergence, height, s)
+		w.recordEvent(ctx, &udb.WalletEvent{
+			Kind:   udb.WalletEventTipDivergence,
+			Height: c.WalletHeight,
+			Detail: fmt.Sprintf("%v reports best height %d", s, height),
+		})
+		w.NtfnServer.notifyTipDivergence(&TipDivergenceNotification{
+			Source:       c.Source,
+			Height:       height,
+			WalletHeight: c.WalletHeight,
+		})
+	}
+
+	w.tipChecksMu.Lock()
+	w.tipChecks = checks
+	w.tipChecksMu.Unlock()
+}
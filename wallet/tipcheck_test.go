@@ -0,0 +1,53 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPTipSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		height int32
+		err    bool
+	}{
+		{"number", http.StatusOK, "912345\n", 912345, false},
+		{"object", http.StatusOK, `{"height":912345,"hash":"00"}`, 912345, false},
+		{"no height", http.StatusOK, `{"hash":"00"}`, 0, true},
+		{"not json", http.StatusOK, "<html></html>", 0, true},
+		{"status", http.StatusServiceUnavailable, "912345", 0, true},
+	}
+	for _, test := range tests {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.status)
+			io.WriteString(w, test.body)
+		}))
+		s := &HTTPTipSource{URL: srv.URL, Client: srv.Client()}
+		height, err := s.BestHeight(context.Background())
+		srv.Close()
+		if test.err {
+			if err == nil {
+				t.Errorf("%s: expected error, got height %d", test.name, height)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if height != test.height {
+			t.Errorf("%s: height %d, want %d", test.name, height, test.height)
+		}
+	}
+}
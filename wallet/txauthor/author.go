@@ -28,6 +28,10 @@ const (
 	generatedTxVersion = 1
 )
 
+// ErrTxTooLarge describes the error of authoring a transaction whose estimated
+// signed size exceeds the maximum transaction size.
+var ErrTxTooLarge = errors.New("signed tx size exceeds allowed maximum")
+
 // InputDetail provides a detailed summary of transaction inputs
 // referencing spendable outputs. This consists of the total spendable
 // amount, the generated inputs, the redeem scripts and the full redeem
@@ -124,7 +128,9 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb dcrutil.Amount,
 		}
 
 		if maxSignedSize > maxTxSize {
-			return nil, errors.E(errors.Invalid, "signed tx size exceeds allowed maximum")
+			return nil, errors.E(errors.Invalid, errors.Errorf("%w: "+
+				"estimated size %d bytes, maximum %d bytes",
+				ErrTxTooLarge, maxSignedSize, maxTxSize))
 		}
 
 		unsignedTransaction := &wire.MsgTx{
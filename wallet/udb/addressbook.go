@@ -0,0 +1,70 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var addressBookBucketKey = []byte("addressbook") // by contact name
+
+// Contact is an address book entry naming an external payment address.
+type Contact struct {
+	Name    string
+	Address string
+}
+
+// PutContact records an address book contact, replacing any existing contact
+// with the same name.
+func PutContact(dbtx walletdb.ReadWriteTx, c *Contact) error {
+	b := dbtx.ReadWriteBucket(addressBookBucketKey)
+	err := b.Put([]byte(c.Name), []byte(c.Address))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// ContactByName returns the address book contact with the given name.  Errors
+// with NotExist if there is no such contact.
+func ContactByName(dbtx walletdb.ReadTx, name string) (*Contact, error) {
+	b := dbtx.ReadBucket(addressBookBucketKey)
+	v := b.Get([]byte(name))
+	if v == nil {
+		return nil, errors.E(errors.NotExist,
+			errors.Errorf("no address book contact %q", name))
+	}
+	return &Contact{Name: name, Address: string(v)}, nil
+}
+
+// Contacts returns all address book contacts, sorted by name.
+func Contacts(dbtx walletdb.ReadTx) ([]*Contact, error) {
+	b := dbtx.ReadBucket(addressBookBucketKey)
+	var contacts []*Contact
+	err := b.ForEach(func(k, v []byte) error {
+		contacts = append(contacts, &Contact{
+			Name:    string(k),
+			Address: string(v),
+		})
+		return nil
+	})
+	return contacts, err
+}
+
+// DeleteContact removes the address book contact with the given name.  Errors
+// with NotExist if there is no such contact.
+func DeleteContact(dbtx walletdb.ReadWriteTx, name string) error {
+	b := dbtx.ReadWriteBucket(addressBookBucketKey)
+	if b.Get([]byte(name)) == nil {
+		return errors.E(errors.NotExist,
+			errors.Errorf("no address book contact %q", name))
+	}
+	err := b.Delete([]byte(name))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -9,7 +9,9 @@ import (
 	"crypto/subtle"
 	"fmt"
 	"hash"
+	"strings"
 	"sync"
+	"sync/atomic"
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/compat"
@@ -151,6 +153,45 @@ type accountInfo struct {
 	uniqueKey        *kdf.Argon2idParams
 	uniquePassHasher hash.Hash // blake2b-256 keyed hash with random bytes
 	uniquePassHash   []byte
+
+	// votingKeyPriv is the account private key decrypted by the voting
+	// domain crypto key.  It is not cleared by locking the manager, and is
+	// used to derive private keys when acctKeyPriv is nil.
+	votingKeyPriv *hdkeychain.ExtendedKey
+
+	// branchKeysPub caches the external and internal branch extended
+	// public keys derived from acctKeyPub.  They are loaded and stored
+	// atomically so that addresses may be derived by concurrent readers
+	// holding the manager mutex for reads.
+	branchKeysPub [2]atomic.Pointer[hdkeychain.ExtendedKey]
+}
+
+// branchKeyPub returns the cached extended public key of the external or
+// internal account branch, deriving it on first use.  The returned key must
+// not be zeroed by the caller.
+func (a *accountInfo) branchKeyPub(branch uint32) (*hdkeychain.ExtendedKey, error) {
+	if k := a.branchKeysPub[branch].Load(); k != nil {
+		return k, nil
+	}
+	k, err := a.acctKeyPub.Child(branch)
+	if err != nil {
+		return nil, err
+	}
+	// Another reader may have raced to derive the same key; keep whichever
+	// was stored first.
+	a.branchKeysPub[branch].CompareAndSwap(nil, k)
+	return a.branchKeysPub[branch].Load(), nil
+}
+
+// clearBranchKeys removes and zeros the cached branch extended public keys.
+//
+// This method MUST be called with the manager lock held for writes.
+func (a *accountInfo) clearBranchKeys() {
+	for i := range a.branchKeysPub {
+		if k := a.branchKeysPub[i].Swap(nil); k != nil {
+			k.Zero()
+		}
+	}
 }
 
 func argon2idKey(password []byte, k *kdf.Argon2idParams) keyType {
@@ -300,8 +341,16 @@ var newCryptoKey = defaultNewCryptoKey
 // Manager represents a concurrency safe crypto currency address manager and
 // key store.
 type Manager struct {
+	// mtx protects the manager state.  Methods which only read the state
+	// (including deriving keys and addresses) hold it for reads, so that
+	// address lookups and balance queries do not serialize with each other
+	// or with address derivation.
 	mtx sync.RWMutex
 
+	// acctInfoMu protects the acctInfo map when the map is accessed with mtx
+	// held only for reads.  Holding mtx for writes is sufficient on its own.
+	acctInfoMu sync.Mutex
+
 	chainParams  *chaincfg.Params
 	watchingOnly bool
 	locked       bool
@@ -342,6 +391,11 @@ type Manager struct {
 	privPassphraseHasher   hash.Hash
 	privPassphraseHasherMu sync.Mutex // protects privPassphraseHasher
 	privPassphraseHash     []byte     // protected by m.mtx, not privPassphraseHasherMu
+
+	// votingCryptoKey is the key used to encrypt the private keys of the
+	// voting domain accounts.  It is nil when the voting domain is locked,
+	// and is not cleared by locking the manager.
+	votingCryptoKey keyType
 }
 
 func zero(b []byte) {
@@ -376,12 +430,28 @@ func (m *Manager) lock() {
 	m.privPassphraseHash = nil
 }
 
+// lockVotingDomain removes and zeros the voting domain crypto key and the
+// account private keys decrypted by it.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) lockVotingDomain() {
+	for _, acctInfo := range m.acctInfo {
+		if acctInfo.votingKeyPriv != nil {
+			acctInfo.votingKeyPriv.Zero()
+		}
+		acctInfo.votingKeyPriv = nil
+	}
+	zero(m.votingCryptoKey)
+	m.votingCryptoKey = nil
+}
+
 // zeroSensitivePublicData performs a best try effort to remove and zero all
 // sensitive public data associated with the address manager such as
 // hierarchical deterministic extended public keys and the crypto public keys.
 func (m *Manager) zeroSensitivePublicData() {
 	// Clear all of the account private keys.
 	for _, acctInfo := range m.acctInfo {
+		acctInfo.clearBranchKeys()
 		acctInfo.acctKeyPub.Zero()
 		acctInfo.acctKeyPub = nil
 	}
@@ -407,6 +477,7 @@ func (m *Manager) Close() error {
 	if !m.watchingOnly && !m.locked {
 		m.lock()
 	}
+	m.lockVotingDomain()
 
 	// Attempt to clear sensitive public key material from memory too.
 	m.zeroSensitivePublicData()
@@ -418,7 +489,7 @@ func (m *Manager) Close() error {
 // keyToManaged returns a new managed address for a public key and its BIP0044
 // derivation path from the coin type key.
 //
-// This function MUST be called with the manager lock held for writes.
+// This function MUST be called with the manager lock held for reads.
 func (m *Manager) keyToManaged(pubKey []byte, account, branch, index uint32) (ManagedAddress, error) {
 	ma, err := newManagedAddressWithoutPrivKey(m, account, pubKey)
 	if err != nil {
@@ -436,12 +507,26 @@ func (m *Manager) keyToManaged(pubKey []byte, account, branch, index uint32) (Ma
 // deriveKey returns either a public or private derived extended key based on
 // the private flag for the given an account info, branch, and index.
 func deriveKey(acctInfo *accountInfo, branch, index uint32, private bool) (*hdkeychain.ExtendedKey, error) {
+	// Public keys of the external and internal branches are derived from
+	// the cached branch keys, which must not be zeroed.
+	if !private && (branch == ExternalBranch || branch == InternalBranch) {
+		branchKey, err := acctInfo.branchKeyPub(branch)
+		if err != nil {
+			return nil, err
+		}
+		return branchKey.Child(index)
+	}
+
 	// Choose the public or private extended key based on whether or not
 	// the private flag was specified.  This, in turn, allows for public or
 	// private child derivation.
 	acctKey := acctInfo.acctKeyPub
 	if private {
-		if acctInfo.acctKeyPriv == nil {
+		acctKeyPriv := acctInfo.acctKeyPriv
+		if acctKeyPriv == nil {
+			acctKeyPriv = acctInfo.votingKeyPriv
+		}
+		if acctKeyPriv == nil {
 			if acctInfo.uniqueKey != nil {
 				return nil, errors.E(errors.Locked,
 					"account with unique passphrase is locked")
@@ -454,7 +539,7 @@ func deriveKey(acctInfo *accountInfo, branch, index uint32, private bool) (*hdke
 			return nil, errors.Errorf("no private key for %s/%d/%d",
 				acctInfo.acctName, branch, index)
 		}
-		acctKey = acctInfo.acctKeyPriv
+		acctKey = acctKeyPriv
 	}
 
 	// Derive and return the key.
@@ -471,10 +556,13 @@ func deriveKey(acctInfo *accountInfo, branch, index uint32, private bool) (*hdke
 // account from the database.   This includes what is necessary to derive new
 // keys for it and track the state of the internal and external branches.
 //
-// This function MUST be called with the manager lock held for writes.
+// This function MUST be called with the manager lock held for reads.
 func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*accountInfo, error) {
 	// Return the account info from cache if it's available.
-	if acctInfo, ok := m.acctInfo[account]; ok {
+	m.acctInfoMu.Lock()
+	acctInfo, ok := m.acctInfo[account]
+	m.acctInfoMu.Unlock()
+	if ok {
 		return acctInfo, nil
 	}
 
@@ -487,7 +575,7 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 
 	// Create the new account info with the known information.  The rest
 	// of the fields are filled out below.
-	acctInfo := new(accountInfo)
+	acctInfo = new(accountInfo)
 
 	switch row := row.(type) {
 	case *dbBIP0044Account:
@@ -534,8 +622,25 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		}
 		acctInfo.acctKeyPriv = acctKeyPriv
 	}
+	acctInfo.votingKeyPriv, err = m.loadVotingKey(ns, account)
+	if err != nil {
+		return nil, err
+	}
 
 	// Add it to the cache and return it when everything is successful.
+	// Another reader may have loaded and cached the account concurrently,
+	// in which case the cached account info is returned instead.
+	m.acctInfoMu.Lock()
+	defer m.acctInfoMu.Unlock()
+	if cached, ok := m.acctInfo[account]; ok {
+		if acctInfo.acctKeyPriv != nil {
+			acctInfo.acctKeyPriv.Zero()
+		}
+		if acctInfo.votingKeyPriv != nil {
+			acctInfo.votingKeyPriv.Zero()
+		}
+		return cached, nil
+	}
 	m.acctInfo[account] = acctInfo
 	return acctInfo, nil
 }
@@ -611,9 +716,9 @@ func (m *Manager) AccountExtendedPubKey(dbtx walletdb.ReadTx, account uint32) (*
 	if account == ImportedAddrAccount {
 		return nil, errors.E(errors.Invalid, "imported account has no extended pubkey")
 	}
-	m.mtx.Lock()
+	m.mtx.RLock()
 	acctInfo, err := m.loadAccountInfo(ns, account)
-	m.mtx.Unlock()
+	m.mtx.RUnlock()
 	if err != nil {
 		return nil, err
 	}
@@ -633,8 +738,8 @@ func (m *Manager) AccountExtendedPrivKey(dbtx walletdb.ReadTx, account uint32) (
 
 	ns := dbtx.ReadBucket(waddrmgrBucketKey)
 
-	defer m.mtx.Unlock()
-	m.mtx.Lock()
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
 
 	acctInfo, err := m.loadAccountInfo(ns, account)
 	if err != nil {
@@ -861,6 +966,7 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 	acctInfo.acctKeyEncrypted = slip0044Account.privKeyEncrypted
 	acctInfo.acctKeyPriv = acctExtPrivKey
 	acctInfo.acctKeyPub = acctExtPubKey
+	acctInfo.clearBranchKeys()
 
 	return nil
 }
@@ -886,7 +992,7 @@ func (m *Manager) deriveKeyFromPath(ns walletdb.ReadBucket, account, branch, ind
 // chainAddressRowToManaged returns a new managed address based on chained
 // address data loaded from the database.
 //
-// This function MUST be called with the manager lock held for writes.
+// This function MUST be called with the manager lock held for reads.
 func (m *Manager) chainAddressRowToManaged(ns walletdb.ReadBucket, row *dbChainAddressRow) (ManagedAddress, error) {
 	private := !m.locked
 	if row.account > ImportedAddrAccount {
@@ -978,7 +1084,7 @@ func addressID(address stdaddr.Address) ([]byte, error) {
 
 // loadAddress attempts to load the passed address from the database.
 //
-// This function MUST be called with the manager lock held for writes.
+// This function MUST be called with the manager lock held for reads.
 func (m *Manager) loadAddress(ns walletdb.ReadBucket, address stdaddr.Address) (ManagedAddress, error) {
 	// Attempt to load the raw address information from the database.
 	id, err := addressID(normalizeAddress(address))
@@ -1006,8 +1112,8 @@ func (m *Manager) loadAddress(ns walletdb.ReadBucket, address stdaddr.Address) (
 // pay-to-script-hash addresses.
 func (m *Manager) Address(ns walletdb.ReadBucket, address stdaddr.Address) (ManagedAddress, error) {
 	address = normalizeAddress(address)
-	defer m.mtx.Unlock()
-	m.mtx.Lock()
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
 	ma, err := m.loadAddress(ns, address)
 	return ma, err
 }
@@ -1179,6 +1285,10 @@ func (m *Manager) ConvertToWatchingOnly(ns walletdb.ReadWriteBucket) error {
 	if err != nil {
 		return err
 	}
+	err = deleteVotingDomain(ns)
+	if err != nil {
+		return err
+	}
 
 	err = putWatchingOnly(ns, true)
 	if err != nil {
@@ -1190,6 +1300,7 @@ func (m *Manager) ConvertToWatchingOnly(ns walletdb.ReadWriteBucket) error {
 	if !m.locked {
 		m.lock()
 	}
+	m.lockVotingDomain()
 
 	// This section clears and removes the encrypted private key material that
 	// is ordinarily used to unlock the manager.  Since the manager is being
@@ -2000,7 +2111,7 @@ func (m *Manager) syncAccountToAddrIndex(ns walletdb.ReadWriteBucket, account ui
 	var xpubBranch *hdkeychain.ExtendedKey
 	switch branch {
 	case ExternalBranch, InternalBranch:
-		xpubBranch, err = acctInfo.acctKeyPub.Child(branch)
+		xpubBranch, err = acctInfo.branchKeyPub(branch)
 		if err != nil {
 			return err
 		}
@@ -2054,12 +2165,19 @@ func (m *Manager) syncAccountToAddrIndex(ns walletdb.ReadWriteBucket, account ui
 // SyncAccountToAddrIndex records address records for an account branch up to
 // syncToIndex.  It does not modify the last used or last returned properties of
 // the account branch.
+//
+// Only database state is modified, and the manager mutex is held for reads.
 func (m *Manager) SyncAccountToAddrIndex(ns walletdb.ReadWriteBucket, account uint32, syncToIndex uint32, branch uint32) error {
-	defer m.mtx.Unlock()
-	m.mtx.Lock()
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
 	return m.syncAccountToAddrIndex(ns, account, syncToIndex, branch)
 }
 
+// AccountNameSeparator separates the components of hierarchical account names,
+// such as "business/payroll".  Each hierarchical name is a distinct account;
+// the hierarchy only describes how accounts are organized.
+const AccountNameSeparator = "/"
+
 // ValidateAccountName validates the given account name and returns an error,
 // if any.
 func ValidateAccountName(name string) error {
@@ -2069,6 +2187,14 @@ func ValidateAccountName(name string) error {
 	if isReservedAccountName(name) {
 		return errors.E(errors.Invalid, "reserved account name")
 	}
+	for _, c := range strings.Split(name, AccountNameSeparator) {
+		switch c {
+		case "":
+			return errors.E(errors.Invalid, "account name has an empty path component")
+		case "*":
+			return errors.E(errors.Invalid, "account name path components may not be \"*\"")
+		}
+	}
 	return nil
 }
 
@@ -2324,8 +2450,8 @@ func (m *Manager) importAccount(dbtx walletdb.ReadWriteTx, acctType accountType,
 // This method is limited to P2PKH addresses for BIP0044 and hardened
 // purpose accounts only.
 func (m *Manager) RecordDerivedAddress(dbtx walletdb.ReadWriteTx, account, branch, child uint32, pubkey []byte) error {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
 
 	ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
 
@@ -2422,8 +2548,8 @@ func (m *Manager) LastImportedAccount(dbtx walletdb.ReadTx) (uint32, error) {
 // ForEachAccountAddress calls the given function with each address of
 // the given account stored in the manager, breaking early on error.
 func (m *Manager) ForEachAccountAddress(ns walletdb.ReadBucket, account uint32, fn func(maddr ManagedAddress) error) error {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
 
 	addrFn := func(rowInterface any) error {
 		managedAddr, err := m.rowInterfaceToManaged(ns, rowInterface)
@@ -2446,8 +2572,8 @@ func (m *Manager) ForEachActiveAccountAddress(ns walletdb.ReadBucket, account ui
 // ForEachActiveAddress calls the given function with each active address
 // stored in the manager, breaking early on error.
 func (m *Manager) ForEachActiveAddress(ns walletdb.ReadBucket, fn func(addr stdaddr.Address) error) error {
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
 
 	addrFn := func(rowInterface any) error {
 		managedAddr, err := m.rowInterfaceToManaged(ns, rowInterface)
@@ -2464,10 +2590,11 @@ func (m *Manager) ForEachActiveAddress(ns walletdb.ReadBucket, fn func(addr stda
 // retured 'done' function should be called after the key is no longer needed to
 // overwrite the key with zeros.
 func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr stdaddr.Address) (key *secp256k1.PrivateKey, done func(), err error) {
-	// Lock the manager mutex for writes.  This protects read access to m.locked
-	// and write access to m.returnedPrivKeys and the cached accounts.
-	defer m.mtx.Unlock()
-	m.mtx.Lock()
+	// Lock the manager mutex for reads.  This protects read access to
+	// m.locked and the account private keys, which are only cleared with the
+	// mutex held for writes.
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
 
 	// NOTE: A watching only Manager may have imported private data.
 
@@ -2599,8 +2726,8 @@ func (m *Manager) selectCryptoKey(keyType CryptoKeyType) (EncryptorDecryptor, er
 func (m *Manager) Encrypt(keyType CryptoKeyType, in []byte) ([]byte, error) {
 	// Encryption must be performed under the manager mutex since the
 	// keys are cleared when the manager is locked.
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
 
 	cryptoKey, err := m.selectCryptoKey(keyType)
 	if err != nil {
@@ -2618,8 +2745,8 @@ func (m *Manager) Encrypt(keyType CryptoKeyType, in []byte) ([]byte, error) {
 func (m *Manager) Decrypt(keyType CryptoKeyType, in []byte) ([]byte, error) {
 	// Decryption must be performed under the manager mutex since the
 	// keys are cleared when the manager is locked.
-	m.mtx.Lock()
-	defer m.mtx.Unlock()
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
 
 	cryptoKey, err := m.selectCryptoKey(keyType)
 	if err != nil {
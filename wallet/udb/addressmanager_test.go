@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 
 	"decred.org/dcrwallet/v5/errors"
@@ -1255,6 +1256,81 @@ func TestManager(t *testing.T) {
 	testManagerAPI(ctx, tc)
 }
 
+// TestConcurrentDerivation tests that concurrent readers of the manager load
+// the same account info and derive the same keys as a fresh derivation from
+// the account xpub.
+func TestConcurrentDerivation(t *testing.T) {
+	ctx := context.Background()
+	db, mgr, _, teardown, err := cloneDB(ctx, "concurrent_derivation.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mgr.Close()
+
+	const readers = 8
+	const children = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, readers)
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+				ns := tx.ReadBucket(waddrmgrBucketKey)
+				for branch := ExternalBranch; branch <= InternalBranch; branch++ {
+					for child := uint32(0); child < children; child++ {
+						_, err := mgr.deriveKeyFromPath(ns, 0, branch, child, false)
+						if err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrBucketKey)
+		xpub, err := mgr.AccountExtendedPubKey(tx, 0)
+		if err != nil {
+			return err
+		}
+		for branch := ExternalBranch; branch <= InternalBranch; branch++ {
+			xbranch, err := xpub.Child(branch)
+			if err != nil {
+				return err
+			}
+			for child := uint32(0); child < children; child++ {
+				want, err := xbranch.Child(child)
+				if err != nil {
+					return err
+				}
+				got, err := mgr.deriveKeyFromPath(ns, 0, branch, child, false)
+				if err != nil {
+					return err
+				}
+				if got.String() != want.String() {
+					t.Errorf("branch %d child %d: got %v, want %v",
+						branch, child, got, want)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestMain(m *testing.M) {
 	testDir, err := os.MkdirTemp("", "udb-")
 	if err != nil {
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var attestationBucketKey = []byte("attestation")
+
+// Keys of the attestation bucket.
+var (
+	attestationKeyName    = []byte("key")
+	attestationLatestName = []byte("latest")
+)
+
+// AttestationKey returns the serialized private key used to sign wallet state
+// attestations.  Errors with NotExist if no key has been recorded.
+func AttestationKey(dbtx walletdb.ReadTx) ([]byte, error) {
+	b := dbtx.ReadBucket(attestationBucketKey)
+	v := b.Get(attestationKeyName)
+	if v == nil {
+		return nil, errors.E(errors.NotExist, "no attestation key")
+	}
+	return append([]byte(nil), v...), nil
+}
+
+// PutAttestationKey records the serialized private key used to sign wallet
+// state attestations.  The key only authenticates attestations and is not
+// encrypted.
+func PutAttestationKey(dbtx walletdb.ReadWriteTx, key []byte) error {
+	b := dbtx.ReadWriteBucket(attestationBucketKey)
+	err := b.Put(attestationKeyName, key)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// LatestAttestation returns the most recently recorded serialized
+// attestation.  Errors with NotExist if no attestation has been recorded.
+func LatestAttestation(dbtx walletdb.ReadTx) ([]byte, error) {
+	b := dbtx.ReadBucket(attestationBucketKey)
+	v := b.Get(attestationLatestName)
+	if v == nil {
+		return nil, errors.E(errors.NotExist, "no attestation")
+	}
+	return append([]byte(nil), v...), nil
+}
+
+// PutLatestAttestation records a serialized attestation, replacing the
+// previously recorded attestation.
+func PutLatestAttestation(dbtx walletdb.ReadWriteTx, attestation []byte) error {
+	b := dbtx.ReadWriteBucket(attestationBucketKey)
+	err := b.Put(attestationLatestName, attestation)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
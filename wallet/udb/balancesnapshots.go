@@ -0,0 +1,118 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+var balanceSnapshotsBucketKey = []byte("balancesnapshots") // by big endian Unix time of UTC day
+
+// BalanceSnapshot records the wallet balance and tickets on a single UTC day.
+type BalanceSnapshot struct {
+	Day             time.Time // Start of the UTC day
+	Time            time.Time // Time the snapshot was recorded
+	Height          int32     // Main chain tip height when recorded
+	Total           dcrutil.Amount
+	LockedByTickets dcrutil.Amount
+	TicketCount     uint32
+
+	// TotalSubsidy is the total subsidy of all votes ever created by the
+	// wallet, and Rewards is the increase in TotalSubsidy since the
+	// previous recorded snapshot.
+	TotalSubsidy dcrutil.Amount
+	Rewards      dcrutil.Amount
+}
+
+// Balance snapshot values are serialized as:
+//
+//	[0:8]   Unix time of recording (8 bytes)
+//	[8:12]  Height (4 bytes)
+//	[12:20] Total (8 bytes)
+//	[20:28] Locked by tickets (8 bytes)
+//	[28:32] Ticket count (4 bytes)
+//	[32:40] Total subsidy (8 bytes)
+//	[40:48] Rewards (8 bytes)
+const balanceSnapshotSize = 48
+
+func keyBalanceSnapshot(day time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(day.Unix()))
+}
+
+func valueBalanceSnapshot(s *BalanceSnapshot) []byte {
+	v := make([]byte, balanceSnapshotSize)
+	binary.BigEndian.PutUint64(v[0:8], uint64(s.Time.Unix()))
+	binary.BigEndian.PutUint32(v[8:12], uint32(s.Height))
+	binary.BigEndian.PutUint64(v[12:20], uint64(s.Total))
+	binary.BigEndian.PutUint64(v[20:28], uint64(s.LockedByTickets))
+	binary.BigEndian.PutUint32(v[28:32], s.TicketCount)
+	binary.BigEndian.PutUint64(v[32:40], uint64(s.TotalSubsidy))
+	binary.BigEndian.PutUint64(v[40:48], uint64(s.Rewards))
+	return v
+}
+
+func readBalanceSnapshot(k, v []byte) (*BalanceSnapshot, error) {
+	if len(k) != 8 || len(v) < balanceSnapshotSize {
+		return nil, errors.E(errors.IO, errors.Errorf("balance snapshot "+
+			"key/value len %d/%d", len(k), len(v)))
+	}
+	return &BalanceSnapshot{
+		Day:             time.Unix(int64(binary.BigEndian.Uint64(k)), 0).UTC(),
+		Time:            time.Unix(int64(binary.BigEndian.Uint64(v[0:8])), 0),
+		Height:          int32(binary.BigEndian.Uint32(v[8:12])),
+		Total:           dcrutil.Amount(binary.BigEndian.Uint64(v[12:20])),
+		LockedByTickets: dcrutil.Amount(binary.BigEndian.Uint64(v[20:28])),
+		TicketCount:     binary.BigEndian.Uint32(v[28:32]),
+		TotalSubsidy:    dcrutil.Amount(binary.BigEndian.Uint64(v[32:40])),
+		Rewards:         dcrutil.Amount(binary.BigEndian.Uint64(v[40:48])),
+	}, nil
+}
+
+// PutBalanceSnapshot records the balance snapshot of a day, replacing any
+// existing snapshot of the same day.
+func PutBalanceSnapshot(dbtx walletdb.ReadWriteTx, s *BalanceSnapshot) error {
+	b := dbtx.ReadWriteBucket(balanceSnapshotsBucketKey)
+	err := b.Put(keyBalanceSnapshot(s.Day), valueBalanceSnapshot(s))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// LatestBalanceSnapshot returns the most recent recorded balance snapshot.
+// Errors with NotExist if no snapshots have been recorded.
+func LatestBalanceSnapshot(dbtx walletdb.ReadTx) (*BalanceSnapshot, error) {
+	b := dbtx.ReadBucket(balanceSnapshotsBucketKey)
+	c := b.ReadCursor()
+	k, v := c.Last()
+	c.Close()
+	if k == nil {
+		return nil, errors.E(errors.NotExist, "no balance snapshots")
+	}
+	return readBalanceSnapshot(k, v)
+}
+
+// BalanceSnapshots returns all recorded balance snapshots of days at or after
+// since, oldest first.
+func BalanceSnapshots(dbtx walletdb.ReadTx, since time.Time) ([]*BalanceSnapshot, error) {
+	b := dbtx.ReadBucket(balanceSnapshotsBucketKey)
+	c := b.ReadCursor()
+	defer c.Close()
+
+	var snapshots []*BalanceSnapshot
+	for k, v := c.Seek(keyBalanceSnapshot(since)); k != nil; k, v = c.Next() {
+		s, err := readBalanceSnapshot(k, v)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// transactionHistoryBuckets are the nested buckets of the transaction store
+// namespace which only record transaction history.
+var transactionHistoryBuckets = [][]byte{
+	bucketTxRecords,
+	bucketCredits,
+	bucketUnspent,
+	bucketDebits,
+	bucketUnmined,
+	bucketUnpublished,
+	bucketUnminedCredits,
+	bucketUnminedInputs,
+	bucketTickets,
+	bucketMultisig,
+	bucketMultisigUsp,
+	bucketStakeInvalidatedCredits,
+	bucketStakeInvalidatedDebits,
+	bucketTicketCommitments,
+	bucketTicketCommitmentsUsp,
+}
+
+// DropTransactionHistory removes all mined and unmined transactions from the
+// store while keeping the main chain, block headers and committed filters.
+// The processed transactions block marker is reset to the main chain block
+// before height from, so the next rescan begins at that height.
+func (s *Store) DropTransactionHistory(dbtx walletdb.ReadWriteTx, from int32) error {
+	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
+
+	_, tipHeight := s.MainChainTip(dbtx)
+	if from < 1 || from > tipHeight+1 {
+		return errors.E(errors.Invalid, errors.Errorf("rescan height %d "+
+			"is not between 1 and %d", from, tipHeight+1))
+	}
+	marker, err := s.GetMainChainBlockHashForHeight(ns, from-1)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range transactionHistoryBuckets {
+		if ns.NestedReadBucket(key) != nil {
+			if err := ns.DeleteNestedBucket(key); err != nil {
+				return errors.E(errors.IO, err)
+			}
+		}
+		if _, err := ns.CreateBucket(key); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	// Remove the transaction hashes recorded by each block record, keeping
+	// the block hash, time, vote bits and stake invalidation.
+	blocks := ns.NestedReadWriteBucket(bucketBlocks)
+	var keys [][]byte
+	err = blocks.ForEach(func(k, v []byte) error {
+		if len(v) < 47 {
+			return errors.E(errors.IO, errors.Errorf("block record len %d", len(v)))
+		}
+		if byteOrder.Uint32(v[43:47]) != 0 {
+			keys = append(keys, append([]byte(nil), k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		v := make([]byte, 47)
+		copy(v, blocks.Get(k)[:43])
+		if err := blocks.Put(k, v); err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	if err := putMinedBalance(ns, 0); err != nil {
+		return err
+	}
+	if err := ns.Put(rootLastTxsBlock, marker[:]); err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
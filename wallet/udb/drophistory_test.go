@@ -0,0 +1,76 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestDropTransactionHistory(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "drop_transaction_history.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxOut(wire.NewTxOut(1e8, nil))
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		rec, err := NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			return err
+		}
+		return s.InsertMemPoolTx(dbtx, rec)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The empty wallet only records the genesis block, so rescanning can
+	// only begin at the next block.
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return s.DropTransactionHistory(dbtx, 2)
+	})
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("dropping from height 2 returned %v, want invalid", err)
+	}
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		return s.DropTransactionHistory(dbtx, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		unmined, err := s.UnminedTxs(dbtx)
+		if err != nil {
+			return err
+		}
+		if len(unmined) != 0 {
+			t.Errorf("%d unmined transactions remain", len(unmined))
+		}
+		txHash := tx.TxHash()
+		if s.ExistsTx(dbtx.ReadBucket(wtxmgrBucketKey), &txHash) {
+			t.Errorf("dropped transaction still exists")
+		}
+		genesis := chaincfg.TestNet3Params().GenesisHash
+		if marker := s.ProcessedTxsBlockMarker(dbtx); *marker != genesis {
+			t.Errorf("processed block marker is %v, want genesis %v",
+				marker, &genesis)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
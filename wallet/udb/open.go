@@ -47,6 +47,7 @@ func Open(ctx context.Context, db walletdb.DB, params *chaincfg.Params, pubPass
 			chainParams:    params,
 			acctLookupFunc: addrMgr.AddrAccount,
 			manager:        addrMgr,
+			txRecords:      newTxRecordCache(),
 		}
 		return err
 	})
@@ -0,0 +1,48 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+var outputLabelsBucketKey = []byte("outputlabels") // by canonical outpoint
+
+// PutOutputLabel records a label describing a transaction output, replacing
+// any previous label for the output.  Empty labels remove the recorded label.
+func PutOutputLabel(dbtx walletdb.ReadWriteTx, txHash *chainhash.Hash, index uint32, label string) error {
+	b := dbtx.ReadWriteBucket(outputLabelsBucketKey)
+	k := canonicalOutPoint(txHash, index)
+	var err error
+	if label == "" {
+		err = b.Delete(k)
+	} else {
+		err = b.Put(k, []byte(label))
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// OutputLabels returns all recorded output labels of a transaction, keyed by
+// output index.
+func OutputLabels(dbtx walletdb.ReadTx, txHash *chainhash.Hash) map[uint32]string {
+	b := dbtx.ReadBucket(outputLabelsBucketKey)
+	labels := make(map[uint32]string)
+	c := b.ReadCursor()
+	defer c.Close()
+	for k, v := c.Seek(txHash[:]); bytes.HasPrefix(k, txHash[:]); k, v = c.Next() {
+		if len(k) != 36 {
+			continue
+		}
+		labels[byteOrder.Uint32(k[32:36])] = string(v)
+	}
+	return labels
+}
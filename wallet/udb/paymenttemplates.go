@@ -0,0 +1,157 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/wire"
+)
+
+var paymentTemplatesBucketKey = []byte("paymenttemplates") // by template name
+
+// PaymentTemplate is a stored payment with fixed outputs which may be
+// executed on demand or on a schedule.
+type PaymentTemplate struct {
+	Name    string
+	Account uint32
+	MinConf int32
+	Outputs []*wire.TxOut
+
+	// Interval is the number of blocks between scheduled executions of
+	// the template.  A zero interval indicates the template is only
+	// executed on demand.
+	Interval int32
+
+	// NextHeight is the main chain height at which the next scheduled
+	// execution occurs.  It is unused when Interval is zero.
+	NextHeight int32
+}
+
+// Payment template serialization:
+//
+//	[0:4]   account (4 bytes)
+//	[4:8]   minconf (4 bytes)
+//	[8:12]  interval (4 bytes)
+//	[12:16] next height (4 bytes)
+//	[16:20] output count (4 bytes)
+//
+// Followed by each output:
+//
+//	[0:8]   amount (8 bytes)
+//	[8:10]  script version (2 bytes)
+//	[10:14] script length (4 bytes)
+//	[14:]   script
+func serializePaymentTemplate(t *PaymentTemplate) []byte {
+	size := 20
+	for _, out := range t.Outputs {
+		size += 14 + len(out.PkScript)
+	}
+	v := make([]byte, size)
+	byteOrder.PutUint32(v[0:4], t.Account)
+	byteOrder.PutUint32(v[4:8], uint32(t.MinConf))
+	byteOrder.PutUint32(v[8:12], uint32(t.Interval))
+	byteOrder.PutUint32(v[12:16], uint32(t.NextHeight))
+	byteOrder.PutUint32(v[16:20], uint32(len(t.Outputs)))
+	off := 20
+	for _, out := range t.Outputs {
+		byteOrder.PutUint64(v[off:off+8], uint64(out.Value))
+		byteOrder.PutUint16(v[off+8:off+10], out.Version)
+		byteOrder.PutUint32(v[off+10:off+14], uint32(len(out.PkScript)))
+		off += 14
+		off += copy(v[off:], out.PkScript)
+	}
+	return v
+}
+
+func deserializePaymentTemplate(name string, v []byte) (*PaymentTemplate, error) {
+	errShort := errors.E(errors.IO, errors.Errorf("short payment template %q", name))
+	if len(v) < 20 {
+		return nil, errShort
+	}
+	t := &PaymentTemplate{
+		Name:       name,
+		Account:    byteOrder.Uint32(v[0:4]),
+		MinConf:    int32(byteOrder.Uint32(v[4:8])),
+		Interval:   int32(byteOrder.Uint32(v[8:12])),
+		NextHeight: int32(byteOrder.Uint32(v[12:16])),
+	}
+	n := byteOrder.Uint32(v[16:20])
+	v = v[20:]
+	for i := uint32(0); i < n; i++ {
+		if len(v) < 14 {
+			return nil, errShort
+		}
+		value := int64(byteOrder.Uint64(v[0:8]))
+		version := byteOrder.Uint16(v[8:10])
+		scriptLen := byteOrder.Uint32(v[10:14])
+		v = v[14:]
+		if uint32(len(v)) < scriptLen {
+			return nil, errShort
+		}
+		script := make([]byte, scriptLen)
+		copy(script, v)
+		v = v[scriptLen:]
+		t.Outputs = append(t.Outputs, &wire.TxOut{
+			Value:    value,
+			Version:  version,
+			PkScript: script,
+		})
+	}
+	return t, nil
+}
+
+// PutPaymentTemplate records a payment template, replacing any existing
+// template with the same name.
+func PutPaymentTemplate(dbtx walletdb.ReadWriteTx, t *PaymentTemplate) error {
+	b := dbtx.ReadWriteBucket(paymentTemplatesBucketKey)
+	err := b.Put([]byte(t.Name), serializePaymentTemplate(t))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// PaymentTemplateByName returns the payment template with the given name.
+// Errors with NotExist if there is no such template.
+func PaymentTemplateByName(dbtx walletdb.ReadTx, name string) (*PaymentTemplate, error) {
+	b := dbtx.ReadBucket(paymentTemplatesBucketKey)
+	v := b.Get([]byte(name))
+	if v == nil {
+		return nil, errors.E(errors.NotExist,
+			errors.Errorf("no payment template %q", name))
+	}
+	return deserializePaymentTemplate(name, v)
+}
+
+// PaymentTemplates returns all recorded payment templates, sorted by name.
+func PaymentTemplates(dbtx walletdb.ReadTx) ([]*PaymentTemplate, error) {
+	b := dbtx.ReadBucket(paymentTemplatesBucketKey)
+	var templates []*PaymentTemplate
+	err := b.ForEach(func(k, v []byte) error {
+		t, err := deserializePaymentTemplate(string(k), v)
+		if err != nil {
+			return err
+		}
+		templates = append(templates, t)
+		return nil
+	})
+	return templates, err
+}
+
+// DeletePaymentTemplate removes the payment template with the given name.
+// Errors with NotExist if there is no such template.
+func DeletePaymentTemplate(dbtx walletdb.ReadWriteTx, name string) error {
+	b := dbtx.ReadWriteBucket(paymentTemplatesBucketKey)
+	if b.Get([]byte(name)) == nil {
+		return errors.E(errors.NotExist,
+			errors.Errorf("no payment template %q", name))
+	}
+	err := b.Delete([]byte(name))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+var accountSpendPoliciesBucketKey = []byte("acctspendpolicies") // by account number
+
+// SpendPolicy describes which spends of an account's outputs are permitted.
+type SpendPolicy uint8
+
+const (
+	// SpendPolicyHot permits all spends, including spends by automated
+	// wallet processes.  This is the policy of accounts without a
+	// recorded policy.
+	SpendPolicyHot SpendPolicy = iota
+
+	// SpendPolicyWarm permits only manually requested spends.
+	SpendPolicyWarm
+
+	// SpendPolicyCold treats the account as watch-only and permits no
+	// spends.
+	SpendPolicyCold
+)
+
+// String returns the name of the spend policy.
+func (p SpendPolicy) String() string {
+	switch p {
+	case SpendPolicyHot:
+		return "hot"
+	case SpendPolicyWarm:
+		return "warm"
+	case SpendPolicyCold:
+		return "cold"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseSpendPolicy returns the spend policy described by its name.
+func ParseSpendPolicy(s string) (SpendPolicy, error) {
+	switch s {
+	case "hot":
+		return SpendPolicyHot, nil
+	case "warm":
+		return SpendPolicyWarm, nil
+	case "cold":
+		return SpendPolicyCold, nil
+	default:
+		return 0, errors.E(errors.Invalid, errors.Errorf("unknown spend policy %q", s))
+	}
+}
+
+// SetAccountSpendPolicy records the spend policy of an account.  Recording
+// the hot policy removes any recorded policy.
+func SetAccountSpendPolicy(dbtx walletdb.ReadWriteTx, account uint32, policy SpendPolicy) error {
+	b := dbtx.ReadWriteBucket(accountSpendPoliciesBucketKey)
+	k := uint32ToBytes(account)
+	var err error
+	if policy == SpendPolicyHot {
+		err = b.Delete(k)
+	} else {
+		err = b.Put(k, []byte{byte(policy)})
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// AccountSpendPolicy returns the recorded spend policy of an account.
+// Accounts without a recorded policy are hot.
+func AccountSpendPolicy(dbtx walletdb.ReadTx, account uint32) SpendPolicy {
+	b := dbtx.ReadBucket(accountSpendPoliciesBucketKey)
+	v := b.Get(uint32ToBytes(account))
+	if len(v) != 1 {
+		return SpendPolicyHot
+	}
+	return SpendPolicy(v[0])
+}
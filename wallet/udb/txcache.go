@@ -0,0 +1,93 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/lru"
+	"github.com/decred/dcrd/blockchain/stake/v5"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// txRecordCacheSize is the number of deserialized mined transaction records
+// kept in memory by a Store.
+const txRecordCacheSize = 1000
+
+// cachedTxRecord is a deserialized mined transaction record.  The transaction
+// is never modified after it is cached.
+type cachedTxRecord struct {
+	size   int // length of the serialized record value
+	msgTx  *wire.MsgTx
+	txType stake.TxType
+}
+
+// newTxRecordCache returns an empty cache of deserialized mined transaction
+// records.
+func newTxRecordCache() *lru.Map[string, *cachedTxRecord] {
+	m := lru.NewMap[string, *cachedTxRecord](txRecordCacheSize)
+	return &m
+}
+
+// copyMsgTx returns a copy of tx which shares the scripts of the original
+// transaction, but not its inputs and outputs.
+func copyMsgTx(tx *wire.MsgTx) wire.MsgTx {
+	c := *tx
+	c.TxIn = make([]*wire.TxIn, len(tx.TxIn))
+	for i, in := range tx.TxIn {
+		in := *in
+		c.TxIn[i] = &in
+	}
+	c.TxOut = make([]*wire.TxOut, len(tx.TxOut))
+	for i, out := range tx.TxOut {
+		out := *out
+		c.TxOut[i] = &out
+	}
+	return c
+}
+
+// readMinedTxRecord reads the mined transaction record with key k and value v
+// into rec, using a cached deserialization of the transaction when one exists.
+// Mined record keys commit to both the transaction and block hashes, so a
+// cached record can only become stale when the record is removed by a
+// rollback, which evicts it.
+func (s *Store) readMinedTxRecord(txHash *chainhash.Hash, k, v []byte, rec *TxRecord) error {
+	if s.txRecords == nil {
+		return readRawTxRecord(txHash, v, rec)
+	}
+	if len(v) < 8 {
+		return errors.E(errors.IO, errors.Errorf("tx record len %d", len(v)))
+	}
+	if c, ok := s.txRecords.Get(string(k)); ok && c.size == len(v) {
+		rec.Hash = *txHash
+		rec.Received = time.Unix(int64(byteOrder.Uint64(v)), 0)
+		rec.MsgTx = copyMsgTx(c.msgTx)
+		rec.TxType = c.txType
+		return nil
+	}
+
+	err := readRawTxRecord(txHash, v, rec)
+	if err != nil {
+		return err
+	}
+	c := &cachedTxRecord{
+		size:   len(v),
+		msgTx:  new(wire.MsgTx),
+		txType: rec.TxType,
+	}
+	*c.msgTx = copyMsgTx(&rec.MsgTx)
+	s.txRecords.Add(string(k), c)
+	return nil
+}
+
+// evictMinedTxRecord removes the cached deserialization of the mined
+// transaction record with key k.
+func (s *Store) evictMinedTxRecord(k []byte) {
+	if s.txRecords != nil {
+		s.txRecords.Delete(string(k))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestMinedTxRecordCache(t *testing.T) {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 1e8, []byte{0x51}))
+	tx.AddTxOut(wire.NewTxOut(1e8-1e4, []byte{0x51}))
+	rec, err := NewTxRecordFromMsgTx(tx, time.Unix(1700000000, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &Block{Hash: chainhash.Hash{1}, Height: 100}
+	k := keyTxRecord(&rec.Hash, block)
+	v, err := valueTxRecord(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Store{txRecords: newTxRecordCache()}
+	var first TxRecord
+	if err := s.readMinedTxRecord(&rec.Hash, k, v, &first); err != nil {
+		t.Fatal(err)
+	}
+	if !s.txRecords.Contains(string(k)) {
+		t.Fatal("record was not cached")
+	}
+
+	// Modifying a returned transaction must not modify the cached record.
+	first.MsgTx.TxOut[0].Value = 0
+	first.MsgTx.TxIn[0].ValueIn = 0
+
+	var second TxRecord
+	if err := s.readMinedTxRecord(&rec.Hash, k, v, &second); err != nil {
+		t.Fatal(err)
+	}
+	if second.MsgTx.TxHash() != rec.Hash {
+		t.Errorf("cached tx hash %v, want %v", second.MsgTx.TxHash(), &rec.Hash)
+	}
+	if second.MsgTx.TxIn[0].ValueIn != 1e8 {
+		t.Errorf("cached input value %d, want %d", second.MsgTx.TxIn[0].ValueIn, int64(1e8))
+	}
+	if !second.Received.Equal(rec.Received) || second.TxType != rec.TxType {
+		t.Errorf("cached record metadata %v %v, want %v %v", second.Received,
+			second.TxType, rec.Received, rec.TxType)
+	}
+
+	s.evictMinedTxRecord(k)
+	if s.txRecords.Contains(string(k)) {
+		t.Fatal("record was not evicted")
+	}
+}
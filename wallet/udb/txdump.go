@@ -0,0 +1,427 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+)
+
+// Formats of transaction store dumps written by Store.Dump.
+const (
+	// DumpFormatText writes a single line for every key/value pair,
+	// describing the bucket and the decoded key and value fields.
+	DumpFormatText = "text"
+
+	// DumpFormatJSON writes a JSON object for every key/value pair, each
+	// followed by a newline.
+	DumpFormatJSON = "json"
+)
+
+// dumpField is a single named field decoded from a key or value.
+type dumpField struct {
+	name, value string
+}
+
+// dumpDecoder decodes the fields of a key or value.  Decoders return nil when
+// the serialization can not be decoded, and the raw bytes are dumped instead.
+type dumpDecoder func([]byte) []dumpField
+
+// dumpBuckets describes the nested buckets of the transaction store and how
+// their keys and values are decoded, using the serializations documented in
+// txdb.go.
+var dumpBuckets = []struct {
+	key         []byte
+	name        string
+	decodeKey   dumpDecoder
+	decodeValue dumpDecoder
+}{
+	{bucketBlocks, "blocks", dumpHeightKey, dumpBlockRecord},
+	{bucketHeaders, "headers", dumpHashKey, dumpBlockHeader},
+	{bucketTxRecords, "txrecords", dumpTxRecordKey, dumpTxRecord},
+	{bucketCredits, "credits", dumpCreditKey, dumpCredit},
+	{bucketUnspent, "unspent", dumpOutPointKey, dumpUnspent},
+	{bucketDebits, "debits", dumpDebitKey, dumpDebit},
+	{bucketUnmined, "unmined", dumpHashKey, dumpTxRecord},
+	{bucketUnpublished, "unpublished", dumpHashKey, dumpFlagByte("unpublished")},
+	{bucketUnminedCredits, "unminedcredits", dumpOutPointKey, dumpUnminedCredit},
+	{bucketUnminedInputs, "unminedinputs", dumpOutPointKey, dumpSpenderHash},
+	{bucketTickets, "tickets", dumpHashKey, dumpTicketRecord},
+	{bucketMultisig, "multisig", dumpOutPointKey, nil},
+	{bucketMultisigUsp, "multisigunspent", dumpOutPointKey, nil},
+	{bucketStakeInvalidatedCredits, "invalidatedcredits", dumpCreditKey, dumpCredit},
+	{bucketStakeInvalidatedDebits, "invalidateddebits", dumpDebitKey, dumpDebit},
+	{bucketCFilters, "cfilters", dumpHashKey, dumpCFilter},
+	{bucketTicketCommitments, "ticketcommitments", dumpOutPointKey, dumpTicketCommitment},
+	{bucketTicketCommitmentsUsp, "unspentticketcommitments", dumpOutPointKey, dumpFlagByte("unminedspent")},
+}
+
+// dumpRootValues describes how values of the root bucket are decoded.
+var dumpRootValues = map[string]dumpDecoder{
+	string(rootCreateDate):   dumpUnixTime("date"),
+	string(rootVersion):      dumpUint32("version"),
+	string(rootMinedBalance): dumpAmount("balance"),
+	string(rootTipBlock):     dumpHash("hash"),
+	string(rootLastTxsBlock): dumpHash("hash"),
+	string(rootHaveCFilters): dumpFlagByte("havecfilters"),
+}
+
+// Dump writes every key/value pair of the transaction store, with keys and
+// values decoded according to their serializations, in the text or JSON
+// format.  Serializations which can not be decoded are written as
+// hexadecimal.  Dumps are intended for debugging and are not a stable format.
+func (s *Store) Dump(dbtx walletdb.ReadTx, w io.Writer, format string) error {
+	var write func(bucket string, key, value []dumpField) error
+	switch format {
+	case DumpFormatText:
+		write = func(bucket string, key, value []dumpField) error {
+			_, err := fmt.Fprintf(w, "[%s] %s: %s\n", bucket,
+				formatDumpFields(key), formatDumpFields(value))
+			return err
+		}
+	case DumpFormatJSON:
+		enc := json.NewEncoder(w)
+		write = func(bucket string, key, value []dumpField) error {
+			return enc.Encode(struct {
+				Bucket string            `json:"bucket"`
+				Key    map[string]string `json:"key"`
+				Value  map[string]string `json:"value"`
+			}{bucket, dumpFieldMap(key), dumpFieldMap(value)})
+		}
+	default:
+		return errors.E(errors.Invalid, errors.Errorf("unknown dump format %q", format))
+	}
+
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	err := ns.ForEach(func(k, v []byte) error {
+		if v == nil {
+			// Nested buckets are dumped below.
+			return nil
+		}
+		key := []dumpField{{"key", string(k)}}
+		return write("root", key, decodeDump(dumpRootValues[string(k)], v))
+	})
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	for _, b := range dumpBuckets {
+		bucket := ns.NestedReadBucket(b.key)
+		if bucket == nil {
+			continue
+		}
+		err := bucket.ForEach(func(k, v []byte) error {
+			return write(b.name, decodeDump(b.decodeKey, k),
+				decodeDump(b.decodeValue, v))
+		})
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
+
+func decodeDump(decode dumpDecoder, b []byte) []dumpField {
+	if decode != nil {
+		if fields := decode(b); fields != nil {
+			return fields
+		}
+	}
+	return []dumpField{{"raw", hex.EncodeToString(b)}}
+}
+
+func formatDumpFields(fields []dumpField) string {
+	var b strings.Builder
+	for i, f := range fields {
+		if i != 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(f.name)
+		b.WriteByte('=')
+		b.WriteString(f.value)
+	}
+	return b.String()
+}
+
+func dumpFieldMap(fields []dumpField) map[string]string {
+	m := make(map[string]string, len(fields))
+	for _, f := range fields {
+		m[f.name] = f.value
+	}
+	return m
+}
+
+func dumpHashString(b []byte) string {
+	var hash chainhash.Hash
+	copy(hash[:], b)
+	return hash.String()
+}
+
+func dumpBool(b bool) string { return strconv.FormatBool(b) }
+
+func dumpUint(v uint32) string { return strconv.FormatUint(uint64(v), 10) }
+
+func dumpInt(v int32) string { return strconv.FormatInt(int64(v), 10) }
+
+func dumpHash(name string) dumpDecoder {
+	return func(b []byte) []dumpField {
+		if len(b) != chainhash.HashSize {
+			return nil
+		}
+		return []dumpField{{name, dumpHashString(b)}}
+	}
+}
+
+func dumpUint32(name string) dumpDecoder {
+	return func(b []byte) []dumpField {
+		if len(b) != 4 {
+			return nil
+		}
+		return []dumpField{{name, dumpUint(byteOrder.Uint32(b))}}
+	}
+}
+
+func dumpAmount(name string) dumpDecoder {
+	return func(b []byte) []dumpField {
+		if len(b) != 8 {
+			return nil
+		}
+		return []dumpField{{name, dcrutil.Amount(byteOrder.Uint64(b)).String()}}
+	}
+}
+
+func dumpUnixTime(name string) dumpDecoder {
+	return func(b []byte) []dumpField {
+		if len(b) != 8 {
+			return nil
+		}
+		t := time.Unix(int64(byteOrder.Uint64(b)), 0).UTC()
+		return []dumpField{{name, t.Format(time.RFC3339)}}
+	}
+}
+
+func dumpFlagByte(name string) dumpDecoder {
+	return func(b []byte) []dumpField {
+		if len(b) != 1 {
+			return nil
+		}
+		return []dumpField{{name, dumpBool(b[0]&1 != 0)}}
+	}
+}
+
+var dumpHashKey = dumpHash("hash")
+
+func dumpHeightKey(k []byte) []dumpField {
+	if len(k) != 4 {
+		return nil
+	}
+	return []dumpField{{"height", dumpInt(int32(byteOrder.Uint32(k)))}}
+}
+
+func dumpOutPointKey(k []byte) []dumpField {
+	if len(k) != 36 {
+		return nil
+	}
+	return []dumpField{
+		{"hash", dumpHashString(k[:32])},
+		{"index", dumpUint(byteOrder.Uint32(k[32:36]))},
+	}
+}
+
+func dumpTxRecordKey(k []byte) []dumpField {
+	if len(k) != 68 {
+		return nil
+	}
+	return []dumpField{
+		{"hash", dumpHashString(k[:32])},
+		{"height", dumpInt(int32(byteOrder.Uint32(k[32:36])))},
+		{"block", dumpHashString(k[36:68])},
+	}
+}
+
+func dumpCreditKey(k []byte) []dumpField {
+	if len(k) != 72 {
+		return nil
+	}
+	return append(dumpTxRecordKey(k[:68]),
+		dumpField{"index", dumpUint(byteOrder.Uint32(k[68:72]))})
+}
+
+func dumpDebitKey(k []byte) []dumpField {
+	if len(k) != 72 {
+		return nil
+	}
+	return append(dumpTxRecordKey(k[:68]),
+		dumpField{"input", dumpUint(byteOrder.Uint32(k[68:72]))})
+}
+
+// dumpCreditRef formats a credits bucket key as a single field value.
+func dumpCreditRef(k []byte) string {
+	return fmt.Sprintf("%v:%d@%d", dumpHashString(k[:32]),
+		byteOrder.Uint32(k[68:72]), int32(byteOrder.Uint32(k[32:36])))
+}
+
+func dumpBlockRecord(v []byte) []dumpField {
+	var block blockRecord
+	if readRawBlockRecord(make([]byte, 4), v, &block) != nil {
+		return nil
+	}
+	txs := make([]string, len(block.transactions))
+	for i := range block.transactions {
+		txs[i] = block.transactions[i].String()
+	}
+	return []dumpField{
+		{"hash", block.Hash.String()},
+		{"time", block.Time.UTC().Format(time.RFC3339)},
+		{"votebits", dumpUint(uint32(block.VoteBits))},
+		{"stakeinvalidated", dumpBool(extractRawBlockRecordStakeInvalid(v))},
+		{"transactions", strings.Join(txs, ",")},
+	}
+}
+
+func dumpBlockHeader(v []byte) []dumpField {
+	var header wire.BlockHeader
+	if header.FromBytes(v) != nil {
+		return nil
+	}
+	return []dumpField{
+		{"height", dumpUint(header.Height)},
+		{"prevblock", header.PrevBlock.String()},
+		{"time", header.Timestamp.UTC().Format(time.RFC3339)},
+		{"votebits", dumpUint(uint32(header.VoteBits))},
+		{"sbits", dcrutil.Amount(header.SBits).String()},
+	}
+}
+
+func dumpTxRecord(v []byte) []dumpField {
+	if len(v) < 8 {
+		return nil
+	}
+	var tx wire.MsgTx
+	if tx.Deserialize(bytes.NewReader(v[8:])) != nil {
+		return nil
+	}
+	received := time.Unix(int64(byteOrder.Uint64(v)), 0).UTC()
+	return []dumpField{
+		{"received", received.Format(time.RFC3339)},
+		{"txhash", tx.TxHash().String()},
+		{"inputs", strconv.Itoa(len(tx.TxIn))},
+		{"outputs", strconv.Itoa(len(tx.TxOut))},
+		{"expiry", dumpUint(tx.Expiry)},
+		{"size", strconv.Itoa(len(v) - 8)},
+	}
+}
+
+func dumpCredit(v []byte) []dumpField {
+	amount, spent, err := fetchRawCreditAmountSpent(v)
+	if err != nil {
+		return nil
+	}
+	_, change, _ := fetchRawCreditAmountChange(v)
+	fields := []dumpField{
+		{"amount", amount.String()},
+		{"spent", dumpBool(spent)},
+		{"change", dumpBool(change)},
+		{"opcode", dumpUint(uint32(fetchRawCreditTagOpCode(v)))},
+		{"coinbase", dumpBool(fetchRawCreditIsCoinbase(v))},
+	}
+	if spent && len(v) >= 81 {
+		fields = append(fields, dumpField{"spender",
+			dumpCreditRef(extractRawCreditSpenderDebitKey(v))})
+	}
+	if account, err := fetchRawCreditAccount(v); err == nil {
+		fields = append(fields, dumpField{"account", dumpUint(account)})
+	}
+	return fields
+}
+
+func dumpUnspent(v []byte) []dumpField {
+	var block Block
+	if readUnspentBlock(v, &block) != nil {
+		return nil
+	}
+	return []dumpField{
+		{"height", dumpInt(block.Height)},
+		{"block", block.Hash.String()},
+	}
+}
+
+func dumpDebit(v []byte) []dumpField {
+	if len(v) != 80 {
+		return nil
+	}
+	return []dumpField{
+		{"amount", extractRawDebitAmount(v).String()},
+		{"credit", dumpCreditRef(extractRawDebitCreditKey(v))},
+	}
+}
+
+func dumpUnminedCredit(v []byte) []dumpField {
+	amount, change, err := fetchRawUnminedCreditAmountChange(v)
+	if err != nil {
+		return nil
+	}
+	fields := []dumpField{
+		{"amount", amount.String()},
+		{"change", dumpBool(change)},
+		{"opcode", dumpUint(uint32(fetchRawUnminedCreditTagOpCode(v)))},
+		{"coinbase", dumpBool(fetchRawUnminedCreditTagIsCoinbase(v))},
+	}
+	if account, err := fetchRawUnminedCreditAccount(v); err == nil {
+		fields = append(fields, dumpField{"account", dumpUint(account)})
+	}
+	return fields
+}
+
+func dumpSpenderHash(v []byte) []dumpField {
+	if len(v) != chainhash.HashSize {
+		return nil
+	}
+	return []dumpField{{"spender", dumpHashString(v)}}
+}
+
+func dumpTicketRecord(v []byte) []dumpField {
+	if len(v) != 4 {
+		return nil
+	}
+	return []dumpField{{"pickedheight", dumpInt(extractRawTicketPickedHeight(v))}}
+}
+
+func dumpCFilter(v []byte) []dumpField {
+	if len(v) < 16 {
+		return nil
+	}
+	return []dumpField{
+		{"key", hex.EncodeToString(v[:16])},
+		{"filterlen", strconv.Itoa(len(v) - 16)},
+	}
+}
+
+func dumpTicketCommitment(v []byte) []dumpField {
+	amount, err := fetchRawTicketCommitmentAmount(v)
+	if err != nil {
+		return nil
+	}
+	account, err := fetchRawTicketCommitmentAccount(v)
+	if err != nil {
+		return nil
+	}
+	return []dumpField{
+		{"amount", amount.String()},
+		{"account", dumpUint(account)},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+func TestDumpDecoders(t *testing.T) {
+	t.Parallel()
+
+	hash := chainhash.Hash{1, 2, 3}
+	k := make([]byte, 72)
+	copy(k, hash[:])
+	byteOrder.PutUint32(k[32:36], 100)
+	byteOrder.PutUint32(k[68:72], 2)
+	key := formatDumpFields(decodeDump(dumpCreditKey, k))
+	want := "hash=" + hash.String() + " height=100 block=" +
+		chainhash.Hash{}.String() + " index=2"
+	if key != want {
+		t.Errorf("credit key: got %q, want %q", key, want)
+	}
+
+	v := make([]byte, 9)
+	byteOrder.PutUint64(v, 1e8)
+	v[8] = 1<<1 | 1<<5
+	value := formatDumpFields(decodeDump(dumpCredit, v))
+	want = "amount=1 DCR spent=false change=true opcode=185 coinbase=true"
+	if value != want {
+		t.Errorf("credit value: got %q, want %q", value, want)
+	}
+
+	// Values which can not be decoded are dumped as hex.
+	raw := formatDumpFields(decodeDump(dumpCredit, []byte{0xab}))
+	if raw != "raw=ab" {
+		t.Errorf("short credit value: got %q, want %q", raw, "raw=ab")
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/internal/compat"
+	"decred.org/dcrwallet/v5/lru"
 	"decred.org/dcrwallet/v5/wallet/txauthor"
 	"decred.org/dcrwallet/v5/wallet/txrules"
 	"decred.org/dcrwallet/v5/wallet/txsizes"
@@ -176,6 +177,10 @@ type Store struct {
 	chainParams    *chaincfg.Params
 	acctLookupFunc func(walletdb.ReadBucket, stdaddr.Address) (uint32, error)
 	manager        *Manager
+
+	// txRecords caches deserialized mined transaction records by their
+	// record keys.  Records are not cached when nil.
+	txRecords *lru.Map[string, *cachedTxRecord]
 }
 
 // MainChainTip returns the hash and height of the currently marked tip-most
@@ -1928,6 +1933,7 @@ func (s *Store) Rollback(dbtx walletdb.ReadWriteTx, height int32) error {
 			if err != nil {
 				return err
 			}
+			s.evictMinedTxRecord(recKey)
 
 			// Handle coinbase transactions specially since they are
 			// not moved to the unconfirmed store.  A coinbase cannot
@@ -2619,6 +2625,49 @@ func (s *Store) ForEachUnspentOutpoint(dbtx walletdb.ReadTx, f func(*wire.OutPoi
 	return nil
 }
 
+// MinedBalance returns the recorded total of all mined unspent outputs,
+// excluding ticket purchase outputs.
+func (s *Store) MinedBalance(dbtx walletdb.ReadTx) (dcrutil.Amount, error) {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	return fetchMinedBalance(ns)
+}
+
+// ForEachMinedUnspentCredit calls f with the outpoint, amount, and whether the
+// output is a ticket purchase output for every mined credit recorded as
+// unspent.  Unlike ForEachUnspentOutpoint, outputs spent by unmined
+// transactions are included.  The order is undefined.
+func (s *Store) ForEachMinedUnspentCredit(dbtx walletdb.ReadTx,
+	f func(op *wire.OutPoint, amount dcrutil.Amount, ticket bool) error) error {
+
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	return ns.NestedReadBucket(bucketUnspent).ForEach(func(k, v []byte) error {
+		var op wire.OutPoint
+		err := readCanonicalOutPoint(k, &op)
+		if err != nil {
+			return err
+		}
+		var block Block
+		err = readUnspentBlock(v, &block)
+		if err != nil {
+			return err
+		}
+		vC := existsRawCredit(ns, keyCredit(&op.Hash, op.Index, &block))
+		if vC == nil {
+			return errors.E(errors.IO, errors.Errorf("missing credit for "+
+				"unspent output %v", &op))
+		}
+		amount, err := fetchRawCreditAmount(vC)
+		if err != nil {
+			return err
+		}
+		opCode := fetchRawCreditTagOpCode(vC)
+		if opCode != opNonstake {
+			op.Tree = wire.TxTreeStake
+		}
+		return f(&op, amount, opCode == txscript.OP_SSTX)
+	})
+}
+
 // IsUnspentOutpoint returns whether the outpoint is recorded as a wallet UTXO.
 func (s *Store) IsUnspentOutpoint(dbtx walletdb.ReadTx, op *wire.OutPoint) bool {
 	ns := dbtx.ReadBucket(wtxmgrBucketKey)
@@ -3549,6 +3598,16 @@ func (s *Store) balanceFullScan(dbtx walletdb.ReadTx, minConf int32, syncHeight
 				ab.Spendable += utxoAmt
 			} else if !fetchRawCreditIsCoinbase(v) {
 				ab.Unconfirmed += utxoAmt
+
+				// Change returned by the wallet's own published
+				// transactions is immediately spendable.
+				_, change, err := fetchRawUnminedCreditAmountChange(v)
+				if err != nil {
+					return nil, err
+				}
+				if change && !unpublished {
+					ab.Effective += utxoAmt
+				}
 			}
 			ab.Total += utxoAmt
 		case txscript.OP_SSTX:
@@ -3596,6 +3655,12 @@ func (s *Store) balanceFullScan(dbtx walletdb.ReadTx, minConf int32, syncHeight
 	}
 	it.close()
 
+	// Outputs spent by unmined transactions were excluded from the
+	// spendable balance above.
+	for _, ab := range accountBalances {
+		ab.Effective += ab.Spendable
+	}
+
 	return accountBalances, nil
 }
 
@@ -3609,6 +3674,7 @@ type Balances = struct {
 	Total                   dcrutil.Amount
 	VotingAuthority         dcrutil.Amount
 	Unconfirmed             dcrutil.Amount
+	Effective               dcrutil.Amount
 }
 
 // AccountBalance returns a Balances struct for some given account at
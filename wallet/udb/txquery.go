@@ -60,7 +60,7 @@ func (s *Store) minedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash, r
 
 	// Parse transaction record k/v, lookup the full block record for the
 	// block time, and read all matching credits, debits.
-	err := readRawTxRecord(txHash, recVal, &details.TxRecord)
+	err := s.readMinedTxRecord(txHash, recKey, recVal, &details.TxRecord)
 	if err != nil {
 		return nil, err
 	}
@@ -444,7 +444,7 @@ func (s *Store) rangeBlockTransactions(ctx context.Context, ns walletdb.ReadBuck
 					Time:  block.Time,
 				},
 			}
-			err := readRawTxRecord(&txHash, v, &detail.TxRecord)
+			err := s.readMinedTxRecord(&txHash, k, v, &detail.TxRecord)
 			if err != nil {
 				return false, err
 			}
@@ -12,6 +12,7 @@ import (
 	"decred.org/dcrwallet/v5/wallet/walletdb"
 	"github.com/decred/dcrd/blockchain/stake/v5"
 	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/wire"
 )
 
@@ -278,6 +279,46 @@ func (s *Store) RemoveUnconfirmed(ns walletdb.ReadWriteBucket, tx *wire.MsgTx, t
 	return deleteRawUnmined(ns, txHash[:])
 }
 
+// UnminedDescendants returns the hashes of all unmined transactions which
+// spend outputs of the unmined transaction txHash, either directly or through
+// other unmined transactions.  These are the transactions removed along with
+// txHash by RemoveUnconfirmed.
+func (s *Store) UnminedDescendants(dbtx walletdb.ReadTx, txHash *chainhash.Hash) ([]chainhash.Hash, error) {
+	ns := dbtx.ReadBucket(wtxmgrBucketKey)
+	var descendants []chainhash.Hash
+	seen := map[chainhash.Hash]struct{}{*txHash: {}}
+	queue := []chainhash.Hash{*txHash}
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		v := existsRawUnmined(ns, hash[:])
+		if v == nil {
+			continue
+		}
+		var tx wire.MsgTx
+		err := tx.Deserialize(bytes.NewReader(extractRawUnminedTx(v)))
+		if err != nil {
+			return nil, errors.E(errors.IO, err)
+		}
+		for i := range tx.TxOut {
+			k := canonicalOutPoint(&hash, uint32(i))
+			spenderHash := existsRawUnminedInput(ns, k)
+			if spenderHash == nil {
+				continue
+			}
+			var spender chainhash.Hash
+			copy(spender[:], spenderHash)
+			if _, ok := seen[spender]; ok {
+				continue
+			}
+			seen[spender] = struct{}{}
+			descendants = append(descendants, spender)
+			queue = append(queue, spender)
+		}
+	}
+	return descendants, nil
+}
+
 // UnminedTxs returns the transaction records for all unmined transactions
 // which are not known to have been mined in a block.  Transactions are
 // guaranteed to be sorted by their dependency order.
@@ -333,6 +374,22 @@ func (s *Store) unminedTxHashes(ns walletdb.ReadBucket) ([]*chainhash.Hash, erro
 	return hashes, err
 }
 
+// PrunedTx describes an unmined transaction removed by PruneUnmined.
+type PrunedTx struct {
+	Hash chainhash.Hash
+
+	// Expired is true when the transaction was removed for reaching its
+	// expiry height.
+	Expired bool
+
+	// TicketPurchase is true when the transaction is a ticket purchase.
+	TicketPurchase bool
+
+	// Returned is the total value of the wallet outputs spent by the
+	// transaction which are unspent again after its removal.
+	Returned dcrutil.Amount
+}
+
 // PruneUnmined removes unmined transactions that no longer belong in the
 // unmined tx set.  This includes:
 //
@@ -340,14 +397,16 @@ func (s *Store) unminedTxHashes(ns walletdb.ReadBucket) ([]*chainhash.Hash, erro
 //   - Ticket purchases with a different ticket price than the passed stake
 //     difficulty
 //   - Votes that do not vote on the tip block
-func (s *Store) PruneUnmined(dbtx walletdb.ReadWriteTx, stakeDiff int64) ([]*chainhash.Hash, error) {
+func (s *Store) PruneUnmined(dbtx walletdb.ReadWriteTx, stakeDiff int64) ([]*PrunedTx, error) {
 	ns := dbtx.ReadWriteBucket(wtxmgrBucketKey)
 
 	tipHash, tipHeight := s.MainChainTip(dbtx)
 
 	type removeTx struct {
-		tx   wire.MsgTx
-		hash *chainhash.Hash
+		tx               wire.MsgTx
+		hash             *chainhash.Hash
+		expired          bool
+		isTicketPurchase bool
 	}
 	var toRemove []*removeTx
 
@@ -360,12 +419,12 @@ func (s *Store) PruneUnmined(dbtx walletdb.ReadWriteTx, stakeDiff int64) ([]*cha
 			return nil, errors.E(errors.IO, err)
 		}
 
-		var expired, isTicketPurchase, isVote bool
+		var expired, isVote bool
+		isTicketPurchase := stake.IsSStx(&tx)
 		switch {
 		case tx.Expiry != wire.NoExpiryValue && tx.Expiry <= uint32(tipHeight):
 			expired = true
-		case stake.IsSStx(&tx):
-			isTicketPurchase = true
+		case isTicketPurchase:
 			if tx.TxOut[0].Value == stakeDiff {
 				continue
 			}
@@ -385,24 +444,42 @@ func (s *Store) PruneUnmined(dbtx walletdb.ReadWriteTx, stakeDiff int64) ([]*cha
 			return nil, errors.E(errors.IO, err)
 		}
 
-		if expired {
+		switch {
+		case expired && isTicketPurchase:
+			log.Infof("Removing expired unmined ticket purchase %v", txHash)
+		case expired:
 			log.Infof("Removing expired unmined transaction %v", txHash)
-		} else if isTicketPurchase {
+		case isTicketPurchase:
 			log.Infof("Removing old unmined ticket purchase %v", txHash)
-		} else if isVote {
+		case isVote:
 			log.Infof("Removing missed or invalid vote %v", txHash)
 		}
 
-		toRemove = append(toRemove, &removeTx{tx, txHash})
+		toRemove = append(toRemove, &removeTx{tx, txHash, expired, isTicketPurchase})
 	}
 
-	removed := make([]*chainhash.Hash, 0, len(toRemove))
+	removed := make([]*PrunedTx, 0, len(toRemove))
 	for _, r := range toRemove {
 		err := s.RemoveUnconfirmed(ns, &r.tx, r.hash)
 		if err != nil {
 			return removed, err
 		}
-		removed = append(removed, r.hash)
+		p := &PrunedTx{
+			Hash:           *r.hash,
+			Expired:        r.expired,
+			TicketPurchase: r.isTicketPurchase,
+		}
+		for _, in := range r.tx.TxIn {
+			c, err := s.UnspentOutput(ns, in.PreviousOutPoint, true)
+			if err == nil {
+				p.Returned += c.Amount
+			}
+		}
+		if p.Returned != 0 {
+			log.Infof("Returned %v spent by removed transaction %v",
+				p.Returned, r.hash)
+		}
+		removed = append(removed, p)
 	}
 
 	return removed, nil
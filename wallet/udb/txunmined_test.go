@@ -0,0 +1,100 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+func TestUnminedDescendants(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, teardown, err := cloneDB(ctx, "unmined_descendants.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spend := func(prev *wire.MsgTx, index uint32, outs int) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		prevHash := prev.TxHash()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, index,
+			wire.TxTreeRegular), prev.TxOut[index].Value, nil))
+		for i := 0; i < outs; i++ {
+			tx.AddTxOut(wire.NewTxOut(1e6, nil))
+		}
+		return tx
+	}
+
+	// tx1 has two outputs spent by tx2 and tx4.  tx3 spends tx2, and tx5
+	// is unrelated.
+	tx1 := wire.NewMsgTx()
+	tx1.AddTxOut(wire.NewTxOut(1e8, nil))
+	tx1.AddTxOut(wire.NewTxOut(1e8, nil))
+	tx2 := spend(tx1, 0, 1)
+	tx3 := spend(tx2, 0, 1)
+	tx4 := spend(tx1, 1, 1)
+	tx5 := wire.NewMsgTx()
+	tx5.AddTxOut(wire.NewTxOut(1e8, nil))
+
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, tx := range []*wire.MsgTx{tx1, tx2, tx3, tx4, tx5} {
+			rec, err := NewTxRecordFromMsgTx(tx, time.Now())
+			if err != nil {
+				return err
+			}
+			err = s.InsertMemPoolTx(dbtx, rec)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		tx   *wire.MsgTx
+		want []*wire.MsgTx
+	}{
+		{tx1, []*wire.MsgTx{tx2, tx3, tx4}},
+		{tx2, []*wire.MsgTx{tx3}},
+		{tx3, nil},
+		{tx5, nil},
+	}
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		for i, test := range tests {
+			txHash := test.tx.TxHash()
+			got, err := s.UnminedDescendants(dbtx, &txHash)
+			if err != nil {
+				return err
+			}
+			want := make(map[chainhash.Hash]struct{})
+			for _, tx := range test.want {
+				want[tx.TxHash()] = struct{}{}
+			}
+			if len(got) != len(want) {
+				t.Errorf("test %d: got %d descendants, want %d", i,
+					len(got), len(want))
+				continue
+			}
+			for j := range got {
+				if _, ok := want[got[j]]; !ok {
+					t.Errorf("test %d: unexpected descendant %v", i, &got[j])
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -207,10 +207,44 @@ const (
 	// the genesis block.
 	birthBlockVersion = 26
 
+	// paymentTemplatesVersion is the 27th version of the database.  It adds
+	// a top-level bucket for recording stored payment templates.
+	paymentTemplatesVersion = 27
+
+	// outputLabelsVersion is the 28th version of the database.  It adds a
+	// top-level bucket for recording labels of transaction outputs.
+	outputLabelsVersion = 28
+
+	// accountSpendPoliciesVersion is the 29th version of the database.  It
+	// adds a top-level bucket for recording the spend policies of accounts.
+	accountSpendPoliciesVersion = 29
+
+	// addressBookVersion is the 30th version of the database.  It adds a
+	// top-level bucket for recording address book contacts.
+	addressBookVersion = 30
+
+	// attestationVersion is the 31st version of the database.  It adds a
+	// top-level bucket for recording the wallet state attestation key and
+	// the latest signed attestation.
+	attestationVersion = 31
+
+	// walletEventsVersion is the 32nd version of the database.  It adds a
+	// top-level bucket for recording a capped history of wallet events.
+	walletEventsVersion = 32
+
+	// balanceSnapshotsVersion is the 33rd version of the database.  It adds
+	// a top-level bucket for recording daily balance snapshots.
+	balanceSnapshotsVersion = 33
+
+	// votingDomainVersion is the 34th version of the database.  It adds an
+	// address manager bucket for recording the voting domain crypto key and
+	// the voting account private keys encrypted by it.
+	votingDomainVersion = 34
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
 	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = birthBlockVersion
+	DBVersion = votingDomainVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
@@ -242,6 +276,14 @@ var upgrades = [...]func(walletdb.ReadWriteTx, []byte, *chaincfg.Params) error{
 	vspTreasuryPoliciesVersion - 1:        vspTreasuryPoliciesUpgrade,
 	importVotingAccountVersion - 1:        importVotingAccountUpgrade,
 	birthBlockVersion - 1:                 birthBlockUpgrade,
+	paymentTemplatesVersion - 1:           paymentTemplatesUpgrade,
+	outputLabelsVersion - 1:               outputLabelsUpgrade,
+	accountSpendPoliciesVersion - 1:       accountSpendPoliciesUpgrade,
+	addressBookVersion - 1:                addressBookUpgrade,
+	attestationVersion - 1:                attestationUpgrade,
+	walletEventsVersion - 1:               walletEventsUpgrade,
+	balanceSnapshotsVersion - 1:           balanceSnapshotsUpgrade,
+	votingDomainVersion - 1:               votingDomainUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -1688,9 +1730,201 @@ func birthBlockUpgrade(tx walletdb.ReadWriteTx, _ []byte, params *chaincfg.Param
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
-// Upgrade checks whether the any upgrades are necessary before the database is
-// ready for application usage.  If any are, they are performed.
-func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
+func paymentTemplatesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 26
+	const newVersion = 27
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 26 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "paymentTemplatesUpgrade inappropriately called")
+	}
+
+	_, err = tx.CreateTopLevelBucket(paymentTemplatesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func outputLabelsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 27
+	const newVersion = 28
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 27 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "outputLabelsUpgrade inappropriately called")
+	}
+
+	_, err = tx.CreateTopLevelBucket(outputLabelsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func accountSpendPoliciesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 28
+	const newVersion = 29
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 28 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountSpendPoliciesUpgrade inappropriately called")
+	}
+
+	_, err = tx.CreateTopLevelBucket(accountSpendPoliciesBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func addressBookUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 29
+	const newVersion = 30
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 29 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "addressBookUpgrade inappropriately called")
+	}
+
+	_, err = tx.CreateTopLevelBucket(addressBookBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func attestationUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 30
+	const newVersion = 31
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 30 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "attestationUpgrade inappropriately called")
+	}
+
+	_, err = tx.CreateTopLevelBucket(attestationBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func walletEventsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 31
+	const newVersion = 32
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 31 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "walletEventsUpgrade inappropriately called")
+	}
+
+	_, err = tx.CreateTopLevelBucket(walletEventsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func balanceSnapshotsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 32
+	const newVersion = 33
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 32 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "balanceSnapshotsUpgrade inappropriately called")
+	}
+
+	_, err = tx.CreateTopLevelBucket(balanceSnapshotsBucketKey)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func votingDomainUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 33
+	const newVersion = 34
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+
+	// Assert that this function is only called on version 33 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "votingDomainUpgrade inappropriately called")
+	}
+
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+	err = createVotingDomainBucket(addrmgrBucket)
+	if err != nil {
+		return err
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+// Version returns the version of the database.
+func Version(ctx context.Context, db walletdb.DB) (uint32, error) {
 	var version uint32
 	err := walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
 		var err error
@@ -1703,6 +1937,13 @@ func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, param
 		version, err = unifiedDBMetadata{}.getVersion(metadataBucket)
 		return err
 	})
+	return version, err
+}
+
+// Upgrade checks whether the any upgrades are necessary before the database is
+// ready for application usage.  If any are, they are performed.
+func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
+	version, err := Version(ctx, db)
 	if err != nil {
 		return err
 	}
@@ -1725,3 +1966,30 @@ func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, param
 		return nil
 	})
 }
+
+// UpgradeStepwise performs the same upgrades as Upgrade, but commits every
+// upgrade in its own transaction and calls f with the new database version
+// after each.  It is intended for measuring the upgrades of a copy of the
+// database, and is not atomic.
+func UpgradeStepwise(ctx context.Context, db walletdb.DB, publicPassphrase []byte,
+	params *chaincfg.Params, f func(version uint32) error) error {
+
+	version, err := Version(ctx, db)
+	if err != nil {
+		return err
+	}
+	for ; version < DBVersion; version++ {
+		upgrade := upgrades[version]
+		err := walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+			return upgrade(tx, publicPassphrase, params)
+		})
+		if err != nil {
+			return err
+		}
+		err = f(version + 1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
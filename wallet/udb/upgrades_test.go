@@ -35,6 +35,7 @@ var dbUpgradeTests = [...]struct {
 	// No upgrade test for V9, it is a fix for V8 and the previous test still applies
 	// TODO: V10 upgrade test
 	{verifyV12Upgrade, "v11.db.gz"},
+	{verifyV34Upgrade, "v24.db.gz"},
 }
 
 var pubPass = []byte("public")
@@ -485,3 +486,35 @@ func verifyV12Upgrade(ctx context.Context, t *testing.T, db walletdb.DB) {
 		t.Error(err)
 	}
 }
+
+func verifyV34Upgrade(ctx context.Context, t *testing.T, db walletdb.DB) {
+	m, _, err := Open(ctx, db, chaincfg.TestNet3Params(), pubPass)
+	if err != nil {
+		t.Fatalf("Open after Upgrade failed: %v", err)
+	}
+
+	err = walletdb.View(ctx, db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrBucketKey)
+		b := ns.NestedReadBucket(votingDomainBucketName)
+		if b == nil {
+			t.Fatalf("upgrade should have created the voting domain bucket")
+		}
+		if b.NestedReadBucket(votingDomainAcctsBucketName) == nil {
+			t.Fatalf("upgrade should have created the voting accounts bucket")
+		}
+		if b.Get(votingDomainCryptoKeyName) != nil {
+			t.Errorf("upgrade should not have created a voting crypto key")
+		}
+		accounts, err := m.VotingAccounts(tx)
+		if err != nil {
+			return err
+		}
+		if len(accounts) != 0 {
+			t.Errorf("upgraded database has voting accounts %v", accounts)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
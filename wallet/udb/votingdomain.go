@@ -0,0 +1,320 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/kdf"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/crypto/rand"
+	"github.com/decred/dcrd/hdkeychain/v3"
+)
+
+// The voting domain bucket is nested in the address manager namespace.  It
+// records the voting domain crypto key, sealed by a key derived from the
+// voting passphrase, and a nested bucket of account private keys sealed by the
+// voting domain crypto key, keyed by the account number.
+var (
+	votingDomainBucketName      = []byte("votingdomain")
+	votingDomainAcctsBucketName = []byte("accts")
+
+	votingDomainKDFName       = []byte("kdf")
+	votingDomainCryptoKeyName = []byte("cryptokey")
+)
+
+// votingDomainBucket returns the voting domain bucket of the address manager
+// namespace.
+func votingDomainBucket(ns walletdb.ReadBucket) walletdb.ReadBucket {
+	return ns.NestedReadBucket(votingDomainBucketName)
+}
+
+// SetVotingPassphrase sets the passphrase protecting the voting domain crypto
+// key.  The key is created when no voting passphrase has been set, and
+// otherwise the voting domain must be unlocked to change the passphrase.  The
+// voting domain is left unlocked.
+func (m *Manager) SetVotingPassphrase(dbtx walletdb.ReadWriteTx, passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return errors.E(errors.Invalid, "voting passphrase may not be empty")
+	}
+
+	ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+	bucket := ns.NestedReadWriteBucket(votingDomainBucketName)
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly, "watching-only wallets "+
+			"have no voting domain")
+	}
+
+	cryptoKey := m.votingCryptoKey
+	if cryptoKey == nil {
+		if bucket.Get(votingDomainCryptoKeyName) != nil {
+			return errors.E(errors.Locked, "voting domain must be "+
+				"unlocked to change its passphrase")
+		}
+		cryptoKey = make(keyType, 32)
+		rand.Read(cryptoKey)
+	}
+
+	kdfp, err := kdf.NewArgon2idParams(rand.Reader())
+	if err != nil {
+		return err
+	}
+	key := argon2idKey(passphrase, kdfp)
+	ciphertext, err := seal(key, cryptoKey)
+	zero(key)
+	if err != nil {
+		return err
+	}
+	marshaledKDF, err := kdfp.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	err = bucket.Put(votingDomainKDFName, marshaledKDF)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = bucket.Put(votingDomainCryptoKeyName, ciphertext)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	m.votingCryptoKey = cryptoKey
+	return nil
+}
+
+// UnlockVotingDomain decrypts the voting domain crypto key with the voting
+// passphrase and the private keys of all voting accounts.  Voting account keys
+// remain unlocked when the manager is locked, and are only cleared by
+// LockVotingDomain or by closing the manager.
+func (m *Manager) UnlockVotingDomain(dbtx walletdb.ReadTx, passphrase []byte) error {
+	ns := dbtx.ReadBucket(waddrmgrBucketKey)
+	bucket := votingDomainBucket(ns)
+
+	marshaledKDF := bucket.Get(votingDomainKDFName)
+	ciphertext := bucket.Get(votingDomainCryptoKeyName)
+	if marshaledKDF == nil || ciphertext == nil {
+		return errors.E(errors.NotExist, "no voting passphrase is set")
+	}
+	kdfp := new(kdf.Argon2idParams)
+	err := kdfp.UnmarshalBinary(marshaledKDF)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	key := argon2idKey(passphrase, kdfp)
+	cryptoKey, err := unseal(key, ciphertext)
+	zero(key)
+	if err != nil {
+		return err
+	}
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	m.lockVotingDomain()
+	m.votingCryptoKey = cryptoKey
+
+	accts := bucket.NestedReadBucket(votingDomainAcctsBucketName)
+	err = accts.ForEach(func(k, v []byte) error {
+		acctInfo, err := m.loadAccountInfo(ns, byteOrder.Uint32(k))
+		if err != nil {
+			return err
+		}
+		if acctInfo.votingKeyPriv != nil {
+			return nil
+		}
+		acctInfo.votingKeyPriv, err = m.unsealVotingKey(v)
+		return err
+	})
+	if err != nil {
+		m.lockVotingDomain()
+		return err
+	}
+	return nil
+}
+
+// LockVotingDomain clears the voting domain crypto key and the voting account
+// private keys from memory.
+func (m *Manager) LockVotingDomain() {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	m.lockVotingDomain()
+}
+
+// VotingDomainUnlocked returns whether the voting domain crypto key is
+// decrypted.
+func (m *Manager) VotingDomainUnlocked() bool {
+	defer m.mtx.RUnlock()
+	m.mtx.RLock()
+
+	return m.votingCryptoKey != nil
+}
+
+// AddVotingAccount records a copy of an account private key encrypted by the
+// voting domain crypto key, allowing the account to derive private keys while
+// the manager is locked.  The voting domain must be unlocked, and the account
+// private key must be available through an unlocked manager or account.
+func (m *Manager) AddVotingAccount(dbtx walletdb.ReadWriteTx, account uint32) error {
+	ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+	accts := ns.NestedReadWriteBucket(votingDomainBucketName).
+		NestedReadWriteBucket(votingDomainAcctsBucketName)
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.votingCryptoKey == nil {
+		return errors.E(errors.Locked, "voting domain is locked")
+	}
+	if account == ImportedAddrAccount {
+		return errors.E(errors.Invalid, "imported address account "+
+			"can not be a voting account")
+	}
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(acctInfo.acctKeyEncrypted) == 0:
+		return errors.E(errors.Invalid, "account has no private key")
+	case acctInfo.acctKeyPriv == nil && acctInfo.uniqueKey != nil:
+		return errors.E(errors.Locked, "account must be unlocked "+
+			"to add it to the voting domain")
+	case acctInfo.acctKeyPriv == nil:
+		return errors.E(errors.Locked, "wallet must be unlocked "+
+			"to add an account to the voting domain")
+	}
+
+	plaintext := []byte(acctInfo.acctKeyPriv.String())
+	defer zero(plaintext)
+	ciphertext, err := seal(m.votingCryptoKey, plaintext)
+	if err != nil {
+		return err
+	}
+	err = accts.Put(uint32ToBytes(account), ciphertext)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Keep an independent copy of the key so that locking the manager or
+	// account does not zero the voting key.
+	votingKeyPriv, err := hdkeychain.NewKeyFromString(string(plaintext),
+		m.chainParams)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if acctInfo.votingKeyPriv != nil {
+		acctInfo.votingKeyPriv.Zero()
+	}
+	acctInfo.votingKeyPriv = votingKeyPriv
+	return nil
+}
+
+// RemoveVotingAccount removes an account from the voting domain.
+func (m *Manager) RemoveVotingAccount(dbtx walletdb.ReadWriteTx, account uint32) error {
+	ns := dbtx.ReadWriteBucket(waddrmgrBucketKey)
+	accts := ns.NestedReadWriteBucket(votingDomainBucketName).
+		NestedReadWriteBucket(votingDomainAcctsBucketName)
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	k := uint32ToBytes(account)
+	if accts.Get(k) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("account %d is "+
+			"not a voting account", account))
+	}
+	err := accts.Delete(k)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	m.acctInfoMu.Lock()
+	acctInfo, ok := m.acctInfo[account]
+	m.acctInfoMu.Unlock()
+	if ok && acctInfo.votingKeyPriv != nil {
+		acctInfo.votingKeyPriv.Zero()
+		acctInfo.votingKeyPriv = nil
+	}
+	return nil
+}
+
+// VotingAccounts returns the accounts of the voting domain.
+func (m *Manager) VotingAccounts(dbtx walletdb.ReadTx) ([]uint32, error) {
+	ns := dbtx.ReadBucket(waddrmgrBucketKey)
+	accts := votingDomainBucket(ns).NestedReadBucket(votingDomainAcctsBucketName)
+
+	var accounts []uint32
+	err := accts.ForEach(func(k, v []byte) error {
+		accounts = append(accounts, byteOrder.Uint32(k))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return accounts, nil
+}
+
+// loadVotingKey decrypts the voting domain copy of an account private key.
+// It returns nil without error when the voting domain is locked or the
+// account is not a voting account.
+//
+// This function MUST be called with the manager lock held for reads.
+func (m *Manager) loadVotingKey(ns walletdb.ReadBucket, account uint32) (*hdkeychain.ExtendedKey, error) {
+	if m.votingCryptoKey == nil {
+		return nil, nil
+	}
+	ciphertext := votingDomainBucket(ns).
+		NestedReadBucket(votingDomainAcctsBucketName).
+		Get(uint32ToBytes(account))
+	if ciphertext == nil {
+		return nil, nil
+	}
+	return m.unsealVotingKey(ciphertext)
+}
+
+// unsealVotingKey decrypts an account private key sealed by the voting domain
+// crypto key.
+//
+// This function MUST be called with the manager lock held for reads.
+func (m *Manager) unsealVotingKey(ciphertext []byte) (*hdkeychain.ExtendedKey, error) {
+	plaintext, err := unseal(m.votingCryptoKey, ciphertext)
+	defer zero(plaintext)
+	if err != nil {
+		return nil, err
+	}
+	acctKeyPriv, err := hdkeychain.NewKeyFromString(string(plaintext),
+		m.chainParams)
+	if err != nil {
+		return nil, errors.E(errors.IO, err)
+	}
+	return acctKeyPriv, nil
+}
+
+// deleteVotingDomain removes the voting domain crypto key and all voting
+// account private keys from the database.
+func deleteVotingDomain(ns walletdb.ReadWriteBucket) error {
+	err := ns.DeleteNestedBucket(votingDomainBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return createVotingDomainBucket(ns)
+}
+
+// createVotingDomainBucket creates the empty voting domain bucket in the
+// address manager namespace.
+func createVotingDomainBucket(ns walletdb.ReadWriteBucket) error {
+	bucket, err := ns.CreateBucket(votingDomainBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = bucket.CreateBucket(votingDomainAcctsBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
@@ -35,10 +35,26 @@ const (
 	VSPFeeProcessConfirmed
 )
 
+func (s FeeStatus) String() string {
+	switch s {
+	case VSPFeeProcessStarted:
+		return "started"
+	case VSPFeeProcessPaid:
+		return "paid"
+	case VSPFeeProcessErrored:
+		return "errored"
+	case VSPFeeProcessConfirmed:
+		return "confirmed"
+	default:
+		return "unknown"
+	}
+}
+
 type VSPTicket struct {
 	FeeHash     chainhash.Hash
 	FeeTxStatus uint32
 	VSPHostID   uint32
+	FeeAmount   int64 // amount paid to the VSP, zero when unknown
 	Host        string
 	PubKey      []byte
 }
@@ -137,11 +153,45 @@ func GetVSPTicketsByFeeStatus(dbtx walletdb.ReadTx, feeStatus int) (map[chainhas
 	return tickets, nil
 }
 
+// VSPTicketHosts returns the VSP host associated with every ticket recorded
+// with VSP information.  Tickets without a recorded host are not included.
+func VSPTicketHosts(dbtx walletdb.ReadTx) (map[chainhash.Hash]string, error) {
+	bucket := dbtx.ReadBucket(vspBucketKey)
+	hostsByID := make(map[uint32]string)
+	hosts := make(map[chainhash.Hash]string)
+	err := bucket.ForEach(func(k, v []byte) error {
+		ticket := deserializeVSPTicket(v)
+		host, ok := hostsByID[ticket.VSPHostID]
+		if !ok {
+			h, err := GetVSPHost(dbtx, ticket.VSPHostID)
+			if err != nil && !errors.Is(err, errors.NotExist) {
+				return err
+			}
+			if h != nil {
+				host = string(h.Host)
+			}
+			hostsByID[ticket.VSPHostID] = host
+		}
+		if host == "" {
+			return nil
+		}
+		var hash chainhash.Hash
+		copy(hash[:], k)
+		hosts[hash] = host
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
 // deserializeVSPTicket deserializes the passed serialized user
 // ticket information.
 func deserializeVSPTicket(serializedTicket []byte) *VSPTicket {
 	// ticket stores hash size and an uint32 representing the fee processment
-	// status.
+	// status.  The fee amount is missing from records written before it was
+	// recorded.
 	curPos := 0
 	vspTicket := &VSPTicket{}
 	copy(vspTicket.FeeHash[:], serializedTicket[curPos:hashSize])
@@ -149,6 +199,10 @@ func deserializeVSPTicket(serializedTicket []byte) *VSPTicket {
 	vspTicket.FeeTxStatus = byteOrder.Uint32(serializedTicket[curPos : curPos+4])
 	curPos += 4
 	vspTicket.VSPHostID = byteOrder.Uint32(serializedTicket[curPos : curPos+4])
+	curPos += 4
+	if len(serializedTicket) >= curPos+8 {
+		vspTicket.FeeAmount = int64(byteOrder.Uint64(serializedTicket[curPos : curPos+8]))
+	}
 
 	return vspTicket
 }
@@ -156,8 +210,8 @@ func deserializeVSPTicket(serializedTicket []byte) *VSPTicket {
 // serializeVSPTicket returns the serialization of a single stake pool
 // user ticket.
 func serializeVSPTicket(record *VSPTicket) []byte {
-	// ticket hash size + fee processment status + host ID
-	buf := make([]byte, hashSize+4+4)
+	// ticket hash size + fee processment status + host ID + fee amount
+	buf := make([]byte, hashSize+4+4+8)
 	curPos := 0
 	// Write the fee hash.
 	copy(buf[curPos:curPos+hashSize], record.FeeHash[:])
@@ -167,6 +221,9 @@ func serializeVSPTicket(record *VSPTicket) []byte {
 	curPos += 4
 	// Write the VSP Host db ID
 	byteOrder.PutUint32(buf[curPos:curPos+4], record.VSPHostID)
+	curPos += 4
+	// Write the fee amount
+	byteOrder.PutUint64(buf[curPos:curPos+8], uint64(record.FeeAmount))
 
 	return buf
 }
@@ -108,6 +108,7 @@ func TestVSPSerializeVSPTicket(t *testing.T) {
 				FeeHash:     *feeHash,
 				FeeTxStatus: 123,
 				VSPHostID:   321,
+				FeeAmount:   456,
 				Host:        "example.com",
 				PubKey:      []byte("not-a-real-key"),
 			},
@@ -157,6 +158,11 @@ func TestVSPSerializeVSPTicket(t *testing.T) {
 				i, before.VSPHostID, after.VSPHostID)
 		}
 
+		if before.FeeAmount != after.FeeAmount {
+			t.Fatalf("test %d: feeamount not serialized correctly, expected %d, got %d",
+				i, before.FeeAmount, after.FeeAmount)
+		}
+
 		// Host and PubKey are not serialized so should always be empty.
 		if len(after.Host) != 0 {
 			t.Fatalf("test %d: expected empty host but had value %q",
@@ -169,3 +175,24 @@ func TestVSPSerializeVSPTicket(t *testing.T) {
 		}
 	}
 }
+
+func TestVSPDeserializeVSPTicketWithoutFeeAmount(t *testing.T) {
+	t.Parallel()
+
+	before := &VSPTicket{
+		FeeTxStatus: 3,
+		VSPHostID:   7,
+		FeeAmount:   456,
+	}
+
+	// Records written before fee amounts were recorded do not include the
+	// trailing amount.
+	serialized := serializeVSPTicket(before)
+	after := deserializeVSPTicket(serialized[:hashSize+4+4])
+	if after.FeeTxStatus != before.FeeTxStatus || after.VSPHostID != before.VSPHostID {
+		t.Fatalf("fields not deserialized correctly: %+v", after)
+	}
+	if after.FeeAmount != 0 {
+		t.Fatalf("expected unknown fee amount, got %d", after.FeeAmount)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+var walletEventsBucketKey = []byte("walletevents") // by big endian sequence number
+
+// MaxWalletEvents is the number of wallet events retained in the database.
+// Recording an event beyond this limit removes the oldest event.
+const MaxWalletEvents = 10000
+
+// WalletEventKind describes the kind of a recorded wallet event.
+type WalletEventKind uint8
+
+// Kinds of wallet events.
+const (
+	WalletEventConnected WalletEventKind = iota + 1
+	WalletEventDisconnected
+	WalletEventRescanStarted
+	WalletEventRescanFinished
+	WalletEventVoteCreated
+	WalletEventTicketPurchased
+	WalletEventReorg
+	WalletEventTipDivergence
+)
+
+var walletEventKindStrings = [...]string{
+	WalletEventConnected:       "connected",
+	WalletEventDisconnected:    "disconnected",
+	WalletEventRescanStarted:   "rescanstarted",
+	WalletEventRescanFinished:  "rescanfinished",
+	WalletEventVoteCreated:     "votecreated",
+	WalletEventTicketPurchased: "ticketpurchased",
+	WalletEventReorg:           "reorg",
+	WalletEventTipDivergence:   "tipdivergence",
+}
+
+// String returns the name of the event kind.
+func (k WalletEventKind) String() string {
+	if int(k) < len(walletEventKindStrings) && walletEventKindStrings[k] != "" {
+		return walletEventKindStrings[k]
+	}
+	return "unknown"
+}
+
+// WalletEvent is a high-level wallet event recorded for later inspection.
+type WalletEvent struct {
+	Seq    uint64 // Assigned when recorded
+	Time   time.Time
+	Kind   WalletEventKind
+	Height int32           // Block height the event relates to
+	Hash   *chainhash.Hash // Block or transaction hash, if any
+	Detail string
+}
+
+// Wallet event values are serialized as:
+//
+//	[0:8]   Unix time (8 bytes)
+//	[8]     Kind (1 byte)
+//	[9:13]  Height (4 bytes)
+//	[13]    Whether a hash is recorded (1 byte)
+//	[14:46] Hash (32 bytes)
+//	[46:]   Detail
+const walletEventHeaderSize = 46
+
+func valueWalletEvent(e *WalletEvent) []byte {
+	v := make([]byte, walletEventHeaderSize+len(e.Detail))
+	binary.BigEndian.PutUint64(v[0:8], uint64(e.Time.Unix()))
+	v[8] = byte(e.Kind)
+	binary.BigEndian.PutUint32(v[9:13], uint32(e.Height))
+	if e.Hash != nil {
+		v[13] = 1
+		copy(v[14:46], e.Hash[:])
+	}
+	copy(v[walletEventHeaderSize:], e.Detail)
+	return v
+}
+
+func readWalletEvent(k, v []byte) (*WalletEvent, error) {
+	if len(k) != 8 || len(v) < walletEventHeaderSize {
+		return nil, errors.E(errors.IO, errors.Errorf("wallet event "+
+			"key/value len %d/%d", len(k), len(v)))
+	}
+	e := &WalletEvent{
+		Seq:    binary.BigEndian.Uint64(k),
+		Time:   time.Unix(int64(binary.BigEndian.Uint64(v[0:8])), 0),
+		Kind:   WalletEventKind(v[8]),
+		Height: int32(binary.BigEndian.Uint32(v[9:13])),
+		Detail: string(v[walletEventHeaderSize:]),
+	}
+	if v[13] != 0 {
+		e.Hash = new(chainhash.Hash)
+		copy(e.Hash[:], v[14:46])
+	}
+	return e, nil
+}
+
+// PutWalletEvent records a wallet event, assigning its sequence number.  The
+// oldest events are removed to keep at most MaxWalletEvents recorded.
+func PutWalletEvent(dbtx walletdb.ReadWriteTx, e *WalletEvent) error {
+	b := dbtx.ReadWriteBucket(walletEventsBucketKey)
+
+	c := b.ReadCursor()
+	lastKey, _ := c.Last()
+	c.Close()
+	var seq uint64
+	if lastKey != nil {
+		seq = binary.BigEndian.Uint64(lastKey) + 1
+	}
+	e.Seq = seq
+
+	var k [8]byte
+	binary.BigEndian.PutUint64(k[:], seq)
+	err := b.Put(k[:], valueWalletEvent(e))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if seq < MaxWalletEvents {
+		return nil
+	}
+	binary.BigEndian.PutUint64(k[:], seq-MaxWalletEvents)
+	err = b.Delete(k[:])
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// WalletEvents returns up to count recorded wallet events, oldest first, after
+// skipping the from most recent events.
+func WalletEvents(dbtx walletdb.ReadTx, count, from int) ([]*WalletEvent, error) {
+	b := dbtx.ReadBucket(walletEventsBucketKey)
+	c := b.ReadCursor()
+	defer c.Close()
+
+	events := make([]*WalletEvent, 0, min(count, MaxWalletEvents))
+	k, v := c.Last()
+	for i := 0; i < from && k != nil; i++ {
+		k, v = c.Prev()
+	}
+	for ; k != nil && len(events) < count; k, v = c.Prev() {
+		e, err := readWalletEvent(k, v)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events, nil
+}
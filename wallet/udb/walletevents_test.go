@@ -0,0 +1,87 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+)
+
+func TestWalletEvents(t *testing.T) {
+	ctx := context.Background()
+	db, _, _, teardown, err := cloneDB(ctx, "wallet_events.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Record more events than are retained.
+	const n = MaxWalletEvents + 5
+	err = walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for i := 0; i < n; i++ {
+			e := &WalletEvent{
+				Time:   time.Unix(int64(1700000000+i), 0),
+				Kind:   WalletEventVoteCreated,
+				Height: int32(i),
+				Detail: fmt.Sprint(i),
+			}
+			if i%2 == 0 {
+				e.Hash = &chainhash.Hash{byte(i)}
+			}
+			if err := PutWalletEvent(dbtx, e); err != nil {
+				return err
+			}
+			if e.Seq != uint64(i) {
+				return fmt.Errorf("event %d assigned sequence number %d", i, e.Seq)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = walletdb.View(ctx, db, func(dbtx walletdb.ReadTx) error {
+		all, err := WalletEvents(dbtx, n, 0)
+		if err != nil {
+			return err
+		}
+		if len(all) != MaxWalletEvents {
+			t.Errorf("retained %d events, want %d", len(all), MaxWalletEvents)
+		}
+		if all[0].Seq != n-MaxWalletEvents {
+			t.Errorf("oldest retained event %d, want %d", all[0].Seq,
+				n-MaxWalletEvents)
+		}
+
+		events, err := WalletEvents(dbtx, 2, 1)
+		if err != nil {
+			return err
+		}
+		if len(events) != 2 {
+			t.Fatalf("got %d events, want 2", len(events))
+		}
+		for i, e := range events {
+			want := n - 3 + i
+			if e.Seq != uint64(want) || e.Height != int32(want) ||
+				e.Detail != fmt.Sprint(want) || e.Kind != WalletEventVoteCreated ||
+				e.Time.Unix() != int64(1700000000+want) {
+				t.Errorf("event %d: got %+v, want event %d", i, e, want)
+			}
+			if (e.Hash != nil) != (want%2 == 0) {
+				t.Errorf("event %d: unexpected hash %v", i, e.Hash)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/v3"
+)
+
+// UpgradeStep describes a single database upgrade measured by DryRunUpgrade.
+type UpgradeStep struct {
+	Version  uint32        // database version after the upgrade
+	Duration time.Duration // time taken to perform the upgrade
+	Growth   int64         // change in database file size, in bytes
+}
+
+// UpgradeReport describes the migrations and upgrades which are performed
+// before a wallet database can be used by the wallet.
+type UpgradeReport struct {
+	// Migration reports whether the database uses the legacy separate
+	// address and transaction manager namespaces and must first be
+	// migrated to the unified database.
+	Migration         bool
+	MigrationDuration time.Duration
+	MigrationGrowth   int64
+
+	// FromVersion is the version of the database before any upgrades
+	// (after a migration, if required), and ToVersion is the version the
+	// database is upgraded to.
+	FromVersion uint32
+	ToVersion   uint32
+	Upgrades    []UpgradeStep
+
+	// Size and UpgradedSize are the sizes of the database file before and
+	// after all migrations and upgrades.
+	Size         int64
+	UpgradedSize int64
+
+	// RescanFrom is the height of the block at which the wallet begins a
+	// rescan once it is opened and synced, and RescanBlocks is the number
+	// of blocks from RescanFrom through the wallet's main chain tip.
+	// RescanBlocks is zero when no rescan is pending.  Blocks attached by
+	// later syncing are not included.
+	RescanFrom   int32
+	RescanBlocks int32
+}
+
+// DryRunUpgrade reports the migrations and upgrades required before db can be
+// used by the wallet, and the rescan performed after opening it, without
+// modifying it.  The upgrades are performed on a
+// copy of the database written to tempPath and opened with driver, which is
+// removed before returning.  The reported durations and sizes are measured on
+// the copy and are only estimates for upgrading the database itself, so
+// tempPath should be on the same filesystem as the database.
+func DryRunUpgrade(ctx context.Context, db DB, driver, tempPath string,
+	pubPass []byte, params *chaincfg.Params) (*UpgradeReport, error) {
+
+	const op errors.Op = "wallet.DryRunUpgrade"
+
+	f, err := os.OpenFile(tempPath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	defer os.Remove(tempPath)
+	err = db.internal().Copy(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+
+	cp, err := walletdb.Open(driver, tempPath)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer cp.Close()
+
+	fileSize := func() (int64, error) {
+		fi, err := os.Stat(tempPath)
+		if err != nil {
+			return 0, errors.E(errors.IO, err)
+		}
+		return fi.Size(), nil
+	}
+
+	r := &UpgradeReport{ToVersion: udb.DBVersion}
+	r.Size, err = fileSize()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	size := r.Size
+
+	r.Migration, err = udb.NeedsMigration(ctx, cp)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if r.Migration {
+		start := time.Now()
+		err = udb.Migrate(ctx, cp, params)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		r.MigrationDuration = time.Since(start)
+		newSize, err := fileSize()
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		r.MigrationGrowth = newSize - size
+		size = newSize
+	}
+
+	r.FromVersion, err = udb.Version(ctx, cp)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	start := time.Now()
+	err = udb.UpgradeStepwise(ctx, cp, pubPass, params, func(version uint32) error {
+		duration := time.Since(start)
+		newSize, err := fileSize()
+		if err != nil {
+			return err
+		}
+		r.Upgrades = append(r.Upgrades, UpgradeStep{
+			Version:  version,
+			Duration: duration,
+			Growth:   newSize - size,
+		})
+		size = newSize
+		start = time.Now()
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	r.UpgradedSize = size
+
+	_, txStore, err := udb.Open(ctx, cp, params, pubPass)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	// Only the database and transaction store are used to find the
+	// rescan point.
+	w := &Wallet{db: cp, txStore: txStore}
+	err = walletdb.View(ctx, cp, func(dbtx walletdb.ReadTx) error {
+		rp, err := w.rescanPoint(dbtx)
+		if err != nil || rp == nil {
+			return err
+		}
+		h, err := txStore.GetBlockHeader(dbtx, rp)
+		if err != nil {
+			return err
+		}
+		_, tipHeight := txStore.MainChainTip(dbtx)
+		r.RescanFrom = int32(h.Height)
+		r.RescanBlocks = tipHeight - r.RescanFrom + 1
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"slices"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/txrules"
+	"decred.org/dcrwallet/v5/wallet/txsizes"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// UTXOStats describes the fragmentation of the spendable unspent outputs of
+// an account.
+type UTXOStats struct {
+	Account   uint32
+	Count     int
+	DustCount int
+	Total     dcrutil.Amount
+	Median    dcrutil.Amount
+
+	// ConsolidationFee is the fee, at the wallet's relay fee, of a single
+	// transaction spending every output of the account to one P2PKH
+	// output.
+	ConsolidationFee dcrutil.Amount
+}
+
+// UTXOStats returns fragmentation statistics of the spendable unspent outputs
+// of every account with at least minConf confirmations.  Outputs are dust
+// when their value is below the dust limit of a P2PKH output at the wallet's
+// relay fee.  Accounts without spendable outputs are omitted.
+func (w *Wallet) UTXOStats(ctx context.Context, minConf int32) ([]UTXOStats, error) {
+	const op errors.Op = "wallet.UTXOStats"
+
+	defer w.lockedOutpointMu.Unlock()
+	w.lockedOutpointMu.Lock()
+
+	relayFee := w.RelayFee()
+	var stats []UTXOStats
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+
+		accounts := make([]uint32, 0, 1)
+		err := w.manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			accounts = append(accounts, account)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, account := range accounts {
+			eligible, err := w.findEligibleOutputs(dbtx, account, minConf,
+				tipHeight, false)
+			if err != nil {
+				return err
+			}
+			if len(eligible) == 0 {
+				continue
+			}
+
+			s := UTXOStats{Account: account, Count: len(eligible)}
+			amounts := make([]dcrutil.Amount, len(eligible))
+			for i := range eligible {
+				amount := dcrutil.Amount(eligible[i].PrevOut.Value)
+				amounts[i] = amount
+				s.Total += amount
+				if txrules.IsDustAmount(amount, txsizes.P2PKHPkScriptSize, relayFee) {
+					s.DustCount++
+				}
+			}
+			slices.Sort(amounts)
+			if n := len(amounts); n%2 == 0 {
+				s.Median = (amounts[n/2-1] + amounts[n/2]) / 2
+			} else {
+				s.Median = amounts[n/2]
+			}
+
+			inputSizes := make([]int, len(eligible))
+			for i := range inputSizes {
+				inputSizes[i] = txsizes.RedeemP2PKHSigScriptSize
+			}
+			size := txsizes.EstimateSerializeSize(inputSizes, nil,
+				txsizes.P2PKHPkScriptSize)
+			s.ConsolidationFee = txrules.FeeForSerializeSize(relayFee, size)
+
+			stats = append(stats, s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return stats, nil
+}
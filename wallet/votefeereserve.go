@@ -0,0 +1,99 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// ErrVoteFeeReserve describes the error of authoring a transaction which
+// spends funds reserved for the fees of votes and revocations.
+var ErrVoteFeeReserve = errors.New("transaction spends funds reserved for vote and revocation fees")
+
+// VoteFeeReservePerTicket returns the spendable balance retained for each
+// unspent ticket to pay the fees of its vote or revocation.  Zero disables
+// the reserve.
+func (w *Wallet) VoteFeeReservePerTicket() dcrutil.Amount {
+	return w.voteFeeReserve
+}
+
+// VoteFeeReserve returns the spendable balance the wallet retains to pay the
+// vote and revocation fees of all unspent tickets, including unmined and
+// immature tickets, over their remaining lifetime.
+func (w *Wallet) VoteFeeReserve(ctx context.Context) (dcrutil.Amount, error) {
+	const op errors.Op = "wallet.VoteFeeReserve"
+	if w.voteFeeReserve == 0 {
+		return 0, nil
+	}
+	var reserve dcrutil.Amount
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		reserve, err = w.totalVoteFeeReserve(dbtx)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return reserve, nil
+}
+
+// totalVoteFeeReserve returns the reserve of every unspent ticket the wallet
+// has voting authority for.
+func (w *Wallet) totalVoteFeeReserve(dbtx walletdb.ReadTx) (dcrutil.Amount, error) {
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	var unspent dcrutil.Amount
+	it := w.txStore.IterateTickets(dbtx)
+	defer it.Close()
+	for it.Next() {
+		if it.SpenderHash != (chainhash.Hash{}) {
+			continue
+		}
+		owned, _, err := w.hasVotingAuthority(addrmgrNs, &it.MsgTx)
+		if err != nil {
+			return 0, err
+		}
+		if owned {
+			unspent++
+		}
+	}
+	if err := it.Err(); err != nil {
+		return 0, err
+	}
+	return unspent * w.voteFeeReserve, nil
+}
+
+// checkVoteFeeReserve returns an error if spending the spent amount, and
+// purchasing newTickets additional tickets, would leave the wallet's
+// spendable balance below the vote fee reserve.
+func (w *Wallet) checkVoteFeeReserve(dbtx walletdb.ReadTx, spent dcrutil.Amount, newTickets int) error {
+	if w.voteFeeReserve == 0 {
+		return nil
+	}
+	reserve, err := w.totalVoteFeeReserve(dbtx)
+	if err != nil {
+		return err
+	}
+	reserve += dcrutil.Amount(newTickets) * w.voteFeeReserve
+
+	balances, err := w.txStore.AccountBalances(dbtx, 1)
+	if err != nil {
+		return err
+	}
+	var spendable dcrutil.Amount
+	for _, bal := range balances {
+		spendable += bal.Spendable
+	}
+	if spendable-spent < reserve {
+		return errors.E(errors.Policy, errors.Errorf("%w: spending %v "+
+			"of spendable balance %v leaves less than the reserve %v",
+			ErrVoteFeeReserve, spent, spendable, reserve))
+	}
+	return nil
+}
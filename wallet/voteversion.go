@@ -0,0 +1,47 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// VoteVersionStatus describes the latest vote version supported by the
+// wallet relative to the stake version of the network.
+type VoteVersionStatus struct {
+	VoteVersion         uint32 // latest vote version supported by the wallet
+	NetworkStakeVersion uint32 // stake version of the main chain tip block
+}
+
+// Outdated reports whether the network has upgraded to a newer stake version
+// than the wallet supports.  Votes created by an outdated wallet are counted
+// as abstaining on every agenda of the newer vote versions.
+func (s *VoteVersionStatus) Outdated() bool {
+	return s.VoteVersion < s.NetworkStakeVersion
+}
+
+// VoteVersionStatus returns the wallet's vote version and the stake version
+// of the main chain tip block.
+func (w *Wallet) VoteVersionStatus(ctx context.Context) (*VoteVersionStatus, error) {
+	const op errors.Op = "wallet.VoteVersionStatus"
+
+	s := &VoteVersionStatus{VoteVersion: voteVersion(w.chainParams)}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		tipHash, _ := w.txStore.MainChainTip(dbtx)
+		header, err := w.txStore.GetBlockHeader(dbtx, &tipHash)
+		if err != nil {
+			return err
+		}
+		s.NetworkStakeVersion = header.StakeVersion
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return s, nil
+}
@@ -14,6 +14,7 @@ import (
 	"decred.org/dcrwallet/v5/wallet/walletdb"
 	"github.com/decred/dcrd/blockchain/stake/v5"
 	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/txscript/v4/stdscript"
 	"github.com/decred/dcrd/wire"
@@ -245,48 +246,45 @@ func (v *VSPTicket) TxBlock(ctx context.Context) (int32, error) {
 	return height, err
 }
 
-func (v *VSPTicket) UpdateFeeConfirmed(ctx context.Context, feeHash chainhash.Hash, host string, pubkey []byte) error {
-	return walletdb.Update(ctx, v.wallet.db, func(dbtx walletdb.ReadWriteTx) error {
+// updateFee records the fee payment status of the ticket, along with the
+// amount paid to the VSP when the fee transaction is recorded by the wallet.
+func (v *VSPTicket) updateFee(ctx context.Context, feeHash chainhash.Hash, status udb.FeeStatus,
+	host string, pubkey []byte) error {
+
+	w := v.wallet
+	return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		var amount dcrutil.Amount
+		if feeHash != (chainhash.Hash{}) {
+			var err error
+			amount, err = w.vspFeeAmount(dbtx, &feeHash)
+			if err != nil && !errors.Is(err, errors.NotExist) {
+				return err
+			}
+		}
 		return udb.SetVSPTicket(dbtx, v.hash, &udb.VSPTicket{
 			FeeHash:     feeHash,
-			FeeTxStatus: uint32(udb.VSPFeeProcessConfirmed),
+			FeeTxStatus: uint32(status),
+			FeeAmount:   int64(amount),
 			Host:        host,
 			PubKey:      pubkey,
 		})
 	})
 }
 
+func (v *VSPTicket) UpdateFeeConfirmed(ctx context.Context, feeHash chainhash.Hash, host string, pubkey []byte) error {
+	return v.updateFee(ctx, feeHash, udb.VSPFeeProcessConfirmed, host, pubkey)
+}
+
 func (v *VSPTicket) UpdateFeePaid(ctx context.Context, feeHash chainhash.Hash, host string, pubkey []byte) error {
-	return walletdb.Update(ctx, v.wallet.db, func(dbtx walletdb.ReadWriteTx) error {
-		return udb.SetVSPTicket(dbtx, v.hash, &udb.VSPTicket{
-			FeeHash:     feeHash,
-			FeeTxStatus: uint32(udb.VSPFeeProcessPaid),
-			Host:        host,
-			PubKey:      pubkey,
-		})
-	})
+	return v.updateFee(ctx, feeHash, udb.VSPFeeProcessPaid, host, pubkey)
 }
 
 func (v *VSPTicket) UpdateFeeStarted(ctx context.Context, feeHash chainhash.Hash, host string, pubkey []byte) error {
-	return walletdb.Update(ctx, v.wallet.db, func(dbtx walletdb.ReadWriteTx) error {
-		return udb.SetVSPTicket(dbtx, v.hash, &udb.VSPTicket{
-			FeeHash:     feeHash,
-			FeeTxStatus: uint32(udb.VSPFeeProcessStarted),
-			Host:        host,
-			PubKey:      pubkey,
-		})
-	})
+	return v.updateFee(ctx, feeHash, udb.VSPFeeProcessStarted, host, pubkey)
 }
 
 func (v *VSPTicket) UpdateFeeErrored(ctx context.Context, host string, pubkey []byte) error {
-	return walletdb.Update(ctx, v.wallet.db, func(dbtx walletdb.ReadWriteTx) error {
-		return udb.SetVSPTicket(dbtx, v.hash, &udb.VSPTicket{
-			FeeHash:     chainhash.Hash{},
-			FeeTxStatus: uint32(udb.VSPFeeProcessErrored),
-			Host:        host,
-			PubKey:      pubkey,
-		})
-	})
+	return v.updateFee(ctx, chainhash.Hash{}, udb.VSPFeeProcessErrored, host, pubkey)
 }
 
 func (v *VSPTicket) FeeHash(ctx context.Context) (chainhash.Hash, error) {
@@ -0,0 +1,81 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/rpc/client/dcrd"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+)
+
+// VSPTickets groups the tickets associated with a single VSP.
+type VSPTickets struct {
+	Host    string
+	Tickets []*TicketSummary
+
+	// Counts records the number of tickets observed with each status.
+	Counts map[TicketStatus]int
+}
+
+// TicketsByVSP returns the wallet's tickets grouped by the VSP they are
+// associated with, sorted by VSP host.  Tickets without a VSP association are
+// not included.  When rpc is nil, live and missed tickets can not be
+// distinguished and are counted as unspent.
+func (w *Wallet) TicketsByVSP(ctx context.Context, rpc *dcrd.RPC) ([]*VSPTickets, error) {
+	const op errors.Op = "wallet.TicketsByVSP"
+
+	var hosts map[chainhash.Hash]string
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		hosts, err = udb.VSPTicketHosts(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	groups := make(map[string]*VSPTickets)
+	f := func(ts []*TicketSummary, _ *wire.BlockHeader) (bool, error) {
+		for _, t := range ts {
+			host, ok := hosts[*t.Ticket.Hash]
+			if !ok {
+				continue
+			}
+			g := groups[host]
+			if g == nil {
+				g = &VSPTickets{Host: host, Counts: make(map[TicketStatus]int)}
+				groups[host] = g
+			}
+			g.Tickets = append(g.Tickets, t)
+			g.Counts[t.Status]++
+		}
+		return false, nil
+	}
+	start := NewBlockIdentifierFromHeight(0)
+	end := NewBlockIdentifierFromHeight(-1)
+	if rpc != nil {
+		err = w.GetTicketsPrecise(ctx, rpc, f, start, end)
+	} else {
+		err = w.GetTickets(ctx, f, start, end)
+	}
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	vsps := make([]*VSPTickets, 0, len(groups))
+	for _, g := range groups {
+		vsps = append(vsps, g)
+	}
+	sort.Slice(vsps, func(i, j int) bool {
+		return vsps[i].Host < vsps[j].Host
+	})
+	return vsps, nil
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math/big"
 	"runtime"
 	"sort"
@@ -50,6 +51,7 @@ import (
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/txscript/v4/stdscript"
 	"github.com/decred/dcrd/wire"
+	"github.com/jrick/bitset"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -137,8 +139,13 @@ type Wallet struct {
 	lockedOutpointMu sync.Mutex
 
 	relayFee                   dcrutil.Amount
+	minRelayFee                dcrutil.Amount // chain server policy
 	relayFeeMu                 sync.Mutex
 	allowHighFees              bool
+	maxTxOutputs               int
+	maxTxSize                  int
+	inputTree                  InputTree
+	voteFeeReserve             dcrutil.Amount
 	disableCoinTypeUpgrades    bool
 	recentlyPublished          map[chainhash.Hash]struct{}
 	recentlyPublishedMu        sync.Mutex
@@ -160,6 +167,10 @@ type Wallet struct {
 	mixSems   mixSemaphores
 	mixClient *mixclient.Client
 
+	// Most recent chain tip cross-checks
+	tipChecks   []TipCheck
+	tipChecksMu sync.Mutex
+
 	// Cached Blake3 anchor candidate
 	cachedBlake3WorkDiffCandidateAnchor   *wire.BlockHeader
 	cachedBlake3WorkDiffCandidateAnchorMu sync.Mutex
@@ -197,6 +208,26 @@ type Config struct {
 	RelayFee      dcrutil.Amount
 	Params        *chaincfg.Params
 
+	// MaxTxOutputs limits the number of outputs, including change, of
+	// transactions authored by the wallet.  Zero imposes no limit.
+	MaxTxOutputs int
+
+	// MaxTxSize limits the estimated signed size of transactions authored
+	// by the wallet.  Zero or values above the network's maximum
+	// transaction size use the network's limit.
+	MaxTxSize int
+
+	// InputTree limits the transaction tree of outputs which may be
+	// selected as inputs of authored transactions.
+	InputTree InputTree
+
+	// VoteFeeReserve is the spendable balance retained for each unspent
+	// ticket to pay the fees of its vote or revocation.  Ticket purchases
+	// and other authored transactions may not spend the wallet's
+	// spendable balance below the total reserve.  Zero disables the
+	// reserve.
+	VoteFeeReserve dcrutil.Amount
+
 	Dialer DialFunc
 }
 
@@ -294,7 +325,13 @@ func voteVersion(params *chaincfg.Params) uint32 {
 	case wire.SimNet:
 		return 11
 	default:
-		return 1
+		// Alternate networks vote on the latest agendas defined by
+		// their parameters.
+		var version uint32 = 1
+		for v := range params.Deployments {
+			version = max(version, v)
+		}
+		return version
 	}
 }
 
@@ -765,11 +802,45 @@ func (w *Wallet) RelayFee() dcrutil.Amount {
 }
 
 // SetRelayFee sets a new minimum relay fee (per kB of serialized
-// transaction) used when constructing transactions.
-func (w *Wallet) SetRelayFee(relayFee dcrutil.Amount) {
+// transaction) used when constructing transactions.  An error with the
+// Policy kind is returned if the fee is below the minimum relay fee of the
+// chain server, as transactions paying this fee would not be relayed.
+func (w *Wallet) SetRelayFee(relayFee dcrutil.Amount) error {
+	const op errors.Op = "wallet.SetRelayFee"
+
 	w.relayFeeMu.Lock()
+	defer w.relayFeeMu.Unlock()
+	if relayFee < w.minRelayFee {
+		return errors.E(op, errors.Policy, errors.Errorf("fee %v/kB is "+
+			"below the network minimum relay fee %v/kB", relayFee,
+			w.minRelayFee))
+	}
 	w.relayFee = relayFee
+	return nil
+}
+
+// MinRelayFee returns the minimum relay fee (per kB of serialized
+// transaction) most recently reported by the chain server, or zero if no
+// chain server policy is known.
+func (w *Wallet) MinRelayFee() dcrutil.Amount {
+	w.relayFeeMu.Lock()
+	minRelayFee := w.minRelayFee
 	w.relayFeeMu.Unlock()
+	return minRelayFee
+}
+
+// SetMinRelayFee records the minimum relay fee policy of the chain server.
+// Fees set below this floor are rejected by SetRelayFee, and the current
+// relay fee is raised to the floor if it is lower.
+func (w *Wallet) SetMinRelayFee(minRelayFee dcrutil.Amount) {
+	w.relayFeeMu.Lock()
+	defer w.relayFeeMu.Unlock()
+	w.minRelayFee = minRelayFee
+	if w.relayFee < minRelayFee {
+		log.Warnf("Raising transaction fee from %v/kB to the network "+
+			"minimum relay fee %v/kB", w.relayFee, minRelayFee)
+		w.relayFee = minRelayFee
+	}
 }
 
 // InitialHeight is the wallet's tip height prior to syncing with the network.
@@ -1556,6 +1627,22 @@ type PurchaseTicketsRequest struct {
 	UseVotingAccount bool   // Forces use of supplied voting account.
 	DontSignTx       bool
 
+	// ExpiryBlocks sets the expiry of the purchase relative to the main
+	// chain tip, permitting tickets to be mined in no more than this many
+	// of the next blocks.  The expiry is limited to the end of the current
+	// stake difficulty window, so purchases which are not mined at the
+	// current ticket price expire.  May not be set together with Expiry.
+	ExpiryBlocks int32
+
+	// ExcludeChange restricts the outputs which fund the purchase to those
+	// paid to external addresses, never selecting change outputs or the
+	// outputs of previous split transactions.
+	ExcludeChange bool
+
+	// FeeRate is the fee rate per kB paid by the split and ticket
+	// transactions.  When zero, the wallet's relay fee is used.
+	FeeRate dcrutil.Amount
+
 	// Mixed split buying through CoinShuffle++
 	Mixing             bool
 	MixedAccount       uint32
@@ -1606,6 +1693,13 @@ func (w *Wallet) PurchaseTickets(ctx context.Context, n NetworkBackend,
 	if req.Mixing && req.MixedAccount == req.SourceAccount {
 		return nil, errors.E(op, errors.InsufficientBalance)
 	}
+	// The UTXO split pays to an internal address and would be spent
+	// unconfirmed by the purchase.
+	if req.ExcludeChange {
+		s := "paying VSP fee requires a UTXO split, which is not " +
+			"permitted when excluding change inputs"
+		return nil, errors.E(op, errors.InsufficientBalance, s)
+	}
 
 	feePercent, err := req.VSPClient.FeePercentage(ctx)
 	if err != nil {
@@ -1643,6 +1737,7 @@ func (w *Wallet) PurchaseTickets(ctx context.Context, n NetworkBackend,
 		minconf:            req.MinConf,
 		randomizeChangeIdx: true,
 		txFee:              relayFee,
+		inputTree:          w.inputTree,
 	}
 	addr, err := w.NewInternalAddress(ctx, req.SourceAccount)
 	if err != nil {
@@ -1765,6 +1860,94 @@ func (w *Wallet) LockAccount(ctx context.Context, account uint32) error {
 	})
 }
 
+// SetVotingPassphrase sets the passphrase of the voting domain.  The voting
+// domain is a set of accounts whose private keys are additionally encrypted by
+// a voting crypto key, which is protected by the voting passphrase rather than
+// the wallet passphrase.  Voting accounts remain unlocked when the wallet is
+// locked, allowing tickets to be voted without keeping spending keys in
+// memory.  Changing an existing voting passphrase requires the voting domain
+// to be unlocked.  The voting domain is unlocked after the passphrase is set.
+func (w *Wallet) SetVotingPassphrase(ctx context.Context, passphrase []byte) error {
+	const op errors.Op = "wallet.SetVotingPassphrase"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.manager.SetVotingPassphrase(dbtx, passphrase)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// UnlockVotingDomain decrypts the voting crypto key with the voting passphrase
+// and unlocks every voting account.  The voting accounts are returned.
+func (w *Wallet) UnlockVotingDomain(ctx context.Context, passphrase []byte) ([]uint32, error) {
+	const op errors.Op = "wallet.UnlockVotingDomain"
+	var accounts []uint32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		err := w.manager.UnlockVotingDomain(dbtx, passphrase)
+		if err != nil {
+			return err
+		}
+		accounts, err = w.manager.VotingAccounts(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return accounts, nil
+}
+
+// LockVotingDomain removes the voting crypto key and the private keys of all
+// voting accounts from memory.
+func (w *Wallet) LockVotingDomain() {
+	w.manager.LockVotingDomain()
+}
+
+// VotingDomainUnlocked returns whether the voting domain is unlocked.
+func (w *Wallet) VotingDomainUnlocked() bool {
+	return w.manager.VotingDomainUnlocked()
+}
+
+// AddVotingAccount adds an account to the voting domain.  The voting domain
+// and the account's private key must both be unlocked.
+func (w *Wallet) AddVotingAccount(ctx context.Context, account uint32) error {
+	const op errors.Op = "wallet.AddVotingAccount"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.manager.AddVotingAccount(dbtx, account)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// RemoveVotingAccount removes an account from the voting domain.
+func (w *Wallet) RemoveVotingAccount(ctx context.Context, account uint32) error {
+	const op errors.Op = "wallet.RemoveVotingAccount"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return w.manager.RemoveVotingAccount(dbtx, account)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// VotingAccounts returns the accounts of the voting domain.
+func (w *Wallet) VotingAccounts(ctx context.Context) ([]uint32, error) {
+	const op errors.Op = "wallet.VotingAccounts"
+	var accounts []uint32
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		accounts, err = w.manager.VotingAccounts(dbtx)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return accounts, nil
+}
+
 // AccountUnlocked returns whether an individually-encrypted account is unlocked.
 func (w *Wallet) AccountUnlocked(ctx context.Context, account uint32) (bool, error) {
 	const op errors.Op = "wallet.AccountUnlocked"
@@ -1892,6 +2075,11 @@ func (w *Wallet) ChangePublicPassphrase(ctx context.Context, old, new []byte) er
 
 // Balances describes a breakdown of an account's balances in various
 // categories.
+//
+// Effective is the mempool-aware spendable balance: the spendable balance,
+// which never includes outputs spent by the wallet's own unmined
+// transactions, plus unconfirmed change returned by those transactions.  It
+// describes what could actually be spent right now.
 type Balances struct {
 	Account                 uint32
 	ImmatureCoinbaseRewards dcrutil.Amount
@@ -1901,6 +2089,7 @@ type Balances struct {
 	Total                   dcrutil.Amount
 	VotingAuthority         dcrutil.Amount
 	Unconfirmed             dcrutil.Amount
+	Effective               dcrutil.Amount
 }
 
 // AccountBalance returns the balance breakdown for a single account.
@@ -2350,6 +2539,10 @@ const (
 	CreditReceive CreditCategory = iota
 	CreditGenerate
 	CreditImmature
+	CreditTicket
+	CreditVote
+	CreditRevocation
+	CreditImmatureStake
 )
 
 // String returns the category as a string.  This string may be used as the
@@ -2363,6 +2556,14 @@ func (c CreditCategory) String() string {
 		return "generate"
 	case CreditImmature:
 		return "immature"
+	case CreditTicket:
+		return "ticket"
+	case CreditVote:
+		return "vote"
+	case CreditRevocation:
+		return "revocation"
+	case CreditImmatureStake:
+		return "immaturestake"
 	default:
 		return "unknown"
 	}
@@ -2370,11 +2571,23 @@ func (c CreditCategory) String() string {
 
 // recvCategory returns the category of received credit outputs from a
 // transaction record.  The passed block chain height is used to distinguish
-// immature from mature coinbase outputs.
+// immature from mature coinbase, vote, and revocation outputs.
 //
 // TODO: This is intended for use by the RPC server and should be moved out of
 // this package at a later time.
 func recvCategory(details *udb.TxDetails, syncHeight int32, chainParams *chaincfg.Params) CreditCategory {
+	switch details.TxType {
+	case stake.TxTypeSStx:
+		return CreditTicket
+	case stake.TxTypeSSGen, stake.TxTypeSSRtx:
+		if !coinbaseMatured(chainParams, details.Block.Height, syncHeight) {
+			return CreditImmatureStake
+		}
+		if details.TxType == stake.TxTypeSSGen {
+			return CreditVote
+		}
+		return CreditRevocation
+	}
 	if compat.IsEitherCoinBaseTx(&details.MsgTx) {
 		if coinbaseMatured(chainParams, details.Block.Height, syncHeight) {
 			return CreditGenerate
@@ -2384,6 +2597,30 @@ func recvCategory(details *udb.TxDetails, syncHeight int32, chainParams *chaincf
 	return CreditReceive
 }
 
+// outputMaturityHeight returns the block height at which an output of a mined
+// transaction becomes spendable (or, for ticket outputs, live), or zero when
+// the output is not subject to a maturity period.
+func outputMaturityHeight(details *udb.TxDetails, index int, chainParams *chaincfg.Params) int32 {
+	height := details.Block.Height
+	if height == -1 {
+		return 0
+	}
+	switch details.TxType {
+	case stake.TxTypeSStx:
+		if index == 0 {
+			// See ticketMatured for the additional block.
+			return height + int32(chainParams.TicketMaturity) + 1
+		}
+		return height + int32(chainParams.SStxChangeMaturity)
+	case stake.TxTypeSSGen, stake.TxTypeSSRtx:
+		return height + int32(chainParams.CoinbaseMaturity)
+	}
+	if compat.IsEitherCoinBaseTx(&details.MsgTx) {
+		return height + int32(chainParams.CoinbaseMaturity)
+	}
+	return 0
+}
+
 // listTransactions creates a object that may be marshalled to a response result
 // for a listtransactions RPC.
 //
@@ -2420,7 +2657,7 @@ func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.M
 	}
 
 	// Fee can only be determined if every input is a debit.
-	var feeF64 float64
+	var fee dcrutil.Amount
 	if len(details.Debits) == len(details.MsgTx.TxIn) {
 		var debitTotal dcrutil.Amount
 		for _, deb := range details.Debits {
@@ -2433,8 +2670,10 @@ func listTransactions(tx walletdb.ReadTx, details *udb.TxDetails, addrMgr *udb.M
 		// Note: The actual fee is debitTotal - outputTotal.  However,
 		// this RPC reports negative numbers for fees, so the inverse
 		// is calculated.
-		feeF64 = (outputTotal - debitTotal).ToCoin()
+		fee = outputTotal - debitTotal
 	}
+	feeF64 := fee.ToCoin()
+	feeAtoms := int64(fee)
 
 outputs:
 	for i, output := range details.MsgTx.TxOut {
@@ -2465,7 +2704,7 @@ outputs:
 			}
 		}
 
-		amountF64 := dcrutil.Amount(output.Value).ToCoin()
+		amount := dcrutil.Amount(output.Value)
 		result := types.ListTransactionsResult{
 			// Fields left zeroed:
 			//   InvolvesWatchOnly
@@ -2474,8 +2713,8 @@ outputs:
 			// Fields set below:
 			//   Account (only for non-"send" categories)
 			//   Category
-			//   Amount
-			//   Fee
+			//   Amount, AmountAtoms
+			//   Fee, FeeAtoms
 			Address:         address,
 			Vout:            uint32(i),
 			Confirmations:   confirmations,
@@ -2487,6 +2726,8 @@ outputs:
 			Time:            received,
 			TimeReceived:    received,
 			TxType:          &txTypeStr,
+			Tree:            TxTree(details.TxType),
+			MaturityHeight:  int64(outputMaturityHeight(details, i, net)),
 		}
 
 		// Add a received/generated/immature result if this is a credit.
@@ -2501,15 +2742,19 @@ outputs:
 
 		if send {
 			result.Category = "send"
-			result.Amount = -amountF64
+			result.Amount = -amount.ToCoin()
+			result.AmountAtoms = -int64(amount)
 			result.Fee = &feeF64
+			result.FeeAtoms = &feeAtoms
 			sends = append(sends, result)
 		}
 		if isCredit {
 			result.Account = accountName
 			result.Category = recvCat
-			result.Amount = amountF64
+			result.Amount = amount.ToCoin()
+			result.AmountAtoms = int64(amount)
 			result.Fee = nil
+			result.FeeAtoms = nil
 			receives = append(receives, result)
 		}
 	}
@@ -3569,7 +3814,7 @@ func (w *Wallet) ListUnspent(ctx context.Context, minconf, maxconf int32, addres
 					}
 				}
 			}
-			if accountName != "" && accountName != acctName {
+			if accountName != "" && !AccountNameMatches(accountName, acctName) {
 				continue
 			}
 			if filter {
@@ -3644,6 +3889,7 @@ func (w *Wallet) ListUnspent(ctx context.Context, minconf, maxconf int32, addres
 				RedeemScript:  hex.EncodeToString(redeemScript),
 				TxType:        int(details.TxType),
 				Amount:        output.Amount.ToCoin(),
+				AmountAtoms:   int64(output.Amount),
 				Confirmations: int64(confs),
 				Spendable:     spendable,
 			}
@@ -4565,37 +4811,11 @@ func (w *Wallet) TotalReceivedForAddr(ctx context.Context, addr stdaddr.Address,
 // transaction hash upon success
 func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.SendOutputs"
-	relayFee := w.RelayFee()
-	for _, output := range outputs {
-		err := txrules.CheckOutput(output, relayFee)
-		if err != nil {
-			return nil, errors.E(op, err)
-		}
-	}
-
-	a := &authorTx{
-		outputs:            outputs,
-		account:            account,
-		changeAccount:      changeAccount,
-		minconf:            minconf,
-		randomizeChangeIdx: true,
-		txFee:              relayFee,
-		dontSignTx:         false,
-		isTreasury:         false,
-	}
-	err := w.authorTx(ctx, op, a)
+	tx, err := w.sendOutputs(ctx, op, outputs, account, changeAccount, minconf, 0)
 	if err != nil {
 		return nil, err
 	}
-	err = w.recordAuthoredTx(ctx, op, a)
-	if err != nil {
-		return nil, err
-	}
-	err = w.publishAndWatch(ctx, op, nil, a.atx.Tx, a.watch)
-	if err != nil {
-		return nil, err
-	}
-	hash := a.atx.Tx.TxHash()
+	hash := tx.TxHash()
 	return &hash, nil
 }
 
@@ -4619,6 +4839,7 @@ func (w *Wallet) SendOutputsToTreasury(ctx context.Context, outputs []*wire.TxOu
 		txFee:              relayFee,
 		dontSignTx:         false,
 		isTreasury:         true,
+		inputTree:          w.inputTree,
 	}
 	err := w.authorTx(ctx, op, a)
 	if err != nil {
@@ -4818,6 +5039,13 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 					return wif.PrivKey(), dcrec.STEcdsaSecp256k1, true, nil
 				}
 
+				acct, err := w.manager.AddrAccount(addrmgrNs, addr)
+				if err == nil {
+					err = checkSpendPolicy(ctx, dbtx, acct)
+					if err != nil {
+						return nil, 0, false, err
+					}
+				}
 				key, done, err := w.manager.PrivateKey(addrmgrNs, addr)
 				if err != nil {
 					return nil, 0, false, err
@@ -5055,17 +5283,20 @@ func (w *Wallet) appendRelevantOutpoints(relevant []wire.OutPoint, dbtx walletdb
 
 // AbandonTransaction removes a transaction, identified by its hash, from
 // the wallet if present.  All transaction spend chains deriving from the
-// transaction's outputs are also removed.  Does not error if the transaction
-// doesn't already exist unmined, but will if the transaction is marked mined in
-// a block on the main chain.
+// transaction's outputs are also removed, and the outputs spent by removed
+// transactions become spendable again.  Errors if the transaction does not
+// exist, or if it is marked mined in a block on the main chain.
 //
 // Purged transactions may have already been published to the network and may
 // still appear in future blocks, and new transactions spending the same inputs
 // as purged transactions may be rejected by full nodes due to being double
 // spends.  In turn, this can cause the purged transaction to be mined later and
-// replace other transactions authored by the wallet.
+// replace other transactions authored by the wallet.  See
+// AbandonUnrelayedTransaction to first check whether the transaction was
+// accepted to the mempool.
 func (w *Wallet) AbandonTransaction(ctx context.Context, hash *chainhash.Hash) error {
 	const opf = "wallet.AbandonTransaction(%v)"
+	var removed []chainhash.Hash
 	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
 		details, err := w.txStore.TxDetails(ns, hash)
@@ -5075,16 +5306,58 @@ func (w *Wallet) AbandonTransaction(ctx context.Context, hash *chainhash.Hash) e
 		if details.Block.Height != -1 {
 			return errors.E(errors.Invalid, errors.Errorf("transaction %v is mined in main chain", hash))
 		}
+		removed, err = w.txStore.UnminedDescendants(dbtx, hash)
+		if err != nil {
+			return err
+		}
 		return w.txStore.RemoveUnconfirmed(ns, &details.MsgTx, hash)
 	})
 	if err != nil {
 		op := errors.Opf(opf, hash)
 		return errors.E(op, err)
 	}
-	w.NtfnServer.notifyRemovedTransaction(*hash)
+	w.NtfnServer.notifyRemovedTransaction(&RemovedTransactionNotification{
+		TxHash: *hash,
+	})
+	for i := range removed {
+		w.NtfnServer.notifyRemovedTransaction(&RemovedTransactionNotification{
+			TxHash: removed[i],
+		})
+	}
 	return nil
 }
 
+// MempoolQuerier defines the functions required of a (trusted) network backend
+// that provides information about the transactions in its mempool.
+type MempoolQuerier interface {
+	ExistsMempoolTxs(ctx context.Context, txs []*chainhash.Hash) (bitset.Bytes, error)
+}
+
+// AbandonUnrelayedTransaction abandons a transaction as AbandonTransaction
+// does, but first checks that the transaction has not been accepted to the
+// mempool of the network backend.  Only transactions which were never relayed,
+// or which were evicted from the mempool (for example, because they are double
+// spent), may be safely abandoned.  rpc may be nil for SPV wallets, in which
+// case the mempool check is skipped.
+func (w *Wallet) AbandonUnrelayedTransaction(ctx context.Context, rpc MempoolQuerier,
+	hash *chainhash.Hash) error {
+
+	const opf = "wallet.AbandonUnrelayedTransaction(%v)"
+	if rpc != nil {
+		inMempool, err := rpc.ExistsMempoolTxs(ctx, []*chainhash.Hash{hash})
+		if err != nil {
+			op := errors.Opf(opf, hash)
+			return errors.E(op, err)
+		}
+		if inMempool.Get(0) {
+			op := errors.Opf(opf, hash)
+			return errors.E(op, errors.Policy, "transaction is in the "+
+				"mempool and may still be mined")
+		}
+	}
+	return w.AbandonTransaction(ctx, hash)
+}
+
 // AllowsHighFees returns whether the wallet is configured to allow or prevent
 // the creation and publishing of transactions with very large fees.
 func (w *Wallet) AllowsHighFees() bool {
@@ -5176,6 +5449,70 @@ func (w *Wallet) PublishUnminedTransactions(ctx context.Context, n NetworkBacken
 	return nil
 }
 
+// ResendableTransaction returns the unmined wallet transaction with the given
+// hash after checking that it may still be accepted to the mempool.  The
+// transaction must not have expired, none of the wallet outputs it spends may
+// be spent by any other transaction, and it must not pay high fees unless the
+// wallet allows them.  The returned transaction may be published with
+// NetworkBackend.PublishTransactions.
+func (w *Wallet) ResendableTransaction(ctx context.Context, txHash *chainhash.Hash) (*wire.MsgTx, error) {
+	const opf = "wallet.ResendableTransaction(%v)"
+	var tx *wire.MsgTx
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		mined, unmined := w.txStore.ExistsTxMinedOrUnmined(ns, txHash)
+		switch {
+		case mined:
+			return errors.E(errors.Invalid, "transaction is mined")
+		case !unmined:
+			return errors.E(errors.NotExist, "transaction is not an unmined wallet transaction")
+		}
+		var err error
+		tx, err = w.txStore.Tx(ns, txHash)
+		if err != nil {
+			return err
+		}
+
+		_, tipHeight := w.txStore.MainChainTip(dbtx)
+		if tx.Expiry != wire.NoExpiryValue && int64(tipHeight)+1 >= int64(tx.Expiry) {
+			return errors.E(errors.Policy, errors.Errorf("transaction "+
+				"expired at height %d", tx.Expiry))
+		}
+
+		var totalInput dcrutil.Amount
+		knownInputs := true
+		for i, in := range tx.TxIn {
+			if in.ValueIn == wire.NullValueIn {
+				knownInputs = false
+			} else {
+				totalInput += dcrutil.Amount(in.ValueIn)
+			}
+
+			spender, _, err := w.txStore.Spender(dbtx, &in.PreviousOutPoint)
+			switch {
+			case errors.Is(err, errors.Invalid), errors.Is(err, errors.NotExist):
+				// Not a wallet output, or no recorded spender.
+				continue
+			case err != nil:
+				return err
+			}
+			if spenderHash := spender.TxHash(); spenderHash != *txHash {
+				return errors.E(errors.DoubleSpend, errors.Errorf("input "+
+					"%d is spent by transaction %v", i, &spenderHash))
+			}
+		}
+		if knownInputs {
+			return w.checkHighFees(totalInput, tx)
+		}
+		return nil
+	})
+	if err != nil {
+		op := errors.Opf(opf, txHash)
+		return nil, errors.E(op, err)
+	}
+	return tx, nil
+}
+
 // ChainParams returns the network parameters for the blockchain the wallet
 // belongs to.
 func (w *Wallet) ChainParams() *chaincfg.Params {
@@ -5377,6 +5714,15 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		}
 	}
 
+	maxTxSize := cfg.MaxTxSize
+	if maxTxSize <= 0 || maxTxSize > params.MaxTxSize {
+		maxTxSize = params.MaxTxSize
+	}
+	maxTxOutputs := cfg.MaxTxOutputs
+	if maxTxOutputs < 0 {
+		maxTxOutputs = 0
+	}
+
 	w := &Wallet{
 		db: db,
 
@@ -5392,6 +5738,10 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 		gapLimit:                cfg.GapLimit,
 		watchLast:               cfg.WatchLast,
 		allowHighFees:           cfg.AllowHighFees,
+		maxTxOutputs:            maxTxOutputs,
+		maxTxSize:               maxTxSize,
+		voteFeeReserve:          cfg.VoteFeeReserve,
+		inputTree:               cfg.InputTree,
 		accountGapLimit:         cfg.AccountGapLimit,
 		disableCoinTypeUpgrades: cfg.DisableCoinTypeUpgrades,
 		manualTickets:           cfg.ManualTickets,
@@ -5653,6 +6003,88 @@ func (w *Wallet) VSPFeeHashForTicket(ctx context.Context, ticketHash *chainhash.
 	return feeHash, err
 }
 
+// VSPFeePayment describes the fee transaction paying a VSP for a ticket.
+type VSPFeePayment struct {
+	Hash   chainhash.Hash
+	Amount dcrutil.Amount // value paid to the VSP
+	Status udb.FeeStatus
+
+	// Confirmations is the number of blocks confirming the fee
+	// transaction, which is zero when unmined or not recorded by the
+	// wallet.
+	Confirmations int32
+}
+
+// vspFeeAmount returns the value paid to a VSP by a fee transaction recorded by
+// the wallet, which is the total value of all outputs not paying to the
+// wallet.
+func (w *Wallet) vspFeeAmount(dbtx walletdb.ReadTx, feeHash *chainhash.Hash) (dcrutil.Amount, error) {
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+
+	tx, err := w.txStore.Tx(txmgrNs, feeHash)
+	if err != nil {
+		return 0, err
+	}
+	var amount dcrutil.Amount
+	for _, out := range tx.TxOut {
+		_, addrs := stdscript.ExtractAddrs(out.Version, out.PkScript, w.chainParams)
+		if len(addrs) == 1 {
+			_, err := w.manager.Address(addrmgrNs, addrs[0])
+			if err == nil {
+				continue // change
+			}
+		}
+		amount += dcrutil.Amount(out.Value)
+	}
+	return amount, nil
+}
+
+// VSPFeePaymentForTicket returns the fee transaction paying a VSP for a
+// ticket.  Errors with NotExist when no fee payment is recorded for the
+// ticket.  The amount of fee payments recorded before amounts were stored is
+// read from the fee transaction.
+func (w *Wallet) VSPFeePaymentForTicket(ctx context.Context, ticketHash *chainhash.Hash) (*VSPFeePayment, error) {
+	const op errors.Op = "wallet.VSPFeePaymentForTicket"
+
+	var p *VSPFeePayment
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		data, err := udb.GetVSPTicket(dbtx, *ticketHash)
+		if err != nil {
+			return err
+		}
+		if data.FeeHash == (chainhash.Hash{}) {
+			return errors.E(errors.NotExist, errors.Errorf("no VSP fee "+
+				"payment for ticket %v", ticketHash))
+		}
+		p = &VSPFeePayment{
+			Hash:   data.FeeHash,
+			Amount: dcrutil.Amount(data.FeeAmount),
+			Status: udb.FeeStatus(data.FeeTxStatus),
+		}
+		if p.Amount == 0 {
+			p.Amount, err = w.vspFeeAmount(dbtx, &p.Hash)
+			if err != nil && !errors.Is(err, errors.NotExist) {
+				return err
+			}
+		}
+		height, err := w.txStore.TxBlockHeight(dbtx, &p.Hash)
+		switch {
+		case errors.Is(err, errors.NotExist):
+		case err != nil:
+			return err
+		case height != -1:
+			_, tipHeight := w.txStore.MainChainTip(dbtx)
+			p.Confirmations = confirms(height, tipHeight)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return p, nil
+}
+
 // VSPHostForTicket returns the current vsp host associated with VSP Ticket.
 func (w *Wallet) VSPHostForTicket(ctx context.Context, ticketHash *chainhash.Hash) (string, error) {
 	var host string
@@ -5813,3 +6245,16 @@ func (w *Wallet) ProcessedTickets(ctx context.Context) ([]*VSPTicket, error) {
 
 	return managedTickets, nil
 }
+
+// DumpTxStore writes a dump of the transaction store in the requested
+// format.  See udb.Store.Dump for details.
+func (w *Wallet) DumpTxStore(ctx context.Context, out io.Writer, format string) error {
+	const op errors.Op = "wallet.DumpTxStore"
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		return w.txStore.Dump(dbtx, out, format)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2024 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"decred.org/dcrwallet/v5/errors"
+	"decred.org/dcrwallet/v5/wallet/udb"
+	"decred.org/dcrwallet/v5/wallet/walletdb"
+)
+
+// recordEvent records a wallet event in its own database transaction.  Events
+// are informational, so failures are logged rather than returned.
+func (w *Wallet) recordEvent(ctx context.Context, e *udb.WalletEvent) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		return udb.PutWalletEvent(dbtx, e)
+	})
+	if err != nil {
+		log.Errorf("Failed to record %v wallet event: %v", e.Kind, err)
+	}
+}
+
+// recordEventTx records a wallet event in an existing database transaction.
+// Failures are logged and do not fail the transaction.
+func (w *Wallet) recordEventTx(dbtx walletdb.ReadWriteTx, e *udb.WalletEvent) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	err := udb.PutWalletEvent(dbtx, e)
+	if err != nil {
+		log.Errorf("Failed to record %v wallet event: %v", e.Kind, err)
+	}
+}
+
+// WalletEvents returns up to count recorded wallet events, oldest first,
+// after skipping the from most recent events.  At most udb.MaxWalletEvents
+// events are retained.
+func (w *Wallet) WalletEvents(ctx context.Context, count, from int) ([]*udb.WalletEvent, error) {
+	const op errors.Op = "wallet.WalletEvents"
+	var events []*udb.WalletEvent
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		events, err = udb.WalletEvents(dbtx, count, from)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return events, nil
+}
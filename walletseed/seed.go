@@ -10,6 +10,7 @@ import (
 	"encoding/hex"
 	"strings"
 
+	"decred.org/dcrwallet/v5/bip39wordlist"
 	"decred.org/dcrwallet/v5/errors"
 	"decred.org/dcrwallet/v5/pgpwordlist"
 	"github.com/decred/dcrd/hdkeychain/v3"
@@ -66,16 +67,151 @@ func EncodeMnemonic(seed []byte) string {
 	return buf.String()
 }
 
+// Format identifies the mnemonic encoding of a seed.
+type Format string
+
+// FormatPGP is the default mnemonic format, encoding each seed byte and a
+// checksum byte using the PGP word list.
+const FormatPGP Format = "pgp"
+
+// bip39Prefix prefixes the names of the BIP0039 formats.
+const bip39Prefix = "bip39-"
+
+// BIP39Format returns the format encoding seeds as BIP0039 mnemonics using the
+// word list of lang.  The seed is the entropy encoded by the mnemonic, so
+// these mnemonics can not be used by wallets which derive keys from a BIP0039
+// mnemonic using its passphrase derivation.
+func BIP39Format(lang *bip39wordlist.Language) Format {
+	return Format(bip39Prefix + lang.Name())
+}
+
+// Formats returns every supported mnemonic format.
+func Formats() []Format {
+	langs := bip39wordlist.Languages()
+	formats := make([]Format, 0, 1+len(langs))
+	formats = append(formats, FormatPGP)
+	for _, l := range langs {
+		formats = append(formats, BIP39Format(l))
+	}
+	return formats
+}
+
+// ParseFormat returns the format named s.
+func ParseFormat(s string) (Format, error) {
+	const op errors.Op = "walletseed.ParseFormat"
+	f := Format(strings.ToLower(strings.TrimSpace(s)))
+	if f == FormatPGP {
+		return f, nil
+	}
+	if _, ok := f.bip39Language(); ok {
+		return f, nil
+	}
+	return "", errors.E(op, errors.Invalid, errors.Errorf("unknown seed "+
+		"format %q", s))
+}
+
+// bip39Language returns the word list of a BIP0039 format.
+func (f Format) bip39Language() (*bip39wordlist.Language, bool) {
+	name, ok := strings.CutPrefix(string(f), bip39Prefix)
+	if !ok {
+		return nil, false
+	}
+	return bip39wordlist.LanguageByName(name)
+}
+
+// EncodeMnemonicFormat encodes a seed as a mnemonic in format f.  BIP0039
+// formats only support seeds of 16 to 32 bytes in multiples of 4 bytes.
+func EncodeMnemonicFormat(seed []byte, f Format) (string, error) {
+	const op errors.Op = "walletseed.EncodeMnemonicFormat"
+	if f == FormatPGP {
+		return EncodeMnemonic(seed), nil
+	}
+	lang, ok := f.bip39Language()
+	if !ok {
+		return "", errors.E(op, errors.Invalid, errors.Errorf("unknown seed "+
+			"format %q", f))
+	}
+	mnemonic, err := bip39wordlist.EncodeMnemonic(seed, lang)
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	return mnemonic, nil
+}
+
+// TagMnemonic prefixes a mnemonic in a format other than the default PGP
+// format with the format name, for example "bip39-english: abandon ...", so
+// that DecodeUserInput selects the correct decoder.
+func TagMnemonic(mnemonic string, f Format) string {
+	if f == FormatPGP {
+		return mnemonic
+	}
+	return string(f) + ": " + mnemonic
+}
+
+// splitTag splits an optional format tag from user input.
+func splitTag(input string) (f Format, rest string, tagged bool, err error) {
+	tag, rest, tagged := strings.Cut(input, ":")
+	if !tagged {
+		return "", input, false, nil
+	}
+	f, err = ParseFormat(tag)
+	return f, rest, true, err
+}
+
+// DecodeUserInputFormat decodes a seed in hexadecimal encoding or as a
+// mnemonic in format f.  Input tagged with a format by TagMnemonic is decoded
+// using the tagged format instead.
+func DecodeUserInputFormat(input string, f Format) ([]byte, error) {
+	const op errors.Op = "walletseed.DecodeUserInputFormat"
+	tagged, rest, ok, err := splitTag(input)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if ok {
+		f, input = tagged, rest
+	}
+	if f == FormatPGP {
+		return DecodeUserInput(input)
+	}
+	lang, ok := f.bip39Language()
+	if !ok {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("unknown seed "+
+			"format %q", f))
+	}
+
+	words := strings.Fields(input)
+	if len(words) == 1 {
+		return DecodeUserInput(words[0])
+	}
+	seed, err := bip39wordlist.DecodeMnemonics(words, lang)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if len(seed) < hdkeychain.MinSeedBytes || len(seed) > hdkeychain.MaxSeedBytes {
+		return nil, errors.E(op, errors.Encoding, hdkeychain.ErrInvalidSeedLen)
+	}
+	return seed, nil
+}
+
 // DecodeUserInput decodes a seed in either hexadecimal or mnemonic word list
-// encoding back into its binary form.
+// encoding back into its binary form.  Mnemonics use the PGP word list unless
+// tagged with another format by TagMnemonic.
 func DecodeUserInput(input string) ([]byte, error) {
 	const op errors.Op = "walletseed.DecodeUserInput"
+	f, rest, tagged, err := splitTag(input)
+	switch {
+	case err != nil:
+		return nil, errors.E(op, err)
+	case tagged && f != FormatPGP:
+		return DecodeUserInputFormat(rest, f)
+	case tagged:
+		input = rest
+	}
 	words := strings.Split(strings.TrimSpace(input), " ")
 	var seed []byte
 	switch {
 	case len(words) == 1:
 		// Assume hex
-		var err error
 		seed, err = hex.DecodeString(words[0])
 		if err != nil {
 			return nil, errors.E(op, errors.Encoding, err)
@@ -86,3 +86,43 @@ func TestDecodeHex(t *testing.T) {
 		}
 	}
 }
+
+func TestFormats(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(255 - i)
+	}
+	for _, f := range Formats() {
+		parsed, err := ParseFormat(strings.ToUpper(string(f)))
+		if err != nil || parsed != f {
+			t.Errorf("%s: parsed as %q: %v", f, parsed, err)
+		}
+
+		mnemonic, err := EncodeMnemonicFormat(seed, f)
+		if err != nil {
+			t.Errorf("%s: %v", f, err)
+			continue
+		}
+		data, err := DecodeUserInputFormat(mnemonic, f)
+		if err != nil {
+			t.Errorf("%s: error: %v", f, err)
+		} else if !bytes.Equal(data, seed) {
+			t.Errorf("%s: got %x want %x", f, data, seed)
+		}
+
+		// Tagged mnemonics are decoded using the tagged format.
+		data, err = DecodeUserInput(TagMnemonic(mnemonic, f))
+		if err != nil {
+			t.Errorf("%s: tagged: error: %v", f, err)
+		} else if !bytes.Equal(data, seed) {
+			t.Errorf("%s: tagged: got %x want %x", f, data, seed)
+		}
+	}
+
+	if _, err := ParseFormat("bip39-klingon"); err == nil {
+		t.Errorf("parsed unknown format")
+	}
+	if _, err := EncodeMnemonicFormat(make([]byte, 64), "bip39-english"); err == nil {
+		t.Errorf("encoded seed length unsupported by BIP0039")
+	}
+}
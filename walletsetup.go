@@ -115,13 +115,18 @@ func createWallet(ctx context.Context, cfg *config) error {
 	loader := loader.NewLoader(activeNet.Params, dbDir, cfg.EnableVoting,
 		cfg.GapLimit, cfg.WatchLast, cfg.AllowHighFees, cfg.RelayFee.Amount,
 		cfg.AccountGapLimit, cfg.DisableCoinTypeUpgrades, !cfg.Mixing,
-		cfg.ManualTickets, cfg.MixSplitLimit, cfg.dial)
+		cfg.ManualTickets, cfg.MixSplitLimit, cfg.MaxTxOutputs, cfg.MaxTxSize,
+		cfg.inputTree(), cfg.VoteFeeReserve.Amount, cfg.dial)
+
+	seedFormat, err := walletseed.ParseFormat(cfg.SeedFormat)
+	if err != nil {
+		return err
+	}
 
 	var privPass, pubPass, seed []byte
 	var imported bool
 	var importedAccountNames []string
 	var importedAccountXpubs []*hdkeychain.ExtendedKey
-	var err error
 	var birthState *udb.BirthdayState
 	c := make(chan struct{}, 1)
 	go func() {
@@ -151,7 +156,7 @@ func createWallet(ctx context.Context, cfg *config) error {
 		// automatically generated value the user has already confirmed or a
 		// value the user has entered which has already been validated.
 		// There is no config flag to set the seed.
-		seed, imported, err = prompt.Seed(r)
+		seed, imported, err = prompt.Seed(r, seedFormat)
 		if err != nil {
 			return
 		}
@@ -310,6 +315,63 @@ func createSimulationWallet(ctx context.Context, cfg *config) error {
 	return nil
 }
 
+// dryRun reports the migrations and upgrades required to open the wallet
+// database at dbPath, and the rescan performed after opening it, measured by
+// upgrading a temporary copy of the database, without modifying the database
+// itself.
+func dryRun(ctx context.Context, cfg *config, dbPath string) error {
+	db, err := wallet.OpenDB("bdb", dbPath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	tempPath := dbPath + ".dryrun"
+	r, err := wallet.DryRunUpgrade(ctx, db, "bdb", tempPath,
+		[]byte(cfg.WalletPass), activeNet.Params)
+	if err != nil {
+		return err
+	}
+
+	if r.Migration {
+		fmt.Printf("Migration to the unified database format is required "+
+			"(%v, %+d bytes)\n", r.MigrationDuration.Round(time.Millisecond),
+			r.MigrationGrowth)
+	}
+	switch {
+	case len(r.Upgrades) != 0:
+		fmt.Printf("Database version %d requires %d upgrade(s) to "+
+			"version %d:\n", r.FromVersion, len(r.Upgrades), r.ToVersion)
+	case !r.Migration:
+		fmt.Printf("Database version %d is current; no upgrades are "+
+			"required\n", r.FromVersion)
+	}
+	if len(r.Upgrades) != 0 || r.Migration {
+		var total time.Duration
+		total += r.MigrationDuration
+		for _, u := range r.Upgrades {
+			fmt.Printf("  version %d: %v, %+d bytes\n", u.Version,
+				u.Duration.Round(time.Millisecond), u.Growth)
+			total += u.Duration
+		}
+		fmt.Printf("Estimated duration: %v\n", total.Round(time.Millisecond))
+		fmt.Printf("Database size: %d bytes, after upgrades: %d bytes\n",
+			r.Size, r.UpgradedSize)
+		fmt.Printf("Additional space required: %d bytes\n",
+			max(r.UpgradedSize-r.Size, 0))
+	}
+
+	if r.RescanBlocks == 0 {
+		fmt.Println("No rescan is pending")
+	} else {
+		fmt.Printf("A rescan of %d block(s) from height %d is pending\n",
+			r.RescanBlocks, r.RescanFrom)
+	}
+	fmt.Println("Blocks attached by syncing are scanned in addition to " +
+		"any pending rescan")
+	return nil
+}
+
 // promptHDPublicKey prompts the user for an extended public key.
 func promptHDPublicKey(reader *bufio.Reader) (string, error) {
 	fmt.Print("Enter HD wallet public key: ")